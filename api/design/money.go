@@ -0,0 +1,44 @@
+package design
+
+import (
+	"strings"
+
+	"goa.design/goa/v3/codegen"
+	"goa.design/goa/v3/eval"
+)
+
+// The gRPC messages carry the money amounts as the integer number of the hundredths of a point.
+// Goa converts the attributes by their design types, so the Float64 attribute of the [MoneyField] overridden by the sint64 protobuf field
+// is converted to float64. The plugin converts it to int64 since the money amounts are the only Float64 attributes of the design
+func init() {
+	codegen.RegisterPluginLast("money", "gen", nil, minorUnitsPlugin)
+}
+
+// grpcConversionSections are the sections of the gRPC types which convert the service types to the protobuf messages and back
+var grpcConversionSections = map[string]bool{
+	"server-type-init":        true,
+	"server-transform-helper": true,
+	"client-type-init":        true,
+	"client-transform-helper": true,
+}
+
+func minorUnitsPlugin(_ string, _ []eval.Root, files []*codegen.File) ([]*codegen.File, error) {
+	for _, f := range files {
+		for _, s := range f.SectionTemplates {
+			if !grpcConversionSections[s.Name] {
+				continue
+			}
+			s.Source = strings.ReplaceAll(s.Source, "{{ .Code }}", "{{ minorUnits .Code }}")
+			if s.FuncMap == nil {
+				s.FuncMap = make(map[string]any)
+			}
+			s.FuncMap["minorUnits"] = minorUnits
+		}
+	}
+	return files, nil
+}
+
+// minorUnits replaces the float64 conversions of the money amounts in the code by the int64 ones
+func minorUnits(code string) string {
+	return strings.ReplaceAll(code, "float64(", "int64(")
+}
@@ -0,0 +1,59 @@
+// Command accrual runs the scriptable stub of the accrual system.
+//
+// Usage:
+//
+//	accrual -a localhost:8081 -s script.json
+//
+// The script is a JSON [accrual.Script]:
+//
+//	{
+//	  "rules": [
+//	    {"prefix": "1", "accrual": 500},
+//	    {"prefix": "2", "accrual_min": 10, "accrual_max": 1000},
+//	    {"prefix": "9", "status": "INVALID"}
+//	  ],
+//	  "status_step": "1s",
+//	  "requests_per_minute": 100
+//	}
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+	"github.com/oleshko-g/oggophermart/internal/transport/http"
+	"goa.design/clue/log"
+)
+
+func main() {
+	ctx := log.Context(context.Background(), log.WithFormat(log.FormatTerminal))
+
+	cfg := http.Config{}
+	aF := cfg.Address()
+	if err := aF.Set("localhost:8081"); err != nil { // default
+		log.Fatal(ctx, err)
+	}
+	flag.Var(aF, "a", "The host address of the accrual system")
+	if v, ok := os.LookupEnv("RUN_ADDRESS"); ok {
+		if err := aF.Set(v); err != nil { // override the default
+			log.Fatal(ctx, err)
+		}
+	}
+
+	scriptPath := flag.String("s", "", "Path to the JSON script of the accrual system behaviour")
+	flag.Parse()
+
+	script := accrual.DefaultScript()
+	if *scriptPath != "" {
+		var err error
+		if script, err = accrual.LoadScript(*scriptPath); err != nil {
+			log.Fatal(ctx, err)
+		}
+	}
+
+	srv := http.NewAccrualServer(ctx, aF.String(), accrual.New(script))
+	log.Printf(ctx, "accrual stub HTTP server is listening on %s", aF.String())
+	log.Fatal(ctx, srv.ListenAndServe())
+}
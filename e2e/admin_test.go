@@ -0,0 +1,259 @@
+package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+)
+
+type adminUser struct {
+	ID    string `json:"id"`
+	Login string `json:"login"`
+	Role  string `json:"role"`
+}
+
+type ledgerEntry struct {
+	Kind    string       `json:"kind"`
+	Amount  money.Amount `json:"amount"`
+	Order   string       `json:"order"`
+	Reason  string       `json:"reason"`
+	ActorID string       `json:"actor_id"`
+}
+
+type ledger struct {
+	Balance   money.Amount  `json:"balance"`
+	Withdrawn money.Amount  `json:"withdrawn"`
+	Entries   []ledgerEntry `json:"entries"`
+}
+
+// login returns the token of the user
+func (g *gophermart) login(t *testing.T, login, password string) string {
+	t.Helper()
+	res, body := g.do(t, http.MethodPost, "/api/user/login", "", "application/json", credentials(login, password))
+	expectStatus(t, res, body, http.StatusOK)
+	return res.Header.Get("Authorization")
+}
+
+// searchUser returns the only user whose login contains the part ignoring its case
+func (g *gophermart) searchUser(t *testing.T, token, part string) adminUser {
+	t.Helper()
+	res, body := g.do(t, http.MethodGet, "/api/admin/users?login="+part, token, "", "")
+	expectStatus(t, res, body, http.StatusOK)
+	users := decode[[]adminUser](t, body)
+	if len(users) != 1 || !strings.Contains(users[0].Login, strings.ToLower(part)) {
+		t.Fatalf("got the users %+v, want the one containing %s", users, part)
+	}
+	return users[0]
+}
+
+// waitForLedger returns the ledger of the user once it has n entries
+func (g *gophermart) waitForLedger(t *testing.T, token, userID string, n int) ledger {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		res, body := g.do(t, http.MethodGet, "/api/admin/users/"+userID+"/ledger", token, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		l := decode[ledger](t, body)
+		if len(l.Entries) >= n {
+			return l
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got the ledger %+v, want %d entries", l, n)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestAdmin(t *testing.T) {
+	accrualSum, err := money.Parse("729.98")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := accrual.Script{
+		Rules:      []accrual.Rule{{Prefix: "1234", Accrual: &accrualSum}},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	accrualAddress, dir := startAccrual(t, script), t.TempDir()
+	env := []string{"ACCRUAL_POLL_INTERVAL=100ms", "ADMIN_LOGIN=root"}
+
+	// the admin login isn't granted the role by its registration
+	g := startGophermartIn(t, dir, accrualAddress, env...)
+	root := g.register(t, "root", "secret")
+	res, body := g.do(t, http.MethodGet, "/api/admin/users", root, "", "")
+	expectStatus(t, res, body, http.StatusForbidden)
+	g.stop()
+
+	// the registered admin login is promoted at the startup
+	g = startGophermartIn(t, dir, accrualAddress, env...)
+	root = g.login(t, "root", "secret")
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	res, body = g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903")
+	expectStatus(t, res, body, http.StatusAccepted)
+	res, body = g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "79927398713") // isn't registered by the accrual system
+	expectStatus(t, res, body, http.StatusAccepted)
+
+	rootUser := g.searchUser(t, root, "root")
+	if rootUser.Role != "admin" {
+		t.Fatalf("got the role %s of the admin login, want admin", rootUser.Role)
+	}
+	aliceUser := g.searchUser(t, root, "ALI")
+	bobUser := g.searchUser(t, root, "bob")
+	aliceLedger := g.waitForLedger(t, root, aliceUser.ID, 1)
+
+	t.Run("users aren't authorized", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/admin/users", alice, "", "")
+		expectStatus(t, res, body, http.StatusForbidden)
+		res, body = g.do(t, http.MethodGet, "/api/admin/users", "", "", "")
+		expectStatus(t, res, body, http.StatusUnauthorized)
+		// the user's own API is still served
+		res, body = g.do(t, http.MethodGet, "/api/user/orders", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+	})
+
+	t.Run("search by role", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/admin/users?role=user", root, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		users := decode[[]adminUser](t, body)
+		if len(users) != 2 || users[0].Login != "alice" || users[1].Login != "bob" {
+			t.Errorf("got the users %+v, want alice and bob", users)
+		}
+	})
+
+	t.Run("support reads", func(t *testing.T) {
+		res, body := g.do(t, http.MethodPut, "/api/admin/users/"+bobUser.ID+"/role", root, "application/json", `{"role":"support"}`)
+		expectStatus(t, res, body, http.StatusOK)
+		if u := decode[adminUser](t, body); u.Role != "support" {
+			t.Errorf("got the user %+v, want the support role", u)
+		}
+
+		// the token issued before the promotion doesn't carry the scope
+		res, body = g.do(t, http.MethodGet, "/api/admin/users", bob, "", "")
+		expectStatus(t, res, body, http.StatusForbidden)
+
+		support := g.login(t, "bob", "secret")
+		res, body = g.do(t, http.MethodGet, "/api/admin/users/"+aliceUser.ID+"/orders", support, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if orders := decode[[]struct{ Number string }](t, body); len(orders) != 2 {
+			t.Errorf("got the orders %+v, want 2", orders)
+		}
+		res, body = g.do(t, http.MethodGet, "/api/admin/users/"+aliceUser.ID+"/ledger", support, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+
+		res, body = g.do(t, http.MethodPost, "/api/admin/users/"+aliceUser.ID+"/adjustments", support, "application/json",
+			`{"amount":10,"reason":"Compensation"}`)
+		expectStatus(t, res, body, http.StatusForbidden)
+
+		// the demotion revokes the scopes of the issued token at once
+		res, body = g.do(t, http.MethodPut, "/api/admin/users/"+bobUser.ID+"/role", root, "application/json", `{"role":"user"}`)
+		expectStatus(t, res, body, http.StatusOK)
+		res, body = g.do(t, http.MethodGet, "/api/admin/users", support, "", "")
+		expectStatus(t, res, body, http.StatusForbidden)
+	})
+
+	t.Run("accrual in the ledger", func(t *testing.T) {
+		if aliceLedger.Balance != accrualSum || len(aliceLedger.Entries) != 1 {
+			t.Fatalf("got the ledger %+v, want the accrual %s", aliceLedger, accrualSum)
+		}
+		if e := aliceLedger.Entries[0]; e.Kind != "accrual" || e.Amount != accrualSum || e.Order != "12345678903" {
+			t.Errorf("got the entry %+v, want the accrual of the order", e)
+		}
+	})
+
+	t.Run("adjustments", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			userID string
+			body   string
+			want   int
+		}{
+			{"zero amount", aliceUser.ID, `{"amount":0,"reason":"Nothing"}`, http.StatusBadRequest},
+			{"no reason", aliceUser.ID, `{"amount":10,"reason":" "}`, http.StatusBadRequest},
+			{"unknown user", "9b2f4c1e-3d5a-4e8b-a6c7-0f1e2d3c4b5a", `{"amount":10,"reason":"Compensation"}`, http.StatusNotFound},
+			{"insufficient balance", aliceUser.ID, `{"amount":-1000,"reason":"Fraud"}`, http.StatusPaymentRequired},
+			{"compensation", aliceUser.ID, `{"amount":10.02,"reason":"Compensation"}`, http.StatusCreated},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res, body := g.do(t, http.MethodPost, "/api/admin/users/"+tt.userID+"/adjustments", root, "application/json", tt.body)
+				expectStatus(t, res, body, tt.want)
+			})
+		}
+
+		l := g.waitForLedger(t, root, aliceUser.ID, 2)
+		want := ledgerEntry{Kind: "adjustment", Amount: 1002, Reason: "Compensation", ActorID: rootUser.ID}
+		if l.Entries[0] != want {
+			t.Errorf("got the latest entry %+v, want %+v", l.Entries[0], want)
+		}
+		if l.Balance != accrualSum+1002 {
+			t.Errorf("got the balance %s, want %s", l.Balance, accrualSum+1002)
+		}
+	})
+
+	t.Run("reprocess", func(t *testing.T) {
+		res, body := g.do(t, http.MethodPost, "/api/admin/orders/79927398713/reprocess", root, "", "")
+		expectStatus(t, res, body, http.StatusConflict)
+		res, body = g.do(t, http.MethodPost, "/api/admin/orders/4561261212345467/reprocess", root, "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+
+		res, body = g.do(t, http.MethodPost, "/api/admin/orders/12345678903/reprocess", root, "", "")
+		expectStatus(t, res, body, http.StatusAccepted)
+
+		// the accrual is reversed and accrued again once the order is processed again
+		l := g.waitForLedger(t, root, aliceUser.ID, 4)
+		kinds := fmt.Sprint(l.Entries[0].Kind, " ", l.Entries[1].Kind)
+		if kinds != "accrual reversal" {
+			t.Errorf("got the latest entries %+v, want the reversal followed by the accrual", l.Entries)
+		}
+		if r := l.Entries[1]; r.Amount != -accrualSum || r.Order != "12345678903" || r.ActorID != rootUser.ID {
+			t.Errorf("got the reversal %+v, want -%s of the order by the admin", r, accrualSum)
+		}
+		if l.Balance != accrualSum+1002 {
+			t.Errorf("got the balance %s, want %s", l.Balance, accrualSum+1002)
+		}
+
+		res, body = g.do(t, http.MethodGet, "/api/user/orders/12345678903", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		timeline := decode[struct {
+			History []struct {
+				From   string `json:"from"`
+				Status string `json:"status"`
+				Source string `json:"source"`
+			} `json:"history"`
+		}](t, body)
+		var reprocessed bool
+		for _, h := range timeline.History {
+			reprocessed = reprocessed || (h.From == "PROCESSED" && h.Status == "NEW" && h.Source == "admin")
+		}
+		if !reprocessed {
+			t.Errorf("got the history %+v, want the transition by the admin", timeline.History)
+		}
+	})
+
+	t.Run("adjustment retried with the idempotency key", func(t *testing.T) {
+		before := g.waitForLedger(t, root, aliceUser.ID, 0)
+		key := http.Header{"Idempotency-Key": {"adjustment-1"}}
+		path := "/api/admin/users/" + aliceUser.ID + "/adjustments"
+		adjustment := `{"amount":5,"reason":"Goodwill"}`
+
+		res, body := g.doWithHeader(t, http.MethodPost, path, root, "application/json", adjustment, key)
+		expectStatus(t, res, body, http.StatusCreated)
+		res, body = g.doWithHeader(t, http.MethodPost, path, root, "application/json", adjustment, key)
+		expectStatus(t, res, body, http.StatusCreated)
+		if res.Header.Get("Idempotent-Replayed") != "true" {
+			t.Error("the retry isn't replayed")
+		}
+
+		l := g.waitForLedger(t, root, aliceUser.ID, len(before.Entries)+1)
+		if len(l.Entries) != len(before.Entries)+1 || l.Balance != before.Balance+500 {
+			t.Errorf("got %d entries and the balance %s, want %d entries and %s",
+				len(l.Entries), l.Balance, len(before.Entries)+1, before.Balance+500)
+		}
+	})
+}
@@ -0,0 +1,1054 @@
+// Package e2e runs the gophermart end-to-end in the test process against a SQLite database file
+// and the in-process stub of the accrual system
+package e2e
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gophermartApp "github.com/oleshko-g/oggophermart/internal/gophermart"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+	transportHTTP "github.com/oleshko-g/oggophermart/internal/transport/http"
+	"goa.design/clue/log"
+)
+
+// gophermart is a running gophermart instance
+type gophermart struct {
+	baseURL string
+	client  *http.Client
+	stop    func() // stops the gophermart once
+}
+
+// logBuffer collects the log of the gophermart which is written concurrently
+type logBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (l *logBuffer) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.b.Write(p)
+}
+
+func (l *logBuffer) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.b.String()
+}
+
+// genHTTPDir is the absolute path of the generated OpenAPI spec since the tests change their working directories
+var genHTTPDir, _ = filepath.Abs("../internal/gen/http")
+
+// startGophermart runs the gophermart in the test process on a random port with its own database file.
+// The env vars are set for the test. The gophermart is stopped when the test finishes
+func startGophermart(t *testing.T, accrualAddress string, env ...string) *gophermart {
+	t.Helper()
+	return startGophermartIn(t, t.TempDir(), accrualAddress, env...)
+}
+
+// startGophermartIn runs the gophermart as [startGophermart] does with the database file and the env file in the dir.
+// The gophermart started in the same dir before is restarted with its data once it's stopped
+func startGophermartIn(t *testing.T, dir, accrualAddress string, env ...string) *gophermart {
+	t.Helper()
+
+	var spec transportHTTP.OpenAPISpec
+	var err error
+	if spec.JSON, err = os.ReadFile(filepath.Join(genHTTPDir, "openapi3.json")); err != nil {
+		t.Fatal(err)
+	}
+	if spec.YAML, err = os.ReadFile(filepath.Join(genHTTPDir, "openapi3.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Chdir(dir) // the env file is created in the working directory
+	t.Setenv("JWT_SECRET", "e2e")
+	for _, kv := range env {
+		k, v, _ := strings.Cut(kv, "=")
+		t.Setenv(k, v)
+	}
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the address flags don't accept IP addresses
+	address := fmt.Sprintf("localhost:%d", listener.Addr().(*net.TCPAddr).Port)
+
+	var output logBuffer
+	app := gophermartApp.New(log.Context(context.Background(), log.WithOutput(&output)), spec)
+	err = app.Configure([]string{
+		"-a", address,
+		"-d", "sqlite://" + filepath.Join(dir, "gophermart.db"),
+		"-r", accrualAddress,
+		"-l", "info",
+	})
+	if err == nil {
+		err = app.Setup()
+	}
+	if err != nil {
+		listener.Close()
+		t.Fatalf("%v\ngophermart output:\n%s", err, output.String())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- app.Run(ctx, listener)
+	}()
+	stop := sync.OnceFunc(func() {
+		cancel()
+		if err := <-stopped; err != nil {
+			t.Errorf("gophermart failed: %v", err)
+		}
+		if t.Failed() {
+			t.Logf("gophermart output:\n%s", output.String())
+		}
+	})
+	t.Cleanup(stop)
+
+	return &gophermart{
+		baseURL: "http://" + address,
+		client:  &http.Client{Timeout: 5 * time.Second},
+		stop:    stop,
+	}
+}
+
+// startAccrual runs the accrual system stub in the test process and returns its address
+func startAccrual(t *testing.T, script accrual.Script) string {
+	t.Helper()
+	ctx := log.Context(context.Background(), log.WithOutput(io.Discard))
+	srv := httptest.NewServer(transportHTTP.NewAccrualHandler(ctx, accrual.New(script)))
+	t.Cleanup(srv.Close)
+	return fmt.Sprintf("localhost:%d", srv.Listener.Addr().(*net.TCPAddr).Port)
+}
+
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	// the address flags don't accept IP addresses
+	return fmt.Sprintf("localhost:%d", l.Addr().(*net.TCPAddr).Port)
+}
+
+// waitForListener waits until the address is listened on, like the gRPC address which the gophermart listens on once it runs
+func waitForListener(t *testing.T, address string) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("gophermart didn't start listening on %s", address)
+}
+
+// do sends the request and returns the response with its body read
+func (g *gophermart) do(t *testing.T, method, path, token, contentType, body string) (*http.Response, []byte) {
+	t.Helper()
+	return g.doWithHeader(t, method, path, token, contentType, body, nil)
+}
+
+// doWithHeader sends the request with the extra header and returns the response with its body read
+func (g *gophermart) doWithHeader(t *testing.T, method, path, token, contentType, body string, header http.Header) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(method, g.baseURL+path, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, resBody
+}
+
+func credentials(login, password string) string {
+	b, _ := json.Marshal(map[string]string{"login": login, "password": password})
+	return string(b)
+}
+
+// register registers the user and returns their token
+func (g *gophermart) register(t *testing.T, login, password string) string {
+	t.Helper()
+	res, _ := g.do(t, http.MethodPost, "/api/user/register", "", "application/json", credentials(login, password))
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("register %s: got status %d, want %d", login, res.StatusCode, http.StatusOK)
+	}
+	token := res.Header.Get("Authorization")
+	if token == "" {
+		t.Fatalf("register %s: no Authorization header", login)
+	}
+	return token
+}
+
+func expectStatus(t *testing.T, res *http.Response, body []byte, want int) {
+	t.Helper()
+	if res.StatusCode != want {
+		t.Errorf("%s %s: got status %d, want %d. Body: %s", res.Request.Method, res.Request.URL.Path, res.StatusCode, want, body)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	g.register(t, "alice", "secret")
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        int
+	}{
+		{"taken login", "application/json", credentials("alice", "another"), http.StatusConflict},
+		{"malformed JSON", "application/json", `{"login":`, http.StatusBadRequest},
+		{"missing password", "application/json", `{"login":"bob"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, body := g.do(t, http.MethodPost, "/api/user/register", "", tt.contentType, tt.body)
+			expectStatus(t, res, body, tt.want)
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	g.register(t, "alice", "secret")
+
+	tests := []struct {
+		name      string
+		body      string
+		want      int
+		wantToken bool
+	}{
+		{"valid credentials", credentials("alice", "secret"), http.StatusOK, true},
+		{"wrong password", credentials("alice", "wrong"), http.StatusUnauthorized, false},
+		{"unknown login", credentials("bob", "secret"), http.StatusUnauthorized, false},
+		{"malformed JSON", `{"login":`, http.StatusBadRequest, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, body := g.do(t, http.MethodPost, "/api/user/login", "", "application/json", tt.body)
+			expectStatus(t, res, body, tt.want)
+			if got := res.Header.Get("Authorization") != ""; got != tt.wantToken {
+				t.Errorf("got Authorization header %t, want %t", got, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestUploadUserOrder(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	// the steps depend on the orders uploaded by the previous ones
+	steps := []struct {
+		name   string
+		token  string
+		number string
+		want   int
+	}{
+		{"new order", alice, "12345678903", http.StatusAccepted},
+		{"uploaded before by the same user", alice, "12345678903", http.StatusOK},
+		{"uploaded before by another user", bob, "12345678903", http.StatusConflict},
+		{"invalid Luhn checksum", alice, "12345678904", http.StatusUnprocessableEntity},
+		{"no token", "", "9278923470", http.StatusUnauthorized},
+		{"invalid token", "invalid", "9278923470", http.StatusUnauthorized},
+	}
+	for _, s := range steps {
+		t.Run(s.name, func(t *testing.T) {
+			res, body := g.do(t, http.MethodPost, "/api/user/orders", s.token, "text/plain", s.number)
+			expectStatus(t, res, body, s.want)
+		})
+	}
+}
+
+func TestUploadUserOrders(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	res, body := g.do(t, http.MethodPost, "/api/user/orders", bob, "text/plain", "9278923470")
+	expectStatus(t, res, body, http.StatusAccepted)
+
+	type result struct {
+		Number string `json:"number"`
+		Result string `json:"result"`
+	}
+	// the steps depend on the orders uploaded by the previous ones
+	steps := []struct {
+		name        string
+		token       string
+		contentType string
+		body        string
+		want        int
+		wantResults []result
+	}{
+		{
+			name:        "JSON array",
+			token:       alice,
+			contentType: "application/json",
+			body:        `["12345678903", 346436439, "12345678904", "9278923470", "12345678903"]`,
+			want:        http.StatusOK,
+			wantResults: []result{
+				{"12345678903", "accepted"},
+				{"346436439", "accepted"},
+				{"12345678904", "invalid"},
+				{"9278923470", "conflict"},
+				{"12345678903", "duplicate"},
+			},
+		},
+		{
+			name:        "new line delimited",
+			token:       alice,
+			contentType: "text/plain",
+			body:        "12345678903\r\n\n4561261212345467\n012345678903\n",
+			want:        http.StatusOK,
+			wantResults: []result{
+				{"12345678903", "duplicate"},
+				{"4561261212345467", "accepted"},
+				{"012345678903", "invalid"},
+			},
+		},
+		{name: "no numbers", token: alice, contentType: "text/plain", body: "\n", want: http.StatusBadRequest},
+		{name: "malformed JSON", token: alice, contentType: "application/json", body: `["12345678903"`, want: http.StatusBadRequest},
+		{name: "no token", contentType: "text/plain", body: "12345678903", want: http.StatusUnauthorized},
+	}
+	for _, s := range steps {
+		t.Run(s.name, func(t *testing.T) {
+			res, body := g.do(t, http.MethodPost, "/api/user/orders/batch", s.token, s.contentType, s.body)
+			expectStatus(t, res, body, s.want)
+			if s.wantResults == nil {
+				return
+			}
+
+			var results []result
+			if err := json.Unmarshal(body, &results); err != nil {
+				t.Fatalf("decoding %s: %s", body, err)
+			}
+			if fmt.Sprint(results) != fmt.Sprint(s.wantResults) {
+				t.Errorf("got results %v, want %v", results, s.wantResults)
+			}
+		})
+	}
+}
+
+func TestListUserOrder(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	alice := g.register(t, "alice", "secret")
+
+	t.Run("no token", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders", "", "", "")
+		expectStatus(t, res, body, http.StatusUnauthorized)
+	})
+
+	t.Run("no orders", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders", alice, "", "")
+		expectStatus(t, res, body, http.StatusNoContent)
+	})
+
+	t.Run("orders in the order of upload", func(t *testing.T) {
+		numbers := []string{"12345678903", "9278923470", "346436439"}
+		for _, n := range numbers {
+			res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", n)
+			expectStatus(t, res, body, http.StatusAccepted)
+		}
+
+		res, body := g.do(t, http.MethodGet, "/api/user/orders", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+
+		var orders []struct {
+			Number     string `json:"number"`
+			Status     string `json:"status"`
+			UploadedAt string `json:"uploaded_at"`
+		}
+		if err := json.Unmarshal(body, &orders); err != nil {
+			t.Fatalf("decoding %s: %s", body, err)
+		}
+		if len(orders) != len(numbers) {
+			t.Fatalf("got %d orders, want %d", len(orders), len(numbers))
+		}
+		for i, o := range orders {
+			if o.Number != numbers[i] {
+				t.Errorf("order %d: got number %s, want %s", i, o.Number, numbers[i])
+			}
+			if o.Status == "" {
+				t.Errorf("order %s: empty status", o.Number)
+			}
+			if _, err := time.Parse(time.RFC3339, o.UploadedAt); err != nil {
+				t.Errorf("order %s: uploaded_at: %s", o.Number, err)
+			}
+		}
+	})
+}
+
+func TestListUserOrderPages(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	alice := g.register(t, "alice", "secret")
+
+	numbers := []string{"12345678903", "9278923470", "346436439", "4561261212345467", "18"}
+	for _, n := range numbers {
+		res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", n)
+		expectStatus(t, res, body, http.StatusAccepted)
+	}
+
+	// listPages follows the Link headers from the path and returns the numbers of all the pages
+	listPages := func(t *testing.T, path string) (pages [][]string) {
+		t.Helper()
+		for path != "" {
+			res, body := g.do(t, http.MethodGet, path, alice, "", "")
+			expectStatus(t, res, body, http.StatusOK)
+			var orders []struct {
+				Number string `json:"number"`
+			}
+			if err := json.Unmarshal(body, &orders); err != nil {
+				t.Fatalf("decoding %s: %s", body, err)
+			}
+			var page []string
+			for _, o := range orders {
+				page = append(page, o.Number)
+			}
+			pages = append(pages, page)
+
+			path = ""
+			if link := res.Header.Get("Link"); link != "" {
+				if res.Header.Get("Next-Cursor") == "" {
+					t.Errorf("Link %s without Next-Cursor", link)
+				}
+				path = strings.TrimSuffix(strings.TrimPrefix(link, "<"), `>; rel="next"`)
+			}
+			if len(pages) > len(numbers) {
+				t.Fatal("the pages don't end")
+			}
+		}
+		return pages
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want [][]string
+	}{
+		{
+			name: "default is a single page in the order of upload",
+			path: "/api/user/orders",
+			want: [][]string{numbers},
+		},
+		{
+			name: "ascending pages",
+			path: "/api/user/orders?limit=2",
+			want: [][]string{numbers[0:2], numbers[2:4], numbers[4:5]},
+		},
+		{
+			name: "descending pages",
+			path: "/api/user/orders?limit=3&sort=desc",
+			want: [][]string{{numbers[4], numbers[3], numbers[2]}, {numbers[1], numbers[0]}},
+		},
+		{
+			name: "status filter",
+			path: "/api/user/orders?limit=2&status=NEW&status=PROCESSED",
+			want: [][]string{numbers[0:2], numbers[2:4], numbers[4:5]},
+		},
+		{
+			name: "exact last page",
+			path: "/api/user/orders?limit=5",
+			want: [][]string{numbers},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listPages(t, tt.path); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got pages %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no orders of the status", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders?status=INVALID", alice, "", "")
+		expectStatus(t, res, body, http.StatusNoContent)
+	})
+
+	t.Run("no orders in the time range", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders?to=2020-01-01T00:00:00Z", alice, "", "")
+		expectStatus(t, res, body, http.StatusNoContent)
+	})
+
+	for _, path := range []string{
+		"/api/user/orders?cursor=malformed",
+		"/api/user/orders?limit=0",
+		"/api/user/orders?sort=up",
+		"/api/user/orders?from=2020-01-02T00:00:00Z&to=2020-01-01T00:00:00Z",
+	} {
+		t.Run(path, func(t *testing.T) {
+			res, body := g.do(t, http.MethodGet, path, alice, "", "")
+			expectStatus(t, res, body, http.StatusBadRequest)
+		})
+	}
+}
+
+func TestOrderProcessing(t *testing.T) {
+	accrualSum, err := money.Parse("729.98")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := accrual.Script{
+		Rules: []accrual.Rule{
+			{Prefix: "1234", Accrual: &accrualSum},
+			{Prefix: "9278", Status: accrual.StatusInvalid},
+		},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	g := startGophermart(t, startAccrual(t, script), "ACCRUAL_POLL_INTERVAL=100ms")
+	alice := g.register(t, "alice", "secret")
+
+	for _, n := range []string{"12345678903", "9278923470", "346436439"} {
+		res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", n)
+		expectStatus(t, res, body, http.StatusAccepted)
+	}
+
+	type order struct {
+		Number  string        `json:"number"`
+		Status  string        `json:"status"`
+		Accrual *money.Amount `json:"accrual"`
+	}
+	want := []order{
+		{"12345678903", "PROCESSED", &accrualSum},
+		{"9278923470", "INVALID", nil},
+		{"346436439", "NEW", nil}, // the accrual system doesn't register the orders no rule matches
+	}
+	format := func(orders []order) string {
+		var b strings.Builder
+		for _, o := range orders {
+			fmt.Fprintf(&b, "%s %s", o.Number, o.Status)
+			if o.Accrual != nil {
+				fmt.Fprintf(&b, " %s", *o.Accrual)
+			}
+			b.WriteString("; ")
+		}
+		return b.String()
+	}
+
+	var got []order
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		got = nil
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decoding %s: %s", body, err)
+		}
+		if format(got) == format(want) {
+			if !strings.Contains(string(body), `"accrual":729.98`) {
+				t.Errorf("the accrual isn't an exact decimal number: %s", body)
+			}
+			return
+		}
+	}
+	t.Errorf("got orders %s, want %s", format(got), format(want))
+}
+
+func TestGetUserOrder(t *testing.T) {
+	accrualSum := money.Points(500)
+	script := accrual.Script{
+		Rules:      []accrual.Rule{{Prefix: "1234", Accrual: &accrualSum}},
+		StatusStep: accrual.Duration(300 * time.Millisecond), // long enough for a poll to see the order PROCESSING
+	}
+	g := startGophermart(t, startAccrual(t, script), "ACCRUAL_POLL_INTERVAL=50ms")
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903")
+	expectStatus(t, res, body, http.StatusAccepted)
+
+	type change struct {
+		From   string `json:"from"`
+		Status string `json:"status"`
+		Source string `json:"source"`
+	}
+	var got struct {
+		Status      string        `json:"status"`
+		Accrual     *money.Amount `json:"accrual"`
+		ProcessedAt string        `json:"processed_at"`
+		History     []change      `json:"history"`
+	}
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		res, body = g.do(t, http.MethodGet, "/api/user/orders/12345678903", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatalf("decoding %s: %s", body, err)
+		}
+		if got.Status == "PROCESSED" {
+			break
+		}
+	}
+
+	if got.Status != "PROCESSED" || got.Accrual == nil || *got.Accrual != accrualSum || got.ProcessedAt == "" {
+		t.Fatalf("got order %s, want it processed with the accrual %s", body, accrualSum)
+	}
+	want := []change{
+		{"", "NEW", "upload"},
+		{"NEW", "PROCESSING", "accrual"},
+		{"PROCESSING", "PROCESSED", "accrual"},
+	}
+	if fmt.Sprint(got.History) != fmt.Sprint(want) {
+		t.Errorf("got history %v, want %v", got.History, want)
+	}
+
+	t.Run("another user's order", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders/12345678903", bob, "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+	})
+	t.Run("unknown order", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders/9278923470", alice, "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+	})
+	t.Run("no token", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders/12345678903", "", "", "")
+		expectStatus(t, res, body, http.StatusUnauthorized)
+	})
+}
+
+func TestWithdrawOrderNumber(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	alice := g.register(t, "alice", "secret")
+
+	// the order number is validated before the balance so the user without the points gets the same statuses
+	tests := []struct {
+		name  string
+		token string
+		body  string
+		want  int
+	}{
+		{"invalid Luhn checksum", alice, `{"order":"12345678904","sum":1}`, http.StatusUnprocessableEntity},
+		{"not a number", alice, `{"order":"1234a","sum":1}`, http.StatusUnprocessableEntity},
+		{"zero sum", alice, `{"order":"12345678903","sum":0}`, http.StatusBadRequest},
+		{"no token", "", `{"order":"12345678903","sum":1}`, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, body := g.do(t, http.MethodPost, "/api/user/balance/withdraw", tt.token, "application/json", tt.body)
+			expectStatus(t, res, body, tt.want)
+		})
+	}
+}
+
+func TestBalance(t *testing.T) {
+	accrualSum, err := money.Parse("729.98")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := accrual.Script{
+		Rules:      []accrual.Rule{{Prefix: "1234", Accrual: &accrualSum}},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	g := startGophermart(t, startAccrual(t, script), "ACCRUAL_POLL_INTERVAL=100ms")
+	alice := g.register(t, "alice", "secret")
+
+	type balance struct {
+		Current   money.Amount `json:"current"`
+		Withdrawn money.Amount `json:"withdrawn"`
+	}
+	getBalance := func(t *testing.T) balance {
+		t.Helper()
+		res, body := g.do(t, http.MethodGet, "/api/user/balance", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		return decode[balance](t, body)
+	}
+	withdraw := func(t *testing.T, order, sum string, want int) {
+		t.Helper()
+		body := fmt.Sprintf(`{"order":%q,"sum":%s}`, order, sum)
+		res, resBody := g.do(t, http.MethodPost, "/api/user/balance/withdraw", alice, "application/json", body)
+		expectStatus(t, res, resBody, want)
+	}
+
+	res, body := g.do(t, http.MethodGet, "/api/user/withdrawals", alice, "", "")
+	expectStatus(t, res, body, http.StatusNoContent)
+	if got := getBalance(t); got != (balance{}) {
+		t.Fatalf("got the balance %+v of the new user, want zero", got)
+	}
+	withdraw(t, "2377225624", "1", http.StatusPaymentRequired)
+
+	res, body = g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903")
+	expectStatus(t, res, body, http.StatusAccepted)
+	for deadline := time.Now().Add(5 * time.Second); getBalance(t).Current != accrualSum; time.Sleep(100 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("got the balance %+v, want the accrual %s of the processed order", getBalance(t), accrualSum)
+		}
+	}
+
+	withdraw(t, "2377225624", "500.5", http.StatusOK)
+	var want balance
+	if want.Current, err = money.Parse("229.48"); err != nil {
+		t.Fatal(err)
+	}
+	if want.Withdrawn, err = money.Parse("500.5"); err != nil {
+		t.Fatal(err)
+	}
+	if got := getBalance(t); got != want {
+		t.Errorf("got the balance %+v after the withdrawal, want %+v", got, want)
+	}
+	withdraw(t, "49927398716", "300", http.StatusPaymentRequired)
+	if got := getBalance(t); got != want {
+		t.Errorf("got the balance %+v after the insufficient funds, want %+v", got, want)
+	}
+
+	res, body = g.do(t, http.MethodGet, "/api/user/withdrawals", alice, "", "")
+	expectStatus(t, res, body, http.StatusOK)
+	type withdrawal struct {
+		Order       string       `json:"order"`
+		Sum         money.Amount `json:"sum"`
+		ProcessedAt time.Time    `json:"processed_at"`
+	}
+	withdrawals := decode[[]withdrawal](t, body)
+	if len(withdrawals) != 1 || withdrawals[0].Order != "2377225624" || withdrawals[0].Sum != want.Withdrawn {
+		t.Fatalf("got the withdrawals %s, want the one of 500.5 points for 2377225624", body)
+	}
+	if withdrawals[0].ProcessedAt.IsZero() {
+		t.Errorf("the withdrawal has no processing time: %s", body)
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+	key := func(k string) http.Header {
+		return http.Header{"Idempotency-Key": {k}}
+	}
+
+	res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903", key("upload-1"))
+	expectStatus(t, res, body, http.StatusAccepted)
+	if res.Header.Get("Idempotent-Replayed") != "" {
+		t.Error("the first response is replayed")
+	}
+
+	t.Run("retry", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903", key("upload-1"))
+		expectStatus(t, res, body, http.StatusAccepted) // a retry without the key gets 200
+		if res.Header.Get("Idempotent-Replayed") != "true" {
+			t.Error("the retry isn't replayed")
+		}
+	})
+	t.Run("key reused for another order", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "9278923470", key("upload-1"))
+		expectStatus(t, res, body, http.StatusUnprocessableEntity)
+	})
+	t.Run("key of another user", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders", bob, "text/plain", "9278923470", key("upload-1"))
+		expectStatus(t, res, body, http.StatusAccepted)
+	})
+	t.Run("too long key", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "346436439", key(strings.Repeat("k", 256)))
+		expectStatus(t, res, body, http.StatusBadRequest)
+	})
+	t.Run("batch retry", func(t *testing.T) {
+		orders := `["346436439", "12345678903"]`
+		first, firstBody := g.doWithHeader(t, http.MethodPost, "/api/user/orders/batch", alice, "application/json", orders, key("batch-1"))
+		expectStatus(t, first, firstBody, http.StatusOK)
+		retry, retryBody := g.doWithHeader(t, http.MethodPost, "/api/user/orders/batch", alice, "application/json", orders, key("batch-1"))
+		expectStatus(t, retry, retryBody, http.StatusOK)
+		if !bytes.Equal(firstBody, retryBody) {
+			t.Errorf("the retry got %s, want the first response %s", retryBody, firstBody)
+		}
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders", "", "text/plain", "346436439", key("upload-2"))
+		expectStatus(t, res, body, http.StatusUnauthorized)
+	})
+	t.Run("webhook retry", func(t *testing.T) {
+		webhook := `{"url":"https://partner.example/events"}`
+		for range 2 {
+			res, body := g.doWithHeader(t, http.MethodPost, "/api/user/webhooks", alice, "application/json", webhook, key("webhook-1"))
+			expectStatus(t, res, body, http.StatusCreated)
+		}
+		res, body := g.do(t, http.MethodGet, "/api/user/webhooks", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if webhooks := decode[[]webhookResult](t, body); len(webhooks) != 1 {
+			t.Errorf("got %d webhooks, want the retry to create none", len(webhooks))
+		}
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	for _, store := range []string{"memory", "database"} {
+		t.Run(store, func(t *testing.T) {
+			g := startGophermart(t, startAccrual(t, accrual.DefaultScript()),
+				"RATE_LIMIT_ROUTES=GET /api/user/orders=1/m:2",
+				"RATE_LIMIT_STORE="+store,
+			)
+			alice := g.register(t, "alice", "secret")
+			bob := g.register(t, "bob", "secret")
+
+			for remaining := 1; remaining >= 0; remaining-- {
+				res, body := g.do(t, http.MethodGet, "/api/user/orders", alice, "", "")
+				expectStatus(t, res, body, http.StatusNoContent)
+				if got := res.Header.Get("X-RateLimit-Remaining"); got != fmt.Sprint(remaining) {
+					t.Errorf("X-RateLimit-Remaining = %q, want %d", got, remaining)
+				}
+			}
+
+			res, body := g.do(t, http.MethodGet, "/api/user/orders", alice, "", "")
+			expectStatus(t, res, body, http.StatusTooManyRequests)
+			for header, want := range map[string]string{
+				"X-RateLimit-Limit":     "2",
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     "120",
+				"Retry-After":           "60",
+			} {
+				if got := res.Header.Get(header); got != want {
+					t.Errorf("%s = %q, want %q", header, got, want)
+				}
+			}
+
+			t.Run("another user", func(t *testing.T) {
+				res, body := g.do(t, http.MethodGet, "/api/user/orders", bob, "", "")
+				expectStatus(t, res, body, http.StatusNoContent)
+			})
+			t.Run("another route", func(t *testing.T) {
+				res, body := g.do(t, http.MethodGet, "/api/user/orders/18", alice, "", "")
+				expectStatus(t, res, body, http.StatusNotFound)
+			})
+			t.Run("unauthenticated requests share the IP", func(t *testing.T) {
+				for _, want := range []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests} {
+					res, body := g.do(t, http.MethodGet, "/api/user/orders", "", "", "")
+					expectStatus(t, res, body, want)
+				}
+			})
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()),
+		"COMPRESSION_MIN_SIZE=64B",
+		"MAX_DECOMPRESSED_BODY_SIZE=1KiB",
+	)
+	alice := g.register(t, "alice", "secret")
+	gzipped := func(s string) string {
+		var b bytes.Buffer
+		zw := gzip.NewWriter(&b)
+		_, _ = zw.Write([]byte(s))
+		_ = zw.Close()
+		return b.String()
+	}
+
+	t.Run("gzip request", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders/batch", alice, "application/json",
+			gzipped(`["12345678903", "9278923470", "346436439"]`), http.Header{"Content-Encoding": {"gzip"}})
+		expectStatus(t, res, body, http.StatusOK)
+	})
+
+	for _, encoding := range []string{"gzip", "deflate"} {
+		t.Run(encoding+" response", func(t *testing.T) {
+			res, body := g.doWithHeader(t, http.MethodGet, "/api/user/orders", alice, "", "", http.Header{"Accept-Encoding": {encoding}})
+			expectStatus(t, res, body, http.StatusOK)
+			if got := res.Header.Get("Content-Encoding"); got != encoding {
+				t.Fatalf("Content-Encoding = %q, want %q", got, encoding)
+			}
+			var zr io.Reader
+			var err error
+			if encoding == "gzip" {
+				zr, err = gzip.NewReader(bytes.NewReader(body))
+			} else {
+				zr, err = zlib.NewReader(bytes.NewReader(body))
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			decompressed, err := io.ReadAll(zr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(decompressed), `"number":"346436439"`) {
+				t.Errorf("the decompressed response %s has no orders", decompressed)
+			}
+		})
+	}
+
+	t.Run("small response", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodGet, "/api/user/orders/18", alice, "", "", http.Header{"Accept-Encoding": {"gzip"}})
+		expectStatus(t, res, body, http.StatusNotFound)
+		if got := res.Header.Get("Content-Encoding"); got != "" {
+			t.Errorf("the response smaller than the minimum size is compressed by %s", got)
+		}
+	})
+
+	t.Run("zip bomb", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders/batch", alice, "application/json",
+			gzipped(`["18"`+strings.Repeat(" ", 2048)+`]`), http.Header{"Content-Encoding": {"gzip"}})
+		expectStatus(t, res, body, http.StatusRequestEntityTooLarge)
+	})
+
+	t.Run("unsupported coding", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "18", http.Header{"Content-Encoding": {"br"}})
+		expectStatus(t, res, body, http.StatusUnsupportedMediaType)
+	})
+}
+
+func TestBodyLimit(t *testing.T) {
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()),
+		"MAX_BODY_SIZE=1KiB",
+		"MAX_BODY_SIZE_ROUTES=POST /api/user/orders/batch=8KiB",
+	)
+	alice := g.register(t, "alice", "secret")
+	padded := func(size int) string {
+		return `["18"` + strings.Repeat(" ", size) + `]`
+	}
+
+	t.Run("default limit", func(t *testing.T) {
+		res, body := g.do(t, http.MethodPost, "/api/user/login", "", "application/json", credentials("alice", strings.Repeat("s", 2048)))
+		expectStatus(t, res, body, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("default limit of the chunked body", func(t *testing.T) {
+		// the body of an unknown length is sent chunked
+		req, err := http.NewRequest(http.MethodPost, g.baseURL+"/api/user/login", io.NopCloser(strings.NewReader(credentials("alice", strings.Repeat("s", 2048)))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		res, err := g.client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		expectStatus(t, res, nil, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("route limit", func(t *testing.T) {
+		res, body := g.do(t, http.MethodPost, "/api/user/orders/batch", alice, "application/json", padded(4096))
+		expectStatus(t, res, body, http.StatusOK)
+		res, body = g.do(t, http.MethodPost, "/api/user/orders/batch", alice, "application/json", padded(8192))
+		expectStatus(t, res, body, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("header limit", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodGet, "/api/user/orders", alice, "", "", http.Header{"X-Padding": {strings.Repeat("p", 128<<10)}})
+		expectStatus(t, res, body, http.StatusRequestHeaderFieldsTooLarge)
+	})
+}
+
+func TestAPIDocs(t *testing.T) {
+	t.Run("spec", func(t *testing.T) {
+		g := startGophermart(t, startAccrual(t, accrual.DefaultScript()))
+		res, body := g.do(t, http.MethodGet, transportHTTP.OpenAPIJSONPath, "", "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		var spec struct {
+			OpenAPI string         `json:"openapi"`
+			Paths   map[string]any `json:"paths"`
+		}
+		if err := json.Unmarshal(body, &spec); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := spec.Paths["/api/user/orders"]; !ok || spec.OpenAPI == "" {
+			t.Fatalf("the spec doesn't describe the API: %s", body)
+		}
+		if _, ok := spec.Paths["/api/orders/{number}"]; ok {
+			t.Error("the spec describes the accrual system")
+		}
+
+		res, body = g.doWithHeader(t, http.MethodGet, transportHTTP.OpenAPIJSONPath, "", "", "", http.Header{"If-None-Match": {res.Header.Get("ETag")}})
+		expectStatus(t, res, body, http.StatusNotModified)
+
+		res, body = g.do(t, http.MethodGet, transportHTTP.OpenAPIYAMLPath, "", "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if got := res.Header.Get("Content-Type"); got != "application/yaml" {
+			t.Errorf("Content-Type = %q, want application/yaml", got)
+		}
+		if !strings.HasPrefix(string(body), "openapi: 3") {
+			t.Errorf("the YAML spec starts with %.20q", body)
+		}
+
+		res, body = g.do(t, http.MethodGet, transportHTTP.DocsPath, "", "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+	})
+
+	t.Run("docs UI", func(t *testing.T) {
+		g := startGophermart(t, startAccrual(t, accrual.DefaultScript()), "API_DOCS_UI=true")
+		res, body := g.do(t, http.MethodGet, transportHTTP.DocsPath, "", "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if !strings.HasPrefix(res.Header.Get("Content-Type"), "text/html") {
+			t.Errorf("Content-Type = %q, want text/html", res.Header.Get("Content-Type"))
+		}
+		if !strings.Contains(string(body), `src="`+transportHTTP.DocsAssetsPath+`/swagger-ui-bundle.js"`) {
+			t.Errorf("the page doesn't load the embedded Swagger UI assets: %s", body)
+		}
+
+		// the assets are served by the gophermart rather than a CDN
+		for asset, contentType := range map[string]string{
+			"/swagger-ui-bundle.js": "text/javascript",
+			"/swagger-ui.css":       "text/css",
+		} {
+			res, body = g.do(t, http.MethodGet, transportHTTP.DocsAssetsPath+asset, "", "", "")
+			expectStatus(t, res, body, http.StatusOK)
+			if !strings.HasPrefix(res.Header.Get("Content-Type"), contentType) || len(body) == 0 {
+				t.Errorf("got the %s asset of %d bytes and Content-Type %q, want %s", asset, len(body), res.Header.Get("Content-Type"), contentType)
+			}
+		}
+	})
+
+	t.Run("assets URL", func(t *testing.T) {
+		g := startGophermart(t, startAccrual(t, accrual.DefaultScript()),
+			"API_DOCS_UI=true",
+			"API_DOCS_ASSETS_URL=/assets/swagger-ui/",
+		)
+		res, body := g.do(t, http.MethodGet, transportHTTP.DocsPath, "", "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if !strings.Contains(string(body), `src="/assets/swagger-ui/swagger-ui-bundle.js"`) {
+			t.Errorf("the page doesn't load the Swagger UI assets: %s", body)
+		}
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	address := freeAddress(t)
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()), "METRICS_ADDRESS="+address)
+	waitForListener(t, address)
+
+	res, body := g.do(t, http.MethodGet, transportHTTP.MetricsPath, "", "", "")
+	expectStatus(t, res, body, http.StatusNotFound)
+
+	metrics := &gophermart{baseURL: "http://" + address, client: g.client}
+	res, body = metrics.do(t, http.MethodGet, transportHTTP.MetricsPath, "", "", "")
+	expectStatus(t, res, body, http.StatusOK)
+	vars := decode[map[string]json.RawMessage](t, body)
+	if _, ok := vars["config_reloads"]; !ok {
+		t.Errorf("got the metrics %s, want the config reloads", body)
+	}
+	if _, ok := vars["cmdline"]; ok {
+		t.Errorf("the metrics expose the command line: %s", body)
+	}
+}
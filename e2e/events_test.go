@@ -0,0 +1,175 @@
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+)
+
+// sseEvent is an event of the stream of the order events
+type sseEvent struct {
+	ID   string
+	Type string
+	Data struct {
+		Number  string        `json:"number"`
+		From    string        `json:"from"`
+		Status  string        `json:"status"`
+		Accrual *money.Amount `json:"accrual"`
+	}
+}
+
+// openEvents opens the stream of the order events of the user and returns the channel of its events.
+// The stream is closed when the test finishes
+func (g *gophermart) openEvents(t *testing.T, token, lastEventID string) <-chan sseEvent {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/api/user/orders/events", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", token)
+	req.Header.Set("Accept-Encoding", "gzip") // the stream isn't compressed
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	res, err := http.DefaultClient.Do(req) // the stream outlives the timeout of the client of the gophermart
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "text/event-stream" || res.Header.Get("Content-Encoding") != "" {
+		res.Body.Close()
+		t.Fatalf("got status %d, content type %q and encoding %q, want the event stream",
+			res.StatusCode, res.Header.Get("Content-Type"), res.Header.Get("Content-Encoding"))
+	}
+
+	events := make(chan sseEvent)
+	go func() {
+		defer res.Body.Close()
+		defer close(events)
+		var e sseEvent
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			field, value, _ := strings.Cut(scanner.Text(), ": ")
+			switch field {
+			case "id":
+				e.ID = value
+			case "event":
+				e.Type = value
+			case "data":
+				_ = json.Unmarshal([]byte(value), &e.Data)
+			case "":
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return
+				}
+				e = sseEvent{}
+			}
+		}
+	}()
+	return events
+}
+
+// nextEvent returns the next event of the stream skipping the heartbeats unless they are wanted
+func nextEvent(t *testing.T, events <-chan sseEvent, heartbeat bool) sseEvent {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				t.Fatal("the stream is closed")
+			}
+			if e.Type == "heartbeat" && !heartbeat {
+				continue
+			}
+			return e
+		case <-timeout:
+			t.Fatal("no event in 5s")
+		}
+	}
+}
+
+func TestOrderEvents(t *testing.T) {
+	accrualSum, err := money.Parse("729.98")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := accrual.Script{
+		Rules:      []accrual.Rule{{Prefix: "1234", Accrual: &accrualSum}},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	g := startGophermart(t, startAccrual(t, script),
+		"ACCRUAL_POLL_INTERVAL=100ms",
+		"ORDER_EVENTS_HEARTBEAT=200ms",
+		"HTTP_WRITE_TIMEOUT=3s", // the streams outlive it
+	)
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	opened := time.Now()
+	events := g.openEvents(t, alice, "")
+	if e := nextEvent(t, events, true); e.Type != "heartbeat" || e.ID != "" {
+		t.Fatalf("got the first event %+v, want a heartbeat without ID", e)
+	}
+	bobEvents := g.openEvents(t, bob, "0")
+
+	res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903")
+	expectStatus(t, res, body, http.StatusAccepted)
+
+	var got []sseEvent
+	for len(got) == 0 || got[len(got)-1].Data.Status != "PROCESSED" {
+		e := nextEvent(t, events, false)
+		if e.Type != "status" || e.ID == "" || e.Data.Number != "12345678903" {
+			t.Fatalf("got event %+v, want the status of the uploaded order", e)
+		}
+		got = append(got, e)
+	}
+	if got[0].Data.Status != "NEW" || got[0].Data.From != "" {
+		t.Errorf("got the first status %+v, want NEW", got[0].Data)
+	}
+	if last := got[len(got)-1].Data; last.Accrual == nil || *last.Accrual != accrualSum {
+		t.Errorf("got the processed order %+v, want the accrual %s", last, accrualSum)
+	}
+
+	t.Run("heartbeats outlive the write timeout", func(t *testing.T) {
+		for time.Since(opened) < 4*time.Second {
+			nextEvent(t, events, true)
+		}
+	})
+
+	t.Run("resume from Last-Event-ID", func(t *testing.T) {
+		resumed := g.openEvents(t, alice, got[0].ID)
+		for _, want := range got[1:] {
+			if e := nextEvent(t, resumed, false); e.ID != want.ID || e.Data.Status != want.Data.Status {
+				t.Errorf("got event %s %s, want %s %s", e.ID, e.Data.Status, want.ID, want.Data.Status)
+			}
+		}
+	})
+
+	t.Run("the events of the other users aren't sent", func(t *testing.T) {
+		for range 3 {
+			if e := nextEvent(t, bobEvents, true); e.Type != "heartbeat" {
+				t.Fatalf("got event %+v, want the heartbeats only", e)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		res, body := g.doWithHeader(t, http.MethodGet, "/api/user/orders/events", alice, "", "", http.Header{"Last-Event-Id": {"x"}})
+		expectStatus(t, res, body, http.StatusBadRequest)
+		res, body = g.do(t, http.MethodGet, "/api/user/orders/events", "", "", "")
+		expectStatus(t, res, body, http.StatusUnauthorized)
+		res, body = g.do(t, http.MethodGet, "/api/user/orders/events", "invalid", "", "")
+		expectStatus(t, res, body, http.StatusUnauthorized)
+	})
+}
@@ -0,0 +1,214 @@
+package e2e
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// startGRPC runs the gophermart with the gRPC server set up by the env and the accrual system run by the script.
+// It returns the clients of the gophermart services
+func startGRPC(t *testing.T, script accrual.Script, env ...string) (userpb.UserClient, balancepb.BalanceClient) {
+	t.Helper()
+	address := freeAddress(t)
+	startGophermart(t, startAccrual(t, script), append([]string{"GRPC_ADDRESS=" + address, "ACCRUAL_POLL_INTERVAL=100ms"}, env...)...)
+	waitForListener(t, address)
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return userpb.NewUserClient(conn), balancepb.NewBalanceClient(conn)
+}
+
+// withToken returns the context of the call authenticated by the token with the metadata key value pairs
+func withToken(token string, kv ...string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), append([]string{"authorization", token}, kv...)...)
+}
+
+func expectCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Errorf("got code %s, want %s: %v", got, want, err)
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	accrualSum, err := money.Parse("729.98")
+	if err != nil {
+		t.Fatal(err)
+	}
+	users, balance := startGRPC(t, accrual.Script{
+		Rules:      []accrual.Rule{{Prefix: "1234", Accrual: &accrualSum}},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	res, err := users.Register(ctx, &userpb.RegisterRequest{Login: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := res.GetAuthToken()
+	if alice == "" {
+		t.Fatal("register: no token")
+	}
+	bob, err := users.Register(ctx, &userpb.RegisterRequest{Login: "bob", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("login", func(t *testing.T) {
+		res, err := users.Login(ctx, &userpb.LoginRequest{Login: "alice", Password: "secret"})
+		if err != nil || res.GetAuthToken() == "" {
+			t.Fatalf("login: %v, token %q", err, res.GetAuthToken())
+		}
+		_, err = users.Login(ctx, &userpb.LoginRequest{Login: "alice", Password: "wrong"})
+		expectCode(t, err, codes.Unauthenticated)
+	})
+	t.Run("login is taken", func(t *testing.T) {
+		_, err := users.Register(ctx, &userpb.RegisterRequest{Login: "alice", Password: "secret"})
+		expectCode(t, err, codes.AlreadyExists)
+	})
+
+	t.Run("upload and get the order", func(t *testing.T) {
+		upload, err := balance.UploadUserOrder(withToken(alice), &balancepb.UploadUserOrderRequest{OrderNumber: "12345678903"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if upload.GetAccepted() == "" {
+			t.Error("the new order isn't accepted")
+		}
+		order, err := balance.GetUserOrder(withToken(alice), &balancepb.GetUserOrderRequest{Number: "12345678903"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if order.GetStatus() == "" || len(order.GetHistory()) == 0 {
+			t.Errorf("got order %v, want its status and history", order)
+		}
+		list, err := balance.ListUserOrder(withToken(alice), &balancepb.ListUserOrderRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.GetOrders()) != 1 || list.GetOrders()[0].GetNumber() != "12345678903" {
+			t.Errorf("got orders %v, want the uploaded order", list.GetOrders())
+		}
+	})
+
+	t.Run("accrual", func(t *testing.T) {
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			order, err := balance.GetUserOrder(withToken(alice), &balancepb.GetUserOrderRequest{Number: "12345678903"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if order.GetStatus() == "PROCESSED" {
+				// the accrual is the exact number of the minor units
+				if got := order.GetAccrual(); got != accrualSum.Minor() {
+					t.Errorf("got the accrual %d, want %d", got, accrualSum.Minor())
+				}
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("got order %v, want it processed", order)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		list, err := balance.ListUserOrder(withToken(alice), &balancepb.ListUserOrderRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := list.GetOrders()[0].GetAccrual(); got != accrualSum.Minor() {
+			t.Errorf("got the accrual %d in the list, want %d", got, accrualSum.Minor())
+		}
+	})
+
+	t.Run("error codes", func(t *testing.T) {
+		_, err := balance.UploadUserOrder(withToken(bob.GetAuthToken()), &balancepb.UploadUserOrderRequest{OrderNumber: "12345678903"})
+		expectCode(t, err, codes.AlreadyExists)
+		_, err = balance.UploadUserOrder(withToken(alice), &balancepb.UploadUserOrderRequest{OrderNumber: "12345678904"})
+		expectCode(t, err, codes.InvalidArgument)
+		_, err = balance.GetUserOrder(withToken(bob.GetAuthToken()), &balancepb.GetUserOrderRequest{Number: "12345678903"})
+		expectCode(t, err, codes.NotFound)
+		_, err = balance.ListUserOrder(withToken("invalid"), &balancepb.ListUserOrderRequest{})
+		expectCode(t, err, codes.Unauthenticated)
+		_, err = balance.ListUserOrder(ctx, &balancepb.ListUserOrderRequest{})
+		expectCode(t, err, codes.Unauthenticated)
+	})
+}
+
+func TestGRPCInterceptors(t *testing.T) {
+	users, balance := startGRPC(t, accrual.DefaultScript(),
+		"RATE_LIMIT_ROUTES=POST "+balancepb.Balance_ListUserOrder_FullMethodName+"=1/m:2",
+		"MAX_BODY_SIZE=1KiB",
+	)
+	res, err := users.Register(context.Background(), &userpb.RegisterRequest{Login: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := res.GetAuthToken()
+
+	t.Run("rate limit", func(t *testing.T) {
+		for range 2 {
+			if _, err := balance.ListUserOrder(withToken(alice), &balancepb.ListUserOrderRequest{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var header metadata.MD
+		_, err := balance.ListUserOrder(withToken(alice), &balancepb.ListUserOrderRequest{}, grpc.Header(&header))
+		expectCode(t, err, codes.ResourceExhausted)
+		if len(header.Get("retry-after")) == 0 {
+			t.Errorf("got the header %v, want retry-after", header)
+		}
+		// the other methods have their own buckets
+		_, err = balance.GetUserOrder(withToken(alice), &balancepb.GetUserOrderRequest{Number: "12345678903"})
+		expectCode(t, err, codes.NotFound)
+	})
+
+	t.Run("idempotency", func(t *testing.T) {
+		upload := func(key, number string) (metadata.MD, error) {
+			var header metadata.MD
+			_, err := balance.UploadUserOrder(withToken(alice, "idempotency-key", key), &balancepb.UploadUserOrderRequest{OrderNumber: number}, grpc.Header(&header))
+			return header, err
+		}
+		if _, err := upload("upload-1", "12345678903"); err != nil {
+			t.Fatal(err)
+		}
+		header, err := upload("upload-1", "12345678903")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := header.Get("idempotent-replayed"); len(got) == 0 || got[0] != "true" {
+			t.Errorf("got the header %v, want the replayed response", header)
+		}
+		_, err = upload("upload-1", "79927398713")
+		expectCode(t, err, codes.InvalidArgument)
+
+		// the error of the service is replayed as well
+		_, err = upload("upload-2", "12345678904")
+		expectCode(t, err, codes.InvalidArgument)
+		header, err = upload("upload-2", "12345678904")
+		expectCode(t, err, codes.InvalidArgument)
+		if len(header.Get("idempotent-replayed")) == 0 {
+			t.Errorf("got the header %v, want the replayed error", header)
+		}
+	})
+
+	t.Run("message size", func(t *testing.T) {
+		_, err := balance.UploadUserOrder(withToken(alice), &balancepb.UploadUserOrderRequest{OrderNumber: strings.Repeat("1", 2<<10)})
+		expectCode(t, err, codes.ResourceExhausted)
+	})
+}
@@ -0,0 +1,252 @@
+package e2e
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+)
+
+type outboxEvent struct {
+	ID     int64  `json:"id"`
+	Type   string `json:"type"`
+	UserID string `json:"user_id"`
+	Data   struct {
+		Number  string        `json:"number"`
+		Status  string        `json:"status"`
+		Accrual *money.Amount `json:"accrual"`
+		Order   string        `json:"order"`
+		Sum     money.Amount  `json:"sum"`
+	} `json:"data"`
+}
+
+// startSink starts the HTTP sink which fails the events the fail func reports and records the rest.
+// The fail func is called under the lock of the sink
+func startSink(t *testing.T, fail func(outboxEvent) bool) (url string, received func() []outboxEvent) {
+	t.Helper()
+	var (
+		mu     sync.Mutex
+		events []outboxEvent
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		e := decode[outboxEvent](t, body)
+		if fail(e) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get(outbox.HeaderEventID) != strconv.FormatInt(e.ID, 10) {
+			t.Errorf("got the event ID header %q, want %d", r.Header.Get(outbox.HeaderEventID), e.ID)
+		}
+		events = append(events, e)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, func() []outboxEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]outboxEvent(nil), events...)
+	}
+}
+
+// byUser groups the events by their users in the order they are received
+func byUser(events []outboxEvent) map[string][]outboxEvent {
+	users := make(map[string][]outboxEvent)
+	for _, e := range events {
+		users[e.UserID] = append(users[e.UserID], e)
+	}
+	return users
+}
+
+func TestOutbox(t *testing.T) {
+	accrualSum, err := money.Parse("729.98")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := accrual.Script{
+		Rules: []accrual.Rule{
+			{Prefix: "1234", Accrual: &accrualSum},
+			{Prefix: "7992", Status: accrual.StatusInvalid},
+		},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	failures := 3
+	sinkURL, received := startSink(t, func(outboxEvent) bool {
+		failures--
+		return failures >= 0
+	})
+	file := filepath.Join(t.TempDir(), "events.jsonl")
+	g := startGophermart(t, startAccrual(t, script),
+		"ACCRUAL_POLL_INTERVAL=100ms",
+		"OUTBOX_POLL_INTERVAL=100ms",
+		"OUTBOX_SINKS=file:"+file+","+sinkURL,
+	)
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903")
+	expectStatus(t, res, body, http.StatusAccepted)
+	res, body = g.do(t, http.MethodPost, "/api/user/orders/batch", bob, "text/plain", "79927398713\n1234567812345670")
+	expectStatus(t, res, body, http.StatusOK)
+
+	// alice: uploaded, processed, accrued; bob: 2 uploaded, invalid, processed, accrued
+	const want = 8
+	deadline := time.Now().Add(10 * time.Second)
+	var events []outboxEvent
+	for events = received(); len(events) < want; events = received() {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d events %+v, want %d", len(events), events, want)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	time.Sleep(300 * time.Millisecond) // the published events aren't published again
+	if events = received(); len(events) != want {
+		t.Fatalf("got %d events %+v, want %d", len(events), events, want)
+	}
+
+	users := byUser(events)
+	if len(users) != 2 {
+		t.Fatalf("got the events of %d users, want 2", len(users))
+	}
+	t.Run("ordered per user", func(t *testing.T) {
+		for user, events := range users {
+			for i := 1; i < len(events); i++ {
+				if events[i].ID <= events[i-1].ID {
+					t.Errorf("user %s: got the event %d after %d", user, events[i].ID, events[i-1].ID)
+				}
+			}
+			orders := make(map[string][]string)
+			for _, e := range events {
+				number := e.Data.Number + e.Data.Order
+				orders[number] = append(orders[number], e.Type+" "+e.Data.Status)
+			}
+			for number, types := range orders {
+				var wantTypes []string
+				switch number {
+				case "12345678903", "1234567812345670":
+					wantTypes = []string{"OrderUploaded NEW", "OrderProcessed PROCESSED", "PointsAccrued "}
+				case "79927398713":
+					wantTypes = []string{"OrderUploaded NEW", "OrderProcessed INVALID"}
+				default:
+					t.Fatalf("got the events %v of the unknown order %s", types, number)
+				}
+				if len(types) != len(wantTypes) {
+					t.Errorf("order %s: got the events %v, want %v", number, types, wantTypes)
+					continue
+				}
+				for i := range types {
+					if types[i] != wantTypes[i] {
+						t.Errorf("order %s: got the events %v, want %v", number, types, wantTypes)
+						break
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("accrued points", func(t *testing.T) {
+		for _, e := range events {
+			if e.Type == "OrderProcessed" && e.Data.Status == "PROCESSED" && (e.Data.Accrual == nil || *e.Data.Accrual != accrualSum) {
+				t.Errorf("got the processed order %+v, want the accrual %s", e.Data, accrualSum)
+			}
+			if e.Type == "PointsAccrued" && e.Data.Sum != accrualSum {
+				t.Errorf("got the accrued points %+v, want %s", e.Data, accrualSum)
+			}
+		}
+	})
+
+	t.Run("at least once to the file", func(t *testing.T) {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		lines, ids := 0, make(map[int64]bool)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var e outboxEvent
+			if err = json.Unmarshal(scanner.Bytes(), &e); err != nil {
+				t.Fatalf("failed to decode the line %s: %v", scanner.Bytes(), err)
+			}
+			lines++
+			ids[e.ID] = true
+		}
+		for _, e := range events {
+			if !ids[e.ID] {
+				t.Errorf("the event %d isn't in the file", e.ID)
+			}
+		}
+		// the events failed by the HTTP sink are appended again
+		if lines <= want {
+			t.Errorf("got %d lines, want the retried events appended again", lines)
+		}
+	})
+}
+
+func TestOutboxDeadEvents(t *testing.T) {
+	script := accrual.Script{
+		Rules:      []accrual.Rule{{Prefix: "7992", Status: accrual.StatusInvalid}},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	var attempts atomic.Int32
+	// the sink rejects the upload of the alice's order every time
+	sinkURL, received := startSink(t, func(e outboxEvent) bool {
+		if e.Type == "OrderUploaded" && e.Data.Number == "79927398713" {
+			attempts.Add(1)
+			return true
+		}
+		return false
+	})
+	g := startGophermart(t, startAccrual(t, script),
+		"ACCRUAL_POLL_INTERVAL=100ms",
+		"OUTBOX_POLL_INTERVAL=100ms",
+		"OUTBOX_MAX_ATTEMPTS=3",
+		"OUTBOX_SINKS="+sinkURL,
+	)
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "79927398713")
+	expectStatus(t, res, body, http.StatusAccepted)
+	res, body = g.do(t, http.MethodPost, "/api/user/orders", bob, "text/plain", "12345678903")
+	expectStatus(t, res, body, http.StatusAccepted)
+
+	// the invalid order of alice follows the dead upload, the upload of bob isn't held back by it
+	deadline := time.Now().Add(10 * time.Second)
+	var events []outboxEvent
+	for events = received(); len(events) < 2; events = received() {
+		if time.Now().After(deadline) {
+			t.Fatalf("got the events %+v, want the upload of bob and the invalid order of alice", events)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	time.Sleep(500 * time.Millisecond) // the dead event isn't published again
+	if events = received(); len(events) != 2 {
+		t.Fatalf("got %d events %+v, want 2", len(events), events)
+	}
+
+	got := make(map[string]bool)
+	for _, e := range events {
+		got[e.Type+" "+e.Data.Number] = true
+	}
+	if !got["OrderUploaded 12345678903"] || !got["OrderProcessed 79927398713"] {
+		t.Errorf("got the events %+v, want the upload of bob and the invalid order of alice", events)
+	}
+	if n := attempts.Load(); n != 3 {
+		t.Errorf("got %d attempts of the dead event, want 3", n)
+	}
+}
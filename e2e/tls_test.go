@@ -0,0 +1,254 @@
+package e2e
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+	transportHTTP "github.com/oleshko-g/oggophermart/internal/transport/http"
+	"goa.design/clue/log"
+)
+
+// testCA issues the certificates of the tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string // the PEM certificate of the CA
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "e2e CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := &testCA{cert: cert, key: key, file: filepath.Join(t.TempDir(), "ca.pem")}
+	writePEM(t, ca.file, "CERTIFICATE", der)
+	return ca
+}
+
+// pool returns the pool of the CA certificate
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+// issue writes the certificate of the serial for localhost and its key to the files in the dir.
+// The certificate authenticates the servers and the clients
+func (ca *testCA) issue(t *testing.T, dir string, serial int64) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	writePEM(t, certFile, "CERTIFICATE", der)
+	return certFile, keyFile
+}
+
+// keyPair returns the certificate and the key in the files
+func keyPair(t *testing.T, certFile, keyFile string) tls.Certificate {
+	t.Helper()
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+	// the file is replaced at once so it's never read half written
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// useTLS makes the requests to the gophermart by HTTPS with the TLS config
+func (g *gophermart) useTLS(cfg *tls.Config) {
+	g.baseURL = strings.Replace(g.baseURL, "http://", "https://", 1)
+	g.client = &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig:   cfg,
+			DisableKeepAlives: true, // every request gets the current certificate of the server
+		},
+	}
+}
+
+// startAccrualTLS runs the accrual system stub by HTTPS with the TLS config in the test process and returns its address
+func startAccrualTLS(t *testing.T, script accrual.Script, cfg *tls.Config) string {
+	t.Helper()
+	ctx := log.Context(context.Background(), log.WithOutput(io.Discard))
+	srv := httptest.NewUnstartedServer(transportHTTP.NewAccrualHandler(ctx, accrual.New(script)))
+	srv.TLS = cfg
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return fmt.Sprintf("https://localhost:%d", srv.Listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestTLS(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	certFile, keyFile := ca.issue(t, dir, 2)
+
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()),
+		"TLS_CERT_FILE="+certFile,
+		"TLS_KEY_FILE="+keyFile,
+		"TLS_MIN_VERSION=1.3",
+		"TLS_RELOAD_INTERVAL=100ms",
+	)
+	g.useTLS(&tls.Config{RootCAs: ca.pool()})
+
+	res, body := g.do(t, http.MethodPost, "/api/user/register", "", "application/json", credentials("alice", "secret"))
+	expectStatus(t, res, body, http.StatusOK)
+	if res.TLS == nil || res.TLS.Version != tls.VersionTLS13 {
+		t.Fatalf("the response isn't sent by TLS 1.3: %+v", res.TLS)
+	}
+
+	t.Run("TLS 1.2 is rejected", func(t *testing.T) {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: ca.pool(), MaxVersion: tls.VersionTLS12},
+		}}
+		if res, err := client.Get(g.baseURL + "/api/user/orders"); err == nil {
+			res.Body.Close()
+			t.Error("the TLS 1.2 connection is established")
+		}
+	})
+
+	t.Run("certificate reload", func(t *testing.T) {
+		ca.issue(t, dir, 3)
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+			res, _ := g.do(t, http.MethodGet, "/api/user/orders", "", "", "")
+			if res.TLS.PeerCertificates[0].SerialNumber.Int64() == 3 {
+				return
+			}
+		}
+		t.Error("the server doesn't present the new certificate")
+	})
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issue(t, t.TempDir(), 2)
+	clientCertFile, clientKeyFile := ca.issue(t, t.TempDir(), 3)
+
+	g := startGophermart(t, startAccrual(t, accrual.DefaultScript()),
+		"TLS_CERT_FILE="+certFile,
+		"TLS_KEY_FILE="+keyFile,
+		"TLS_CLIENT_CA_FILE="+ca.file,
+	)
+
+	t.Run("without the client certificate", func(t *testing.T) {
+		g.useTLS(&tls.Config{RootCAs: ca.pool()})
+		req, _ := http.NewRequest(http.MethodGet, g.baseURL+"/api/user/orders", nil)
+		if res, err := g.client.Do(req); err == nil {
+			res.Body.Close()
+			t.Error("the connection without the client certificate is established")
+		}
+	})
+
+	t.Run("with the client certificate", func(t *testing.T) {
+		g.useTLS(&tls.Config{
+			RootCAs:      ca.pool(),
+			Certificates: []tls.Certificate{keyPair(t, clientCertFile, clientKeyFile)},
+		})
+		res, body := g.do(t, http.MethodPost, "/api/user/register", "", "application/json", credentials("alice", "secret"))
+		expectStatus(t, res, body, http.StatusOK)
+	})
+}
+
+func TestAccrualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	accrualCertFile, accrualKeyFile := ca.issue(t, t.TempDir(), 2)
+	clientCertFile, clientKeyFile := ca.issue(t, t.TempDir(), 3)
+
+	accrualSum := money.Points(500)
+	script := accrual.Script{
+		Rules: []accrual.Rule{{Prefix: "1234", Accrual: &accrualSum}},
+	}
+	// the accrual system verifies the certificate of the gophermart
+	accrualAddress := startAccrualTLS(t, script, &tls.Config{
+		Certificates: []tls.Certificate{keyPair(t, accrualCertFile, accrualKeyFile)},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    ca.pool(),
+	})
+
+	g := startGophermart(t, accrualAddress,
+		"ACCRUAL_POLL_INTERVAL=100ms",
+		"ACCRUAL_TLS_CA_FILE="+ca.file,
+		"ACCRUAL_TLS_CERT_FILE="+clientCertFile,
+		"ACCRUAL_TLS_KEY_FILE="+clientKeyFile,
+	)
+	alice := g.register(t, "alice", "secret")
+	res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903")
+	expectStatus(t, res, body, http.StatusAccepted)
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		res, body := g.do(t, http.MethodGet, "/api/user/orders", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if strings.Contains(string(body), `"status":"PROCESSED"`) {
+			return
+		}
+	}
+	t.Error("the order isn't processed by the accrual system")
+}
@@ -0,0 +1,308 @@
+package e2e
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/service/accrual"
+	"github.com/oleshko-g/oggophermart/internal/service/webhook"
+)
+
+type webhookResult struct {
+	ID       string   `json:"id"`
+	URL      string   `json:"url"`
+	Statuses []string `json:"statuses"`
+	Active   bool     `json:"active"`
+	Secret   *string  `json:"secret"`
+}
+
+type webhookDelivery struct {
+	ID          int64   `json:"id"`
+	Event       string  `json:"event"`
+	Number      string  `json:"number"`
+	State       string  `json:"state"`
+	Attempts    int     `json:"attempts"`
+	LastError   *string `json:"last_error"`
+	DeliveredAt *string `json:"delivered_at"`
+}
+
+// webhookRequest is a delivery received by the partner
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// startPartner starts the partner which receives the deliveries. It fails them while failing is set
+func startPartner(t *testing.T, failing *atomic.Bool) (url string, received <-chan webhookRequest) {
+	t.Helper()
+	ch := make(chan webhookRequest, 100)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		ch <- webhookRequest{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL + "/events", ch
+}
+
+func decode[T any](t *testing.T, body []byte) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(body, &v); err != nil {
+		t.Fatalf("failed to decode %s: %v", body, err)
+	}
+	return v
+}
+
+// waitForDeliveries polls the deliveries of the webhook until there are n of them in the state
+func (g *gophermart) waitForDeliveries(t *testing.T, token, webhookID, state string, n int) []webhookDelivery {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		res, body := g.do(t, http.MethodGet, "/api/user/webhooks/"+webhookID+"/deliveries?state="+state, token, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		deliveries := decode[[]webhookDelivery](t, body)
+		if len(deliveries) == n {
+			return deliveries
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d %s deliveries, want %d", len(deliveries), state, n)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	accrualSum, err := money.Parse("729.98")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := accrual.Script{
+		Rules: []accrual.Rule{
+			{Prefix: "1234", Accrual: &accrualSum},
+			{Prefix: "7992", Status: accrual.StatusInvalid},
+		},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	g := startGophermart(t, startAccrual(t, script),
+		"ACCRUAL_POLL_INTERVAL=100ms",
+		"WEBHOOK_POLL_INTERVAL=100ms",
+		"WEBHOOK_BACKOFF=100ms",
+		"WEBHOOK_MAX_ATTEMPTS=2",
+		"WEBHOOK_DEV_MODE=true", // the partners listen on the loopback interface
+	)
+	alice := g.register(t, "alice", "secret")
+	bob := g.register(t, "bob", "secret")
+
+	var partnerFailing, flakyFailing atomic.Bool
+	partnerURL, received := startPartner(t, &partnerFailing)
+	flakyURL, flakyReceived := startPartner(t, &flakyFailing)
+	flakyFailing.Store(true)
+
+	res, body := g.do(t, http.MethodPost, "/api/user/webhooks", alice, "application/json",
+		`{"url":"`+partnerURL+`","statuses":["PROCESSED"]}`)
+	expectStatus(t, res, body, http.StatusCreated)
+	processed := decode[webhookResult](t, body)
+	if processed.Secret == nil || !strings.HasPrefix(*processed.Secret, "whsec_") || !processed.Active {
+		t.Fatalf("got the webhook %s, want an active one with the secret", body)
+	}
+
+	res, body = g.do(t, http.MethodPost, "/api/user/webhooks", alice, "application/json", `{"url":"`+flakyURL+`"}`)
+	expectStatus(t, res, body, http.StatusCreated)
+	flaky := decode[webhookResult](t, body)
+	if len(flaky.Statuses) != 2 {
+		t.Errorf("got the statuses %v, want both by default", flaky.Statuses)
+	}
+
+	t.Run("CRUD", func(t *testing.T) {
+		res, body := g.do(t, http.MethodGet, "/api/user/webhooks", alice, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if list := decode[[]webhookResult](t, body); len(list) != 2 || list[0].ID != processed.ID || list[0].Secret != nil {
+			t.Errorf("got the webhooks %s, want both in the order of creation without the secrets", body)
+		}
+
+		res, body = g.do(t, http.MethodGet, "/api/user/webhooks", bob, "", "")
+		expectStatus(t, res, body, http.StatusOK)
+		if strings.TrimSpace(string(body)) != "[]" {
+			t.Errorf("got the webhooks %s, want none", body)
+		}
+		res, body = g.do(t, http.MethodGet, "/api/user/webhooks/"+processed.ID, bob, "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+
+		res, body = g.do(t, http.MethodPost, "/api/user/webhooks", bob, "application/json", `{"url":"ftp://partner.example/events"}`)
+		expectStatus(t, res, body, http.StatusBadRequest)
+		res, body = g.do(t, http.MethodPost, "/api/user/webhooks", bob, "application/json", `{"url":"`+partnerURL+`","statuses":[]}`)
+		expectStatus(t, res, body, http.StatusBadRequest)
+		res, body = g.do(t, http.MethodPost, "/api/user/webhooks", "", "application/json", `{"url":"`+partnerURL+`"}`)
+		expectStatus(t, res, body, http.StatusUnauthorized)
+
+		res, body = g.do(t, http.MethodPost, "/api/user/webhooks", bob, "application/json", `{"url":"`+partnerURL+`"}`)
+		expectStatus(t, res, body, http.StatusCreated)
+		id := decode[webhookResult](t, body).ID
+		res, body = g.do(t, http.MethodPut, "/api/user/webhooks/"+id, bob, "application/json",
+			`{"url":"https://partner.example/v2","statuses":["INVALID"],"active":false}`)
+		expectStatus(t, res, body, http.StatusOK)
+		if w := decode[webhookResult](t, body); w.URL != "https://partner.example/v2" || w.Active || len(w.Statuses) != 1 || w.Secret != nil {
+			t.Errorf("got the updated webhook %s", body)
+		}
+		res, body = g.do(t, http.MethodDelete, "/api/user/webhooks/"+id, bob, "", "")
+		expectStatus(t, res, body, http.StatusNoContent)
+		res, body = g.do(t, http.MethodGet, "/api/user/webhooks/"+id, bob, "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+		res, body = g.do(t, http.MethodDelete, "/api/user/webhooks/"+id, bob, "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+	})
+
+	// the partner redirects the deliveries to the flaky one
+	redirecting := httptest.NewServer(http.RedirectHandler(flakyURL, http.StatusFound))
+	t.Cleanup(redirecting.Close)
+	res, body = g.do(t, http.MethodPost, "/api/user/webhooks", alice, "application/json",
+		`{"url":"`+redirecting.URL+`","statuses":["PROCESSED"]}`)
+	expectStatus(t, res, body, http.StatusCreated)
+	redirected := decode[webhookResult](t, body)
+
+	for _, number := range []string{"12345678903", "79927398713"} {
+		res, body := g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", number)
+		expectStatus(t, res, body, http.StatusAccepted)
+	}
+
+	t.Run("redirects aren't followed", func(t *testing.T) {
+		dead := g.waitForDeliveries(t, alice, redirected.ID, "dead", 1)
+		if d := dead[0]; d.LastError == nil || *d.LastError != "the webhook responded with 302 Found" {
+			t.Errorf("got the delivery %+v, want it failed by the redirect", d)
+		}
+	})
+
+	t.Run("signed delivery", func(t *testing.T) {
+		var r webhookRequest
+		select {
+		case r = <-received:
+		case <-time.After(10 * time.Second):
+			t.Fatal("no delivery in 10s")
+		}
+		timestamp := r.header.Get(webhook.HeaderTimestamp)
+		if got, want := r.header.Get(webhook.HeaderSignature), webhook.Sign(*processed.Secret, timestamp, r.body); got != want {
+			t.Errorf("got the signature %q, want %q", got, want)
+		}
+		if r.header.Get(webhook.HeaderEvent) != "order.processed" || r.header.Get(webhook.HeaderDelivery) == "" {
+			t.Errorf("got the headers %v", r.header)
+		}
+		e := decode[struct {
+			ID   int64  `json:"id"`
+			Type string `json:"type"`
+			Data struct {
+				Number  string        `json:"number"`
+				Status  string        `json:"status"`
+				Accrual *money.Amount `json:"accrual"`
+			} `json:"data"`
+		}](t, r.body)
+		if e.Type != "order.processed" || e.Data.Number != "12345678903" || e.Data.Accrual == nil || *e.Data.Accrual != accrualSum {
+			t.Errorf("got the event %s", r.body)
+		}
+		if strconv.FormatInt(e.ID, 10) != r.header.Get(webhook.HeaderDelivery) {
+			t.Errorf("got the event ID %d, want the delivery %s", e.ID, r.header.Get(webhook.HeaderDelivery))
+		}
+
+		deliveries := g.waitForDeliveries(t, alice, processed.ID, "delivered", 1)
+		if deliveries[0].DeliveredAt == nil || deliveries[0].Attempts != 0 {
+			t.Errorf("got the delivery %+v, want it delivered at the first attempt", deliveries[0])
+		}
+		select {
+		case r = <-received:
+			t.Errorf("got the unsubscribed delivery %s", r.body)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+
+	t.Run("dead after the attempts and redelivered", func(t *testing.T) {
+		dead := g.waitForDeliveries(t, alice, flaky.ID, "dead", 2)
+		for _, d := range dead {
+			if d.Attempts != 2 || d.LastError == nil {
+				t.Errorf("got the dead delivery %+v, want 2 failed attempts", d)
+			}
+		}
+
+		flakyFailing.Store(false)
+		path := "/api/user/webhooks/" + flaky.ID + "/deliveries/" + strconv.FormatInt(dead[0].ID, 10) + "/redeliver"
+		res, body := g.do(t, http.MethodPost, path, bob, "", "")
+		expectStatus(t, res, body, http.StatusNotFound)
+		res, body = g.do(t, http.MethodPost, path, alice, "", "")
+		expectStatus(t, res, body, http.StatusAccepted)
+
+		select {
+		case r := <-flakyReceived:
+			if r.header.Get(webhook.HeaderDelivery) != strconv.FormatInt(dead[0].ID, 10) {
+				t.Errorf("got the delivery %s, want %d", r.header.Get(webhook.HeaderDelivery), dead[0].ID)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("no redelivery in 10s")
+		}
+		g.waitForDeliveries(t, alice, flaky.ID, "delivered", 1)
+		g.waitForDeliveries(t, alice, flaky.ID, "dead", 1)
+	})
+}
+
+func TestWebhookAddresses(t *testing.T) {
+	script := accrual.Script{
+		Rules:      []accrual.Rule{{Prefix: "1234", Status: accrual.StatusInvalid}},
+		StatusStep: accrual.Duration(100 * time.Millisecond),
+	}
+	g := startGophermart(t, startAccrual(t, script),
+		"ACCRUAL_POLL_INTERVAL=100ms",
+		"WEBHOOK_POLL_INTERVAL=100ms",
+		"WEBHOOK_MAX_ATTEMPTS=1",
+	)
+	alice := g.register(t, "alice", "secret")
+
+	var received atomic.Int32
+	partner := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(partner.Close)
+
+	t.Run("https URLs of the public addresses", func(t *testing.T) {
+		for _, url := range []string{
+			"http://partner.example/events",
+			"https://127.0.0.1/events",
+			"https://10.0.0.1/events",
+			"https://169.254.169.254/latest/meta-data",
+			"https://[::1]/events",
+			"https://[::ffff:192.168.0.1]/events",
+		} {
+			res, body := g.do(t, http.MethodPost, "/api/user/webhooks", alice, "application/json", `{"url":"`+url+`"}`)
+			expectStatus(t, res, body, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("the host names are checked once they are resolved", func(t *testing.T) {
+		url := "https://localhost:" + strconv.Itoa(partner.Listener.Addr().(*net.TCPAddr).Port) + "/events"
+		res, body := g.do(t, http.MethodPost, "/api/user/webhooks", alice, "application/json", `{"url":"`+url+`"}`)
+		expectStatus(t, res, body, http.StatusCreated)
+		w := decode[webhookResult](t, body)
+
+		res, body = g.do(t, http.MethodPost, "/api/user/orders", alice, "text/plain", "12345678903")
+		expectStatus(t, res, body, http.StatusAccepted)
+
+		dead := g.waitForDeliveries(t, alice, w.ID, "dead", 1)
+		if d := dead[0]; d.LastError == nil || *d.LastError != "the webhook address isn't public" {
+			t.Errorf("got the delivery %+v, want it failed by the address", d)
+		}
+		if n := received.Load(); n != 0 {
+			t.Errorf("the partner in the internal network received %d deliveries", n)
+		}
+	})
+}
@@ -28,6 +28,7 @@ func NewClient(getOrder goa.Endpoint) *Client {
 // GetOrder calls the "GetOrder" endpoint of the "accrual" service.
 // GetOrder may return the following errors:
 //   - "Internal service error" (type *service.GophermartError)
+//   - "Too many requests" (type *service.RateLimitError)
 //   - error: internal error
 func (c *Client) GetOrder(ctx context.Context, p *GetOrderPayload) (res *GetOrderResult, err error) {
 	var ires any
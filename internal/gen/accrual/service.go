@@ -9,9 +9,11 @@ package accrual
 
 import (
 	"context"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
 )
 
-// Service is the accrual service interface.
+// The accrual system which calculates the points for the orders
 type Service interface {
 	// GetOrder implements GetOrder.
 	GetOrder(context.Context, *GetOrderPayload) (res *GetOrderResult, err error)
@@ -40,7 +42,9 @@ type GetOrderPayload struct {
 
 // GetOrderResult is the result type of the accrual service GetOrder method.
 type GetOrderResult struct {
-	Order   *string
-	Status  *string
-	Accrual *uint
+	Order  *string
+	Status *string
+	// The amount of the points with at most 2 fractional digits
+	Accrual       *money.Amount
+	NotRegistered *string `json:"-"`
 }
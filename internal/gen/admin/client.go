@@ -0,0 +1,143 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin client
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package admin
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "admin" service client.
+type Client struct {
+	SearchUsersEndpoint    goa.Endpoint
+	ListUserOrdersEndpoint goa.Endpoint
+	GetUserLedgerEndpoint  goa.Endpoint
+	AdjustBalanceEndpoint  goa.Endpoint
+	SetUserRoleEndpoint    goa.Endpoint
+	ReprocessOrderEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "admin" service client given the endpoints.
+func NewClient(searchUsers, listUserOrders, getUserLedger, adjustBalance, setUserRole, reprocessOrder goa.Endpoint) *Client {
+	return &Client{
+		SearchUsersEndpoint:    searchUsers,
+		ListUserOrdersEndpoint: listUserOrders,
+		GetUserLedgerEndpoint:  getUserLedger,
+		AdjustBalanceEndpoint:  adjustBalance,
+		SetUserRoleEndpoint:    setUserRole,
+		ReprocessOrderEndpoint: reprocessOrder,
+	}
+}
+
+// SearchUsers calls the "SearchUsers" endpoint of the "admin" service.
+// SearchUsers may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "User is not authorized" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "User not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) SearchUsers(ctx context.Context, p *SearchUsersPayload) (res []*AdminUser, err error) {
+	var ires any
+	ires, err = c.SearchUsersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*AdminUser), nil
+}
+
+// ListUserOrders calls the "ListUserOrders" endpoint of the "admin" service.
+// ListUserOrders may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "User is not authorized" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "User not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListUserOrders(ctx context.Context, p *ListUserOrdersPayload) (res []*Order, err error) {
+	var ires any
+	ires, err = c.ListUserOrdersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Order), nil
+}
+
+// GetUserLedger calls the "GetUserLedger" endpoint of the "admin" service.
+// GetUserLedger may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "User is not authorized" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "User not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetUserLedger(ctx context.Context, p *GetUserLedgerPayload) (res *Ledger, err error) {
+	var ires any
+	ires, err = c.GetUserLedgerEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Ledger), nil
+}
+
+// AdjustBalance calls the "AdjustBalance" endpoint of the "admin" service.
+// AdjustBalance may return the following errors:
+//   - "Insufficient balance" (type *service.GophermartError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "User is not authorized" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "User not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) AdjustBalance(ctx context.Context, p *AdjustBalancePayload) (res *LedgerEntry, err error) {
+	var ires any
+	ires, err = c.AdjustBalanceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*LedgerEntry), nil
+}
+
+// SetUserRole calls the "SetUserRole" endpoint of the "admin" service.
+// SetUserRole may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "User is not authorized" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "User not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) SetUserRole(ctx context.Context, p *SetUserRolePayload) (res *AdminUser, err error) {
+	var ires any
+	ires, err = c.SetUserRoleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AdminUser), nil
+}
+
+// ReprocessOrder calls the "ReprocessOrder" endpoint of the "admin" service.
+// ReprocessOrder may return the following errors:
+//   - "Order not found" (type *service.GophermartError)
+//   - "The order isn't processed yet" (type *service.GophermartError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "User is not authorized" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "User not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ReprocessOrder(ctx context.Context, p *ReprocessOrderPayload) (err error) {
+	_, err = c.ReprocessOrderEndpoint(ctx, p)
+	return
+}
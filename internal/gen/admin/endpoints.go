@@ -0,0 +1,163 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin endpoints
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package admin
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "admin" service endpoints.
+type Endpoints struct {
+	SearchUsers    goa.Endpoint
+	ListUserOrders goa.Endpoint
+	GetUserLedger  goa.Endpoint
+	AdjustBalance  goa.Endpoint
+	SetUserRole    goa.Endpoint
+	ReprocessOrder goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "admin" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		SearchUsers:    NewSearchUsersEndpoint(s, a.JWTAuth),
+		ListUserOrders: NewListUserOrdersEndpoint(s, a.JWTAuth),
+		GetUserLedger:  NewGetUserLedgerEndpoint(s, a.JWTAuth),
+		AdjustBalance:  NewAdjustBalanceEndpoint(s, a.JWTAuth),
+		SetUserRole:    NewSetUserRoleEndpoint(s, a.JWTAuth),
+		ReprocessOrder: NewReprocessOrderEndpoint(s, a.JWTAuth),
+	}
+}
+
+// Use applies the given middleware to all the "admin" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.SearchUsers = m(e.SearchUsers)
+	e.ListUserOrders = m(e.ListUserOrders)
+	e.GetUserLedger = m(e.GetUserLedger)
+	e.AdjustBalance = m(e.AdjustBalance)
+	e.SetUserRole = m(e.SetUserRole)
+	e.ReprocessOrder = m(e.ReprocessOrder)
+}
+
+// NewSearchUsersEndpoint returns an endpoint function that calls the method
+// "SearchUsers" of service "admin".
+func NewSearchUsersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchUsersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{"support"},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.SearchUsers(ctx, p)
+	}
+}
+
+// NewListUserOrdersEndpoint returns an endpoint function that calls the method
+// "ListUserOrders" of service "admin".
+func NewListUserOrdersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListUserOrdersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{"support"},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListUserOrders(ctx, p)
+	}
+}
+
+// NewGetUserLedgerEndpoint returns an endpoint function that calls the method
+// "GetUserLedger" of service "admin".
+func NewGetUserLedgerEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetUserLedgerPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{"support"},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetUserLedger(ctx, p)
+	}
+}
+
+// NewAdjustBalanceEndpoint returns an endpoint function that calls the method
+// "AdjustBalance" of service "admin".
+func NewAdjustBalanceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdjustBalancePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.AdjustBalance(ctx, p)
+	}
+}
+
+// NewSetUserRoleEndpoint returns an endpoint function that calls the method
+// "SetUserRole" of service "admin".
+func NewSetUserRoleEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetUserRolePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.SetUserRole(ctx, p)
+	}
+}
+
+// NewReprocessOrderEndpoint returns an endpoint function that calls the method
+// "ReprocessOrder" of service "admin".
+func NewReprocessOrderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReprocessOrderPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.ReprocessOrder(ctx, p)
+	}
+}
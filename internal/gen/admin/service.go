@@ -0,0 +1,177 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin service
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package admin
+
+import (
+	"context"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// The accounts of all the users. The support reads them, the admin changes
+// them. The role of the user is checked upon each request so the revoked
+// scopes of the issued tokens aren't granted
+type Service interface {
+	// Search the users by a part of their login in the order of the logins
+	SearchUsers(context.Context, *SearchUsersPayload) (res []*AdminUser, err error)
+	// List the orders of the user. The latest orders go first
+	ListUserOrders(context.Context, *ListUserOrdersPayload) (res []*Order, err error)
+	// Get the balance of the user with the latest entries of their ledger
+	GetUserLedger(context.Context, *GetUserLedgerPayload) (res *Ledger, err error)
+	// Adjust the balance of the user. The balance is decreased by a negative
+	// amount but it can't become negative
+	AdjustBalance(context.Context, *AdjustBalancePayload) (res *LedgerEntry, err error)
+	// Change the role of the user. The user gets the scopes of the role upon their
+	// next login
+	SetUserRole(context.Context, *SetUserRolePayload) (res *AdminUser, err error)
+	// Move the order in a final status back to NEW so the accrual system processes
+	// it again. The accrual of the processed order is reversed until the order is
+	// processed again
+	ReprocessOrder(context.Context, *ReprocessOrderPayload) (err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gophermart"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.2"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "admin"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [6]string{"SearchUsers", "ListUserOrders", "GetUserLedger", "AdjustBalance", "SetUserRole", "ReprocessOrder"}
+
+// AdjustBalancePayload is the payload type of the admin service AdjustBalance
+// method.
+type AdjustBalancePayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the user
+	UserID string
+	// The amount of the points with at most 2 fractional digits
+	Amount money.Amount
+	// Why the balance is adjusted
+	Reason string
+}
+
+// AdminUser is the result type of the admin service SetUserRole method.
+type AdminUser struct {
+	ID        string
+	Login     string
+	Role      string
+	CreatedAt string
+}
+
+// GetUserLedgerPayload is the payload type of the admin service GetUserLedger
+// method.
+type GetUserLedgerPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the user
+	UserID string
+	// The maximum number of the entries
+	Limit int
+}
+
+// Ledger is the result type of the admin service GetUserLedger method.
+type Ledger struct {
+	// The amount of the points with at most 2 fractional digits
+	Balance money.Amount
+	// The amount of the points with at most 2 fractional digits
+	Withdrawn money.Amount
+	// The latest entries go first
+	Entries []*LedgerEntry
+}
+
+// LedgerEntry is the result type of the admin service AdjustBalance method.
+type LedgerEntry struct {
+	ID int64
+	// accrual: the order was processed, withdrawal: the user withdrew the points,
+	// adjustment: the admin adjusted the balance, reversal: the accrual of the
+	// reprocessed order was taken back
+	Kind string
+	// The amount of the points with at most 2 fractional digits
+	Amount money.Amount
+	// The number of the order of the entry
+	Order *string
+	// Why the admin changed the balance
+	Reason *string
+	// The ID of the admin who changed the balance
+	ActorID   *string
+	CreatedAt string
+}
+
+// ListUserOrdersPayload is the payload type of the admin service
+// ListUserOrders method.
+type ListUserOrdersPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the user
+	UserID string
+	// The maximum number of the orders
+	Limit int
+}
+
+type Order struct {
+	Number string
+	Status string
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount
+	UploadedAt string
+}
+
+// ReprocessOrderPayload is the payload type of the admin service
+// ReprocessOrder method.
+type ReprocessOrderPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The order number
+	Number string
+}
+
+// SearchUsersPayload is the payload type of the admin service SearchUsers
+// method.
+type SearchUsersPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The part of the login. The case is ignored. All the users match if it's
+	// absent
+	Login *string
+	// Only the users of the role are found
+	Role *string
+	// The maximum number of the users
+	Limit int
+}
+
+// SetUserRolePayload is the payload type of the admin service SetUserRole
+// method.
+type SetUserRolePayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the user
+	UserID string
+	Role   string
+}
+
+// MakeMissingField builds a goa.ServiceError from an error.
+func MakeMissingField(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "missing_field", false, false, false)
+}
@@ -9,21 +9,34 @@ package balance
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "balance" service client.
 type Client struct {
-	UploadUserOrderEndpoint goa.Endpoint
-	ListUserOrderEndpoint   goa.Endpoint
+	UploadUserOrderEndpoint       goa.Endpoint
+	UploadUserOrdersEndpoint      goa.Endpoint
+	ListUserOrderEndpoint         goa.Endpoint
+	GetUserOrderEndpoint          goa.Endpoint
+	StreamUserOrderEventsEndpoint goa.Endpoint
+	GetUserBalanceEndpoint        goa.Endpoint
+	WithdrawEndpoint              goa.Endpoint
+	ListWithdrawalsEndpoint       goa.Endpoint
 }
 
 // NewClient initializes a "balance" service client given the endpoints.
-func NewClient(uploadUserOrder, listUserOrder goa.Endpoint) *Client {
+func NewClient(uploadUserOrder, uploadUserOrders, listUserOrder, getUserOrder, streamUserOrderEvents, getUserBalance, withdraw, listWithdrawals goa.Endpoint) *Client {
 	return &Client{
-		UploadUserOrderEndpoint: uploadUserOrder,
-		ListUserOrderEndpoint:   listUserOrder,
+		UploadUserOrderEndpoint:       uploadUserOrder,
+		UploadUserOrdersEndpoint:      uploadUserOrders,
+		ListUserOrderEndpoint:         listUserOrder,
+		GetUserOrderEndpoint:          getUserOrder,
+		StreamUserOrderEventsEndpoint: streamUserOrderEvents,
+		GetUserBalanceEndpoint:        getUserBalance,
+		WithdrawEndpoint:              withdraw,
+		ListWithdrawalsEndpoint:       listWithdrawals,
 	}
 }
 
@@ -47,6 +60,25 @@ func (c *Client) UploadUserOrder(ctx context.Context, p *UploadUserOrderPayload)
 	return ires.(*UploadUserOrderResult), nil
 }
 
+// UploadUserOrders calls the "UploadUserOrders" endpoint of the "balance"
+// service.
+// UploadUserOrders may return the following errors:
+//   - "Too many orders" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Not implemented" (type *service.GophermartError)
+//   - error: internal error
+func (c *Client) UploadUserOrders(ctx context.Context, p *UploadUserOrdersPayload, req io.ReadCloser) (res *UploadUserOrdersResult, err error) {
+	var ires any
+	ires, err = c.UploadUserOrdersEndpoint(ctx, &UploadUserOrdersRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*UploadUserOrdersResult), nil
+}
+
 // ListUserOrder calls the "ListUserOrder" endpoint of the "balance" service.
 // ListUserOrder may return the following errors:
 //   - "missing_field" (type *goa.ServiceError)
@@ -63,3 +95,89 @@ func (c *Client) ListUserOrder(ctx context.Context, p *ListUserOrderPayload) (re
 	}
 	return ires.(*ListUserOrderResult), nil
 }
+
+// GetUserOrder calls the "GetUserOrder" endpoint of the "balance" service.
+// GetUserOrder may return the following errors:
+//   - "Order not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Not implemented" (type *service.GophermartError)
+//   - error: internal error
+func (c *Client) GetUserOrder(ctx context.Context, p *GetUserOrderPayload) (res *OrderTimeline, err error) {
+	var ires any
+	ires, err = c.GetUserOrderEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*OrderTimeline), nil
+}
+
+// StreamUserOrderEvents calls the "StreamUserOrderEvents" endpoint of the
+// "balance" service.
+// StreamUserOrderEvents may return the following errors:
+//   - "missing_field" (type *goa.ServiceError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Not implemented" (type *service.GophermartError)
+//   - error: internal error
+func (c *Client) StreamUserOrderEvents(ctx context.Context, p *StreamUserOrderEventsPayload) (res StreamUserOrderEventsClientStream, err error) {
+	var ires any
+	ires, err = c.StreamUserOrderEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(StreamUserOrderEventsClientStream), nil
+}
+
+// GetUserBalance calls the "GetUserBalance" endpoint of the "balance" service.
+// GetUserBalance may return the following errors:
+//   - "missing_field" (type *goa.ServiceError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Not implemented" (type *service.GophermartError)
+//   - error: internal error
+func (c *Client) GetUserBalance(ctx context.Context, p *GetUserBalancePayload) (res *UserBalance, err error) {
+	var ires any
+	ires, err = c.GetUserBalanceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserBalance), nil
+}
+
+// Withdraw calls the "Withdraw" endpoint of the "balance" service.
+// Withdraw may return the following errors:
+//   - "Insufficient funds" (type *service.GophermartError)
+//   - "Invalid order number" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Not implemented" (type *service.GophermartError)
+//   - error: internal error
+func (c *Client) Withdraw(ctx context.Context, p *WithdrawPayload) (err error) {
+	_, err = c.WithdrawEndpoint(ctx, p)
+	return
+}
+
+// ListWithdrawals calls the "ListWithdrawals" endpoint of the "balance"
+// service.
+// ListWithdrawals may return the following errors:
+//   - "missing_field" (type *goa.ServiceError)
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Not implemented" (type *service.GophermartError)
+//   - error: internal error
+func (c *Client) ListWithdrawals(ctx context.Context, p *ListWithdrawalsPayload) (res *ListWithdrawalsResult, err error) {
+	var ires any
+	ires, err = c.ListWithdrawalsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListWithdrawalsResult), nil
+}
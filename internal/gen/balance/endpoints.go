@@ -9,6 +9,7 @@ package balance
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
@@ -16,8 +17,33 @@ import (
 
 // Endpoints wraps the "balance" service endpoints.
 type Endpoints struct {
-	UploadUserOrder goa.Endpoint
-	ListUserOrder   goa.Endpoint
+	UploadUserOrder       goa.Endpoint
+	UploadUserOrders      goa.Endpoint
+	ListUserOrder         goa.Endpoint
+	GetUserOrder          goa.Endpoint
+	StreamUserOrderEvents goa.Endpoint
+	GetUserBalance        goa.Endpoint
+	Withdraw              goa.Endpoint
+	ListWithdrawals       goa.Endpoint
+}
+
+// UploadUserOrdersRequestData holds both the payload and the HTTP request body
+// reader of the "UploadUserOrders" method.
+type UploadUserOrdersRequestData struct {
+	// Payload is the method payload.
+	Payload *UploadUserOrdersPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// StreamUserOrderEventsEndpointInput holds both the payload and the server
+// stream of the "StreamUserOrderEvents" method.
+type StreamUserOrderEventsEndpointInput struct {
+	// Payload is the method payload.
+	Payload *StreamUserOrderEventsPayload
+	// Stream is the server stream used by the "StreamUserOrderEvents" method to
+	// send data.
+	Stream StreamUserOrderEventsServerStream
 }
 
 // NewEndpoints wraps the methods of the "balance" service with endpoints.
@@ -25,15 +51,27 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		UploadUserOrder: NewUploadUserOrderEndpoint(s, a.JWTAuth),
-		ListUserOrder:   NewListUserOrderEndpoint(s, a.JWTAuth),
+		UploadUserOrder:       NewUploadUserOrderEndpoint(s, a.JWTAuth),
+		UploadUserOrders:      NewUploadUserOrdersEndpoint(s, a.JWTAuth),
+		ListUserOrder:         NewListUserOrderEndpoint(s, a.JWTAuth),
+		GetUserOrder:          NewGetUserOrderEndpoint(s, a.JWTAuth),
+		StreamUserOrderEvents: NewStreamUserOrderEventsEndpoint(s, a.JWTAuth),
+		GetUserBalance:        NewGetUserBalanceEndpoint(s, a.JWTAuth),
+		Withdraw:              NewWithdrawEndpoint(s, a.JWTAuth),
+		ListWithdrawals:       NewListWithdrawalsEndpoint(s, a.JWTAuth),
 	}
 }
 
 // Use applies the given middleware to all the "balance" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.UploadUserOrder = m(e.UploadUserOrder)
+	e.UploadUserOrders = m(e.UploadUserOrders)
 	e.ListUserOrder = m(e.ListUserOrder)
+	e.GetUserOrder = m(e.GetUserOrder)
+	e.StreamUserOrderEvents = m(e.StreamUserOrderEvents)
+	e.GetUserBalance = m(e.GetUserBalance)
+	e.Withdraw = m(e.Withdraw)
+	e.ListWithdrawals = m(e.ListWithdrawals)
 }
 
 // NewUploadUserOrderEndpoint returns an endpoint function that calls the
@@ -44,7 +82,7 @@ func NewUploadUserOrderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.E
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
+			Scopes:         []string{"user", "support", "admin"},
 			RequiredScopes: []string{},
 		}
 		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
@@ -55,6 +93,25 @@ func NewUploadUserOrderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.E
 	}
 }
 
+// NewUploadUserOrdersEndpoint returns an endpoint function that calls the
+// method "UploadUserOrders" of service "balance".
+func NewUploadUserOrdersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*UploadUserOrdersRequestData)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, ep.Payload.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UploadUserOrders(ctx, ep.Payload, ep.Body)
+	}
+}
+
 // NewListUserOrderEndpoint returns an endpoint function that calls the method
 // "ListUserOrder" of service "balance".
 func NewListUserOrderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -63,7 +120,7 @@ func NewListUserOrderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
+			Scopes:         []string{"user", "support", "admin"},
 			RequiredScopes: []string{},
 		}
 		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
@@ -73,3 +130,98 @@ func NewListUserOrderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		return s.ListUserOrder(ctx, p)
 	}
 }
+
+// NewGetUserOrderEndpoint returns an endpoint function that calls the method
+// "GetUserOrder" of service "balance".
+func NewGetUserOrderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetUserOrderPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetUserOrder(ctx, p)
+	}
+}
+
+// NewStreamUserOrderEventsEndpoint returns an endpoint function that calls the
+// method "StreamUserOrderEvents" of service "balance".
+func NewStreamUserOrderEventsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*StreamUserOrderEventsEndpointInput)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, ep.Payload.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.StreamUserOrderEvents(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewGetUserBalanceEndpoint returns an endpoint function that calls the method
+// "GetUserBalance" of service "balance".
+func NewGetUserBalanceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetUserBalancePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetUserBalance(ctx, p)
+	}
+}
+
+// NewWithdrawEndpoint returns an endpoint function that calls the method
+// "Withdraw" of service "balance".
+func NewWithdrawEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*WithdrawPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Withdraw(ctx, p)
+	}
+}
+
+// NewListWithdrawalsEndpoint returns an endpoint function that calls the
+// method "ListWithdrawals" of service "balance".
+func NewListWithdrawalsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWithdrawalsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWithdrawals(ctx, p)
+	}
+}
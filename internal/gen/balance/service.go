@@ -9,7 +9,9 @@ package balance
 
 import (
 	"context"
+	"io"
 
+	"github.com/oleshko-g/oggophermart/internal/money"
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
 )
@@ -18,8 +20,24 @@ import (
 type Service interface {
 	// Upload user order
 	UploadUserOrder(context.Context, *UploadUserOrderPayload) (res *UploadUserOrderResult, err error)
+	// Upload user orders in bulk. The body is a JSON array of the numbers or the
+	// numbers delimited by new lines
+	UploadUserOrders(context.Context, *UploadUserOrdersPayload, io.ReadCloser) (res *UploadUserOrdersResult, err error)
 	// List user orders
 	ListUserOrder(context.Context, *ListUserOrderPayload) (res *ListUserOrderResult, err error)
+	// Get user order with the timeline of its statuses
+	GetUserOrder(context.Context, *GetUserOrderPayload) (res *OrderTimeline, err error)
+	// Stream the events of the user orders. An event is sent when an order changes
+	// its status or receives the accrual. The heartbeat events keep the idle
+	// stream alive
+	StreamUserOrderEvents(context.Context, *StreamUserOrderEventsPayload, StreamUserOrderEventsServerStream) (err error)
+	// Get the current balance of the user and the sum of their withdrawals
+	GetUserBalance(context.Context, *GetUserBalancePayload) (res *UserBalance, err error)
+	// Withdraw the points to pay for the new order. The order number is validated
+	// as the uploaded ones
+	Withdraw(context.Context, *WithdrawPayload) (err error)
+	// List the withdrawals of the user. The earliest withdrawals go first
+	ListWithdrawals(context.Context, *ListWithdrawalsPayload) (res *ListWithdrawalsResult, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -42,27 +60,155 @@ const ServiceName = "balance"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"UploadUserOrder", "ListUserOrder"}
+var MethodNames = [8]string{"UploadUserOrder", "UploadUserOrders", "ListUserOrder", "GetUserOrder", "StreamUserOrderEvents", "GetUserBalance", "Withdraw", "ListWithdrawals"}
+
+// StreamUserOrderEventsServerStream allows streaming instances of *OrderEvent
+// to the client.
+type StreamUserOrderEventsServerStream interface {
+	// Send streams instances of "OrderEvent".
+	Send(*OrderEvent) error
+	// SendWithContext streams instances of "OrderEvent" with context.
+	SendWithContext(context.Context, *OrderEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// StreamUserOrderEventsClientStream allows streaming instances of *OrderEvent
+// to the client.
+type StreamUserOrderEventsClientStream interface {
+	// Recv reads instances of "OrderEvent" from the stream.
+	Recv() (*OrderEvent, error)
+	// RecvWithContext reads instances of "OrderEvent" from the stream with context.
+	RecvWithContext(context.Context) (*OrderEvent, error)
+}
+
+// GetUserBalancePayload is the payload type of the balance service
+// GetUserBalance method.
+type GetUserBalancePayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+}
+
+// GetUserOrderPayload is the payload type of the balance service GetUserOrder
+// method.
+type GetUserOrderPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// Unique user order number
+	Number string
+}
 
 // ListUserOrderPayload is the payload type of the balance service
 // ListUserOrder method.
 type ListUserOrderPayload struct {
 	// A JWT token used to authenticate a request
 	Authorization string
+	// The maximum number of the orders in the response. All the orders are listed
+	// if it's absent
+	Limit *int
+	// The position to continue the listing from. It's taken from the Next-Cursor
+	// header of the previous response
+	Cursor *string
+	// The statuses of the listed orders. The orders of any status are listed if
+	// it's absent
+	Status []string
+	// The orders uploaded at or after the time are listed
+	From *string
+	// The orders uploaded before the time are listed
+	To *string
+	// The direction of sorting by the upload time
+	Sort *string
 }
 
 // ListUserOrderResult is the result type of the balance service ListUserOrder
 // method.
 type ListUserOrderResult struct {
+	// The cursor of the next page. It's absent on the last page
+	NextCursor *string
+	// The link to the next page. It's absent on the last page
+	Link     *string
 	Orders   []*Order
 	NoOrders *string `json:"-"`
 }
 
+// ListWithdrawalsPayload is the payload type of the balance service
+// ListWithdrawals method.
+type ListWithdrawalsPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+}
+
+// ListWithdrawalsResult is the result type of the balance service
+// ListWithdrawals method.
+type ListWithdrawalsResult struct {
+	Withdrawals   []*Withdrawal
+	NoWithdrawals *string `json:"-"`
+}
+
 type Order struct {
-	Number     string
-	Status     string
-	Accrual    *uint
+	Number string
+	Status string
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount
+	UploadedAt string
+}
+
+// OrderEvent is the result type of the balance service StreamUserOrderEvents
+// method.
+type OrderEvent struct {
+	// The ID to resume the stream after the event. It's empty for the heartbeats
+	ID   string `json:"-"`
+	Type string `json:"-"`
+	// The order number
+	Number *string
+	// The previous status. It's absent for the first status of the order
+	From   *string
+	Status *string
+	Source *string
+	// The amount of the points with at most 2 fractional digits
+	Accrual *money.Amount
+	At      *string
+}
+
+type OrderStatusChange struct {
+	// The previous status. It's absent for the first status of the order
+	From   *string
+	Status string
+	// upload: the user uploaded the order, accrual: the accrual system processed
+	// the order, import: the order was imported in bulk, migration: the status was
+	// recorded before the history was kept, admin: the admin reprocessed the order
+	Source string
+	At     string
+}
+
+// OrderTimeline is the result type of the balance service GetUserOrder method.
+type OrderTimeline struct {
+	Number string
+	Status string
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount
 	UploadedAt string
+	// The time the order has reached a final status
+	ProcessedAt *string
+	// The transitions of the order status in their order
+	History []*OrderStatusChange
+}
+
+type OrderUploadResult struct {
+	Number string
+	// accepted: stored for processing, duplicate: uploaded by the user before,
+	// conflict: uploaded by another user, invalid: not a valid order number
+	Result string
+}
+
+// StreamUserOrderEventsPayload is the payload type of the balance service
+// StreamUserOrderEvents method.
+type StreamUserOrderEventsPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the last received event. The events after it are sent before the
+	// new ones
+	LastEventID *string
 }
 
 // UploadUserOrderPayload is the payload type of the balance service
@@ -80,6 +226,49 @@ type UploadUserOrderResult struct {
 	Accepted *string `json:"-"`
 }
 
+// UploadUserOrdersPayload is the payload type of the balance service
+// UploadUserOrders method.
+type UploadUserOrdersPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// application/json for a JSON array, the numbers are delimited by new lines
+	// otherwise
+	ContentType *string
+}
+
+// UploadUserOrdersResult is the result type of the balance service
+// UploadUserOrders method.
+type UploadUserOrdersResult struct {
+	// The results in the order of the uploaded numbers
+	Orders []*OrderUploadResult
+}
+
+// UserBalance is the result type of the balance service GetUserBalance method.
+type UserBalance struct {
+	// The amount of the points with at most 2 fractional digits
+	Current money.Amount
+	// The amount of the points with at most 2 fractional digits
+	Withdrawn money.Amount
+}
+
+// WithdrawPayload is the payload type of the balance service Withdraw method.
+type WithdrawPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The number of the order paid by the points
+	Order string
+	// The amount of the points with at most 2 fractional digits
+	Sum money.Amount
+}
+
+type Withdrawal struct {
+	// The number of the order paid by the points
+	Order string
+	// The amount of the points with at most 2 fractional digits
+	Sum         money.Amount
+	ProcessedAt string
+}
+
 // MakeMissingField builds a goa.ServiceError from an error.
 func MakeMissingField(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "missing_field", false, false, false)
@@ -0,0 +1,157 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance gRPC client CLI support package
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	"github.com/oleshko-g/oggophermart/internal/money"
+)
+
+// BuildUploadUserOrderPayload builds the payload for the balance
+// UploadUserOrder endpoint from CLI flags.
+func BuildUploadUserOrderPayload(balanceUploadUserOrderMessage string, balanceUploadUserOrderAuthorization string) (*balance.UploadUserOrderPayload, error) {
+	var err error
+	var message balancepb.UploadUserOrderRequest
+	{
+		if balanceUploadUserOrderMessage != "" {
+			err = json.Unmarshal([]byte(balanceUploadUserOrderMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"OrderNumber\": \"87\"\n   }'")
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = balanceUploadUserOrderAuthorization
+	}
+	v := &balance.UploadUserOrderPayload{
+		OrderNumber: message.OrderNumber,
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListUserOrderPayload builds the payload for the balance ListUserOrder
+// endpoint from CLI flags.
+func BuildListUserOrderPayload(balanceListUserOrderMessage string, balanceListUserOrderAuthorization string) (*balance.ListUserOrderPayload, error) {
+	var err error
+	var message balancepb.ListUserOrderRequest
+	{
+		if balanceListUserOrderMessage != "" {
+			err = json.Unmarshal([]byte(balanceListUserOrderMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cursor\": \"Et ratione maiores.\",\n      \"from\": \"1976-03-13T07:36:18Z\",\n      \"limit\": 949,\n      \"sort\": \"asc\",\n      \"status\": [\n         \"PROCESSING\",\n         \"INVALID\",\n         \"NEW\",\n         \"INVALID\"\n      ],\n      \"to\": \"1982-07-28T01:17:18Z\"\n   }'")
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = balanceListUserOrderAuthorization
+	}
+	v := &balance.ListUserOrderPayload{
+		Cursor: message.Cursor,
+		From:   message.From,
+		To:     message.To,
+		Sort:   message.Sort,
+	}
+	if message.Limit != nil {
+		limit := int(*message.Limit)
+		v.Limit = &limit
+	}
+	if message.Status != nil {
+		v.Status = make([]string, len(message.Status))
+		for i, val := range message.Status {
+			v.Status[i] = val
+		}
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetUserOrderPayload builds the payload for the balance GetUserOrder
+// endpoint from CLI flags.
+func BuildGetUserOrderPayload(balanceGetUserOrderMessage string, balanceGetUserOrderAuthorization string) (*balance.GetUserOrderPayload, error) {
+	var err error
+	var message balancepb.GetUserOrderRequest
+	{
+		if balanceGetUserOrderMessage != "" {
+			err = json.Unmarshal([]byte(balanceGetUserOrderMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"number\": \"12345678903\"\n   }'")
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = balanceGetUserOrderAuthorization
+	}
+	v := &balance.GetUserOrderPayload{
+		Number: message.Number,
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetUserBalancePayload builds the payload for the balance GetUserBalance
+// endpoint from CLI flags.
+func BuildGetUserBalancePayload(balanceGetUserBalanceAuthorization string) (*balance.GetUserBalancePayload, error) {
+	var authorization string
+	{
+		authorization = balanceGetUserBalanceAuthorization
+	}
+	v := &balance.GetUserBalancePayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildWithdrawPayload builds the payload for the balance Withdraw endpoint
+// from CLI flags.
+func BuildWithdrawPayload(balanceWithdrawMessage string, balanceWithdrawAuthorization string) (*balance.WithdrawPayload, error) {
+	var err error
+	var message balancepb.WithdrawRequest
+	{
+		if balanceWithdrawMessage != "" {
+			err = json.Unmarshal([]byte(balanceWithdrawMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"order\": \"2377225624\",\n      \"sum\": 729.98\n   }'")
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = balanceWithdrawAuthorization
+	}
+	v := &balance.WithdrawPayload{
+		Order: message.Order,
+		Sum:   money.Amount(message.Sum),
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListWithdrawalsPayload builds the payload for the balance
+// ListWithdrawals endpoint from CLI flags.
+func BuildListWithdrawalsPayload(balanceListWithdrawalsAuthorization string) (*balance.ListWithdrawalsPayload, error) {
+	var authorization string
+	{
+		authorization = balanceListWithdrawalsAuthorization
+	}
+	v := &balance.ListWithdrawalsPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
@@ -0,0 +1,221 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance gRPC client
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	goapb "goa.design/goa/v3/grpc/pb"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+)
+
+// Client lists the service endpoint gRPC clients.
+type Client struct {
+	grpccli balancepb.BalanceClient
+	opts    []grpc.CallOption
+}
+
+// NewClient instantiates gRPC client for all the balance service servers.
+func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
+	return &Client{
+		grpccli: balancepb.NewBalanceClient(cc),
+		opts:    opts,
+	}
+}
+
+// UploadUserOrder calls the "UploadUserOrder" function in
+// balancepb.BalanceClient interface.
+func (c *Client) UploadUserOrder() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildUploadUserOrderFunc(c.grpccli, c.opts...),
+			EncodeUploadUserOrderRequest,
+			DecodeUploadUserOrderResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *balancepb.UploadUserOrderTheOrderBelongsToAnotherUserError:
+				return nil, NewUploadUserOrderTheOrderBelongsToAnotherUserError(message)
+			case *balancepb.UploadUserOrderInvalidOrderNumberError:
+				return nil, NewUploadUserOrderInvalidOrderNumberError(message)
+			case *balancepb.UploadUserOrderInvalidInputParameterError:
+				return nil, NewUploadUserOrderInvalidInputParameterError(message)
+			case *balancepb.UploadUserOrderUserIsNotAuthenticatedError:
+				return nil, NewUploadUserOrderUserIsNotAuthenticatedError(message)
+			case *balancepb.UploadUserOrderInternalServiceErrorError:
+				return nil, NewUploadUserOrderInternalServiceErrorError(message)
+			case *balancepb.UploadUserOrderNotImplementedError:
+				return nil, NewUploadUserOrderNotImplementedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListUserOrder calls the "ListUserOrder" function in balancepb.BalanceClient
+// interface.
+func (c *Client) ListUserOrder() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListUserOrderFunc(c.grpccli, c.opts...),
+			EncodeListUserOrderRequest,
+			DecodeListUserOrderResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *balancepb.ListUserOrderInvalidInputParameterError:
+				return nil, NewListUserOrderInvalidInputParameterError(message)
+			case *balancepb.ListUserOrderUserIsNotAuthenticatedError:
+				return nil, NewListUserOrderUserIsNotAuthenticatedError(message)
+			case *balancepb.ListUserOrderInternalServiceErrorError:
+				return nil, NewListUserOrderInternalServiceErrorError(message)
+			case *balancepb.ListUserOrderNotImplementedError:
+				return nil, NewListUserOrderNotImplementedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetUserOrder calls the "GetUserOrder" function in balancepb.BalanceClient
+// interface.
+func (c *Client) GetUserOrder() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetUserOrderFunc(c.grpccli, c.opts...),
+			EncodeGetUserOrderRequest,
+			DecodeGetUserOrderResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *balancepb.GetUserOrderOrderNotFoundError:
+				return nil, NewGetUserOrderOrderNotFoundError(message)
+			case *balancepb.GetUserOrderInvalidInputParameterError:
+				return nil, NewGetUserOrderInvalidInputParameterError(message)
+			case *balancepb.GetUserOrderUserIsNotAuthenticatedError:
+				return nil, NewGetUserOrderUserIsNotAuthenticatedError(message)
+			case *balancepb.GetUserOrderInternalServiceErrorError:
+				return nil, NewGetUserOrderInternalServiceErrorError(message)
+			case *balancepb.GetUserOrderNotImplementedError:
+				return nil, NewGetUserOrderNotImplementedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetUserBalance calls the "GetUserBalance" function in
+// balancepb.BalanceClient interface.
+func (c *Client) GetUserBalance() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetUserBalanceFunc(c.grpccli, c.opts...),
+			EncodeGetUserBalanceRequest,
+			DecodeGetUserBalanceResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *balancepb.GetUserBalanceInvalidInputParameterError:
+				return nil, NewGetUserBalanceInvalidInputParameterError(message)
+			case *balancepb.GetUserBalanceUserIsNotAuthenticatedError:
+				return nil, NewGetUserBalanceUserIsNotAuthenticatedError(message)
+			case *balancepb.GetUserBalanceInternalServiceErrorError:
+				return nil, NewGetUserBalanceInternalServiceErrorError(message)
+			case *balancepb.GetUserBalanceNotImplementedError:
+				return nil, NewGetUserBalanceNotImplementedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Withdraw calls the "Withdraw" function in balancepb.BalanceClient interface.
+func (c *Client) Withdraw() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildWithdrawFunc(c.grpccli, c.opts...),
+			EncodeWithdrawRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *balancepb.WithdrawInsufficientFundsError:
+				return nil, NewWithdrawInsufficientFundsError(message)
+			case *balancepb.WithdrawInvalidOrderNumberError:
+				return nil, NewWithdrawInvalidOrderNumberError(message)
+			case *balancepb.WithdrawInvalidInputParameterError:
+				return nil, NewWithdrawInvalidInputParameterError(message)
+			case *balancepb.WithdrawUserIsNotAuthenticatedError:
+				return nil, NewWithdrawUserIsNotAuthenticatedError(message)
+			case *balancepb.WithdrawInternalServiceErrorError:
+				return nil, NewWithdrawInternalServiceErrorError(message)
+			case *balancepb.WithdrawNotImplementedError:
+				return nil, NewWithdrawNotImplementedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListWithdrawals calls the "ListWithdrawals" function in
+// balancepb.BalanceClient interface.
+func (c *Client) ListWithdrawals() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListWithdrawalsFunc(c.grpccli, c.opts...),
+			EncodeListWithdrawalsRequest,
+			DecodeListWithdrawalsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *balancepb.ListWithdrawalsInvalidInputParameterError:
+				return nil, NewListWithdrawalsInvalidInputParameterError(message)
+			case *balancepb.ListWithdrawalsUserIsNotAuthenticatedError:
+				return nil, NewListWithdrawalsUserIsNotAuthenticatedError(message)
+			case *balancepb.ListWithdrawalsInternalServiceErrorError:
+				return nil, NewListWithdrawalsInternalServiceErrorError(message)
+			case *balancepb.ListWithdrawalsNotImplementedError:
+				return nil, NewListWithdrawalsNotImplementedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,231 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance gRPC client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// BuildUploadUserOrderFunc builds the remote method to invoke for "balance"
+// service "UploadUserOrder" endpoint.
+func BuildUploadUserOrderFunc(grpccli balancepb.BalanceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.UploadUserOrder(ctx, reqpb.(*balancepb.UploadUserOrderRequest), opts...)
+		}
+		return grpccli.UploadUserOrder(ctx, &balancepb.UploadUserOrderRequest{}, opts...)
+	}
+}
+
+// EncodeUploadUserOrderRequest encodes requests sent to balance
+// UploadUserOrder endpoint.
+func EncodeUploadUserOrderRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*balance.UploadUserOrderPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "UploadUserOrder", "*balance.UploadUserOrderPayload", v)
+	}
+	(*md).Append("authorization", payload.Authorization)
+	return NewProtoUploadUserOrderRequest(payload), nil
+}
+
+// DecodeUploadUserOrderResponse decodes responses from the balance
+// UploadUserOrder endpoint.
+func DecodeUploadUserOrderResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*balancepb.UploadUserOrderResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "UploadUserOrder", "*balancepb.UploadUserOrderResponse", v)
+	}
+	res := NewUploadUserOrderResult(message)
+	return res, nil
+}
+
+// BuildListUserOrderFunc builds the remote method to invoke for "balance"
+// service "ListUserOrder" endpoint.
+func BuildListUserOrderFunc(grpccli balancepb.BalanceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListUserOrder(ctx, reqpb.(*balancepb.ListUserOrderRequest), opts...)
+		}
+		return grpccli.ListUserOrder(ctx, &balancepb.ListUserOrderRequest{}, opts...)
+	}
+}
+
+// EncodeListUserOrderRequest encodes requests sent to balance ListUserOrder
+// endpoint.
+func EncodeListUserOrderRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*balance.ListUserOrderPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "ListUserOrder", "*balance.ListUserOrderPayload", v)
+	}
+	(*md).Append("authorization", payload.Authorization)
+	return NewProtoListUserOrderRequest(payload), nil
+}
+
+// DecodeListUserOrderResponse decodes responses from the balance ListUserOrder
+// endpoint.
+func DecodeListUserOrderResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*balancepb.ListUserOrderResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "ListUserOrder", "*balancepb.ListUserOrderResponse", v)
+	}
+	if err := ValidateListUserOrderResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListUserOrderResult(message)
+	return res, nil
+}
+
+// BuildGetUserOrderFunc builds the remote method to invoke for "balance"
+// service "GetUserOrder" endpoint.
+func BuildGetUserOrderFunc(grpccli balancepb.BalanceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetUserOrder(ctx, reqpb.(*balancepb.GetUserOrderRequest), opts...)
+		}
+		return grpccli.GetUserOrder(ctx, &balancepb.GetUserOrderRequest{}, opts...)
+	}
+}
+
+// EncodeGetUserOrderRequest encodes requests sent to balance GetUserOrder
+// endpoint.
+func EncodeGetUserOrderRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*balance.GetUserOrderPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "GetUserOrder", "*balance.GetUserOrderPayload", v)
+	}
+	(*md).Append("authorization", payload.Authorization)
+	return NewProtoGetUserOrderRequest(payload), nil
+}
+
+// DecodeGetUserOrderResponse decodes responses from the balance GetUserOrder
+// endpoint.
+func DecodeGetUserOrderResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*balancepb.GetUserOrderResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "GetUserOrder", "*balancepb.GetUserOrderResponse", v)
+	}
+	if err := ValidateGetUserOrderResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetUserOrderResult(message)
+	return res, nil
+}
+
+// BuildGetUserBalanceFunc builds the remote method to invoke for "balance"
+// service "GetUserBalance" endpoint.
+func BuildGetUserBalanceFunc(grpccli balancepb.BalanceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetUserBalance(ctx, reqpb.(*balancepb.GetUserBalanceRequest), opts...)
+		}
+		return grpccli.GetUserBalance(ctx, &balancepb.GetUserBalanceRequest{}, opts...)
+	}
+}
+
+// EncodeGetUserBalanceRequest encodes requests sent to balance GetUserBalance
+// endpoint.
+func EncodeGetUserBalanceRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*balance.GetUserBalancePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "GetUserBalance", "*balance.GetUserBalancePayload", v)
+	}
+	(*md).Append("authorization", payload.Authorization)
+	return NewProtoGetUserBalanceRequest(), nil
+}
+
+// DecodeGetUserBalanceResponse decodes responses from the balance
+// GetUserBalance endpoint.
+func DecodeGetUserBalanceResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*balancepb.GetUserBalanceResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "GetUserBalance", "*balancepb.GetUserBalanceResponse", v)
+	}
+	res := NewGetUserBalanceResult(message)
+	return res, nil
+}
+
+// BuildWithdrawFunc builds the remote method to invoke for "balance" service
+// "Withdraw" endpoint.
+func BuildWithdrawFunc(grpccli balancepb.BalanceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Withdraw(ctx, reqpb.(*balancepb.WithdrawRequest), opts...)
+		}
+		return grpccli.Withdraw(ctx, &balancepb.WithdrawRequest{}, opts...)
+	}
+}
+
+// EncodeWithdrawRequest encodes requests sent to balance Withdraw endpoint.
+func EncodeWithdrawRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*balance.WithdrawPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "Withdraw", "*balance.WithdrawPayload", v)
+	}
+	(*md).Append("authorization", payload.Authorization)
+	return NewProtoWithdrawRequest(payload), nil
+}
+
+// BuildListWithdrawalsFunc builds the remote method to invoke for "balance"
+// service "ListWithdrawals" endpoint.
+func BuildListWithdrawalsFunc(grpccli balancepb.BalanceClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListWithdrawals(ctx, reqpb.(*balancepb.ListWithdrawalsRequest), opts...)
+		}
+		return grpccli.ListWithdrawals(ctx, &balancepb.ListWithdrawalsRequest{}, opts...)
+	}
+}
+
+// EncodeListWithdrawalsRequest encodes requests sent to balance
+// ListWithdrawals endpoint.
+func EncodeListWithdrawalsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*balance.ListWithdrawalsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "ListWithdrawals", "*balance.ListWithdrawalsPayload", v)
+	}
+	(*md).Append("authorization", payload.Authorization)
+	return NewProtoListWithdrawalsRequest(), nil
+}
+
+// DecodeListWithdrawalsResponse decodes responses from the balance
+// ListWithdrawals endpoint.
+func DecodeListWithdrawalsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*balancepb.ListWithdrawalsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "ListWithdrawals", "*balancepb.ListWithdrawalsResponse", v)
+	}
+	if err := ValidateListWithdrawalsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListWithdrawalsResult(message)
+	return res, nil
+}
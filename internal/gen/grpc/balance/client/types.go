@@ -0,0 +1,538 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance gRPC client types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// NewProtoUploadUserOrderRequest builds the gRPC request type from the payload
+// of the "UploadUserOrder" endpoint of the "balance" service.
+func NewProtoUploadUserOrderRequest(payload *balance.UploadUserOrderPayload) *balancepb.UploadUserOrderRequest {
+	message := &balancepb.UploadUserOrderRequest{
+		OrderNumber: payload.OrderNumber,
+	}
+	return message
+}
+
+// NewUploadUserOrderResult builds the result type of the "UploadUserOrder"
+// endpoint of the "balance" service from the gRPC response type.
+func NewUploadUserOrderResult(message *balancepb.UploadUserOrderResponse) *balance.UploadUserOrderResult {
+	result := &balance.UploadUserOrderResult{
+		Accepted: message.Accepted,
+	}
+	return result
+}
+
+// NewUploadUserOrderTheOrderBelongsToAnotherUserError builds the error type of
+// the "UploadUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewUploadUserOrderTheOrderBelongsToAnotherUserError(message *balancepb.UploadUserOrderTheOrderBelongsToAnotherUserError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewUploadUserOrderInvalidOrderNumberError builds the error type of the
+// "UploadUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewUploadUserOrderInvalidOrderNumberError(message *balancepb.UploadUserOrderInvalidOrderNumberError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewUploadUserOrderInvalidInputParameterError builds the error type of the
+// "UploadUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewUploadUserOrderInvalidInputParameterError(message *balancepb.UploadUserOrderInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewUploadUserOrderUserIsNotAuthenticatedError builds the error type of the
+// "UploadUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewUploadUserOrderUserIsNotAuthenticatedError(message *balancepb.UploadUserOrderUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewUploadUserOrderInternalServiceErrorError builds the error type of the
+// "UploadUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewUploadUserOrderInternalServiceErrorError(message *balancepb.UploadUserOrderInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewUploadUserOrderNotImplementedError builds the error type of the
+// "UploadUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewUploadUserOrderNotImplementedError(message *balancepb.UploadUserOrderNotImplementedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewProtoListUserOrderRequest builds the gRPC request type from the payload
+// of the "ListUserOrder" endpoint of the "balance" service.
+func NewProtoListUserOrderRequest(payload *balance.ListUserOrderPayload) *balancepb.ListUserOrderRequest {
+	message := &balancepb.ListUserOrderRequest{
+		Cursor: payload.Cursor,
+		From:   payload.From,
+		To:     payload.To,
+		Sort:   payload.Sort,
+	}
+	if payload.Limit != nil {
+		limit := int32(*payload.Limit)
+		message.Limit = &limit
+	}
+	if payload.Status != nil {
+		message.Status = make([]string, len(payload.Status))
+		for i, val := range payload.Status {
+			message.Status[i] = val
+		}
+	}
+	return message
+}
+
+// NewListUserOrderResult builds the result type of the "ListUserOrder"
+// endpoint of the "balance" service from the gRPC response type.
+func NewListUserOrderResult(message *balancepb.ListUserOrderResponse) *balance.ListUserOrderResult {
+	result := &balance.ListUserOrderResult{
+		NextCursor: message.NextCursor,
+		Link:       message.Link,
+		NoOrders:   message.NoOrders,
+	}
+	if message.Orders != nil {
+		result.Orders = make([]*balance.Order, len(message.Orders))
+		for i, val := range message.Orders {
+			result.Orders[i] = &balance.Order{
+				Number:     val.Number,
+				Status:     val.Status,
+				UploadedAt: val.UploadedAt,
+			}
+			if val.Accrual != nil {
+				accrual := money.Amount(*val.Accrual)
+				result.Orders[i].Accrual = &accrual
+			}
+		}
+	}
+	return result
+}
+
+// NewListUserOrderInvalidInputParameterError builds the error type of the
+// "ListUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListUserOrderInvalidInputParameterError(message *balancepb.ListUserOrderInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewListUserOrderUserIsNotAuthenticatedError builds the error type of the
+// "ListUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListUserOrderUserIsNotAuthenticatedError(message *balancepb.ListUserOrderUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewListUserOrderInternalServiceErrorError builds the error type of the
+// "ListUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListUserOrderInternalServiceErrorError(message *balancepb.ListUserOrderInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewListUserOrderNotImplementedError builds the error type of the
+// "ListUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListUserOrderNotImplementedError(message *balancepb.ListUserOrderNotImplementedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewProtoGetUserOrderRequest builds the gRPC request type from the payload of
+// the "GetUserOrder" endpoint of the "balance" service.
+func NewProtoGetUserOrderRequest(payload *balance.GetUserOrderPayload) *balancepb.GetUserOrderRequest {
+	message := &balancepb.GetUserOrderRequest{
+		Number: payload.Number,
+	}
+	return message
+}
+
+// NewGetUserOrderResult builds the result type of the "GetUserOrder" endpoint
+// of the "balance" service from the gRPC response type.
+func NewGetUserOrderResult(message *balancepb.GetUserOrderResponse) *balance.OrderTimeline {
+	result := &balance.OrderTimeline{
+		Number:      message.Number,
+		Status:      message.Status,
+		UploadedAt:  message.UploadedAt,
+		ProcessedAt: message.ProcessedAt,
+	}
+	if message.Accrual != nil {
+		accrual := money.Amount(*message.Accrual)
+		result.Accrual = &accrual
+	}
+	if message.History != nil {
+		result.History = make([]*balance.OrderStatusChange, len(message.History))
+		for i, val := range message.History {
+			result.History[i] = &balance.OrderStatusChange{
+				From:   val.From,
+				Status: val.Status,
+				Source: val.Source,
+				At:     val.At,
+			}
+		}
+	}
+	return result
+}
+
+// NewGetUserOrderOrderNotFoundError builds the error type of the
+// "GetUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserOrderOrderNotFoundError(message *balancepb.GetUserOrderOrderNotFoundError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewGetUserOrderInvalidInputParameterError builds the error type of the
+// "GetUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserOrderInvalidInputParameterError(message *balancepb.GetUserOrderInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewGetUserOrderUserIsNotAuthenticatedError builds the error type of the
+// "GetUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserOrderUserIsNotAuthenticatedError(message *balancepb.GetUserOrderUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewGetUserOrderInternalServiceErrorError builds the error type of the
+// "GetUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserOrderInternalServiceErrorError(message *balancepb.GetUserOrderInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewGetUserOrderNotImplementedError builds the error type of the
+// "GetUserOrder" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserOrderNotImplementedError(message *balancepb.GetUserOrderNotImplementedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewProtoGetUserBalanceRequest builds the gRPC request type from the payload
+// of the "GetUserBalance" endpoint of the "balance" service.
+func NewProtoGetUserBalanceRequest() *balancepb.GetUserBalanceRequest {
+	message := &balancepb.GetUserBalanceRequest{}
+	return message
+}
+
+// NewGetUserBalanceResult builds the result type of the "GetUserBalance"
+// endpoint of the "balance" service from the gRPC response type.
+func NewGetUserBalanceResult(message *balancepb.GetUserBalanceResponse) *balance.UserBalance {
+	result := &balance.UserBalance{
+		Current:   money.Amount(message.Current),
+		Withdrawn: money.Amount(message.Withdrawn),
+	}
+	return result
+}
+
+// NewGetUserBalanceInvalidInputParameterError builds the error type of the
+// "GetUserBalance" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserBalanceInvalidInputParameterError(message *balancepb.GetUserBalanceInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewGetUserBalanceUserIsNotAuthenticatedError builds the error type of the
+// "GetUserBalance" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserBalanceUserIsNotAuthenticatedError(message *balancepb.GetUserBalanceUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewGetUserBalanceInternalServiceErrorError builds the error type of the
+// "GetUserBalance" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserBalanceInternalServiceErrorError(message *balancepb.GetUserBalanceInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewGetUserBalanceNotImplementedError builds the error type of the
+// "GetUserBalance" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewGetUserBalanceNotImplementedError(message *balancepb.GetUserBalanceNotImplementedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewProtoWithdrawRequest builds the gRPC request type from the payload of the
+// "Withdraw" endpoint of the "balance" service.
+func NewProtoWithdrawRequest(payload *balance.WithdrawPayload) *balancepb.WithdrawRequest {
+	message := &balancepb.WithdrawRequest{
+		Order: payload.Order,
+		Sum:   int64(payload.Sum),
+	}
+	return message
+}
+
+// NewWithdrawInsufficientFundsError builds the error type of the "Withdraw"
+// endpoint of the "balance" service from the gRPC error response type.
+func NewWithdrawInsufficientFundsError(message *balancepb.WithdrawInsufficientFundsError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewWithdrawInvalidOrderNumberError builds the error type of the "Withdraw"
+// endpoint of the "balance" service from the gRPC error response type.
+func NewWithdrawInvalidOrderNumberError(message *balancepb.WithdrawInvalidOrderNumberError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewWithdrawInvalidInputParameterError builds the error type of the
+// "Withdraw" endpoint of the "balance" service from the gRPC error response
+// type.
+func NewWithdrawInvalidInputParameterError(message *balancepb.WithdrawInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewWithdrawUserIsNotAuthenticatedError builds the error type of the
+// "Withdraw" endpoint of the "balance" service from the gRPC error response
+// type.
+func NewWithdrawUserIsNotAuthenticatedError(message *balancepb.WithdrawUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewWithdrawInternalServiceErrorError builds the error type of the "Withdraw"
+// endpoint of the "balance" service from the gRPC error response type.
+func NewWithdrawInternalServiceErrorError(message *balancepb.WithdrawInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewWithdrawNotImplementedError builds the error type of the "Withdraw"
+// endpoint of the "balance" service from the gRPC error response type.
+func NewWithdrawNotImplementedError(message *balancepb.WithdrawNotImplementedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewProtoListWithdrawalsRequest builds the gRPC request type from the payload
+// of the "ListWithdrawals" endpoint of the "balance" service.
+func NewProtoListWithdrawalsRequest() *balancepb.ListWithdrawalsRequest {
+	message := &balancepb.ListWithdrawalsRequest{}
+	return message
+}
+
+// NewListWithdrawalsResult builds the result type of the "ListWithdrawals"
+// endpoint of the "balance" service from the gRPC response type.
+func NewListWithdrawalsResult(message *balancepb.ListWithdrawalsResponse) *balance.ListWithdrawalsResult {
+	result := &balance.ListWithdrawalsResult{
+		NoWithdrawals: message.NoWithdrawals,
+	}
+	if message.Withdrawals != nil {
+		result.Withdrawals = make([]*balance.Withdrawal, len(message.Withdrawals))
+		for i, val := range message.Withdrawals {
+			result.Withdrawals[i] = &balance.Withdrawal{
+				Order:       val.Order,
+				Sum:         money.Amount(val.Sum),
+				ProcessedAt: val.ProcessedAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewListWithdrawalsInvalidInputParameterError builds the error type of the
+// "ListWithdrawals" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListWithdrawalsInvalidInputParameterError(message *balancepb.ListWithdrawalsInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewListWithdrawalsUserIsNotAuthenticatedError builds the error type of the
+// "ListWithdrawals" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListWithdrawalsUserIsNotAuthenticatedError(message *balancepb.ListWithdrawalsUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewListWithdrawalsInternalServiceErrorError builds the error type of the
+// "ListWithdrawals" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListWithdrawalsInternalServiceErrorError(message *balancepb.ListWithdrawalsInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewListWithdrawalsNotImplementedError builds the error type of the
+// "ListWithdrawals" endpoint of the "balance" service from the gRPC error
+// response type.
+func NewListWithdrawalsNotImplementedError(message *balancepb.ListWithdrawalsNotImplementedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// ValidateListUserOrderResponse runs the validations defined on
+// ListUserOrderResponse.
+func ValidateListUserOrderResponse(message *balancepb.ListUserOrderResponse) (err error) {
+	for _, e := range message.Orders {
+		if e != nil {
+			if err2 := ValidateOrder(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateOrder runs the validations defined on Order.
+func ValidateOrder(elem *balancepb.Order) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("elem.number", elem.Number, "[1-9][0-9]*"))
+	if !(elem.Status == "NEW" || elem.Status == "PROCESSING" || elem.Status == "INVALID" || elem.Status == "PROCESSED") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.status", elem.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.uploaded_at", elem.UploadedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateGetUserOrderResponse runs the validations defined on
+// GetUserOrderResponse.
+func ValidateGetUserOrderResponse(message *balancepb.GetUserOrderResponse) (err error) {
+	if message.History == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("history", "message"))
+	}
+	if !(message.Status == "NEW" || message.Status == "PROCESSING" || message.Status == "INVALID" || message.Status == "PROCESSED") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.uploaded_at", message.UploadedAt, goa.FormatDateTime))
+	if message.ProcessedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.processed_at", *message.ProcessedAt, goa.FormatDateTime))
+	}
+	for _, e := range message.History {
+		if e != nil {
+			if err2 := ValidateOrderStatusChange(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateOrderStatusChange runs the validations defined on OrderStatusChange.
+func ValidateOrderStatusChange(elem *balancepb.OrderStatusChange) (err error) {
+	if elem.From != nil {
+		if !(*elem.From == "NEW" || *elem.From == "PROCESSING" || *elem.From == "INVALID" || *elem.From == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.from", *elem.From, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if !(elem.Status == "NEW" || elem.Status == "PROCESSING" || elem.Status == "INVALID" || elem.Status == "PROCESSED") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.status", elem.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+	}
+	if !(elem.Source == "upload" || elem.Source == "accrual" || elem.Source == "import" || elem.Source == "migration" || elem.Source == "admin") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.source", elem.Source, []any{"upload", "accrual", "import", "migration", "admin"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.at", elem.At, goa.FormatDateTime))
+	return
+}
+
+// ValidateListWithdrawalsResponse runs the validations defined on
+// ListWithdrawalsResponse.
+func ValidateListWithdrawalsResponse(message *balancepb.ListWithdrawalsResponse) (err error) {
+	for _, e := range message.Withdrawals {
+		if e != nil {
+			if err2 := ValidateWithdrawal(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateWithdrawal runs the validations defined on Withdrawal.
+func ValidateWithdrawal(elem *balancepb.Withdrawal) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.processed_at", elem.ProcessedAt, goa.FormatDateTime))
+	return
+}
@@ -0,0 +1,2446 @@
+// Code generated with goa v3.23.4, DO NOT EDIT.
+//
+// balance protocol buffer definition
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: goagen_internal_balance.proto
+
+package balancepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UploadUserOrderTheOrderBelongsToAnotherUserError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderTheOrderBelongsToAnotherUserError) Reset() {
+	*x = UploadUserOrderTheOrderBelongsToAnotherUserError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderTheOrderBelongsToAnotherUserError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderTheOrderBelongsToAnotherUserError) ProtoMessage() {}
+
+func (x *UploadUserOrderTheOrderBelongsToAnotherUserError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderTheOrderBelongsToAnotherUserError.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderTheOrderBelongsToAnotherUserError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadUserOrderTheOrderBelongsToAnotherUserError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadUserOrderInvalidOrderNumberError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderInvalidOrderNumberError) Reset() {
+	*x = UploadUserOrderInvalidOrderNumberError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderInvalidOrderNumberError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderInvalidOrderNumberError) ProtoMessage() {}
+
+func (x *UploadUserOrderInvalidOrderNumberError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderInvalidOrderNumberError.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderInvalidOrderNumberError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadUserOrderInvalidOrderNumberError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadUserOrderInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderInvalidInputParameterError) Reset() {
+	*x = UploadUserOrderInvalidInputParameterError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderInvalidInputParameterError) ProtoMessage() {}
+
+func (x *UploadUserOrderInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UploadUserOrderInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadUserOrderUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderUserIsNotAuthenticatedError) Reset() {
+	*x = UploadUserOrderUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *UploadUserOrderUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UploadUserOrderUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadUserOrderInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderInternalServiceErrorError) Reset() {
+	*x = UploadUserOrderInternalServiceErrorError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderInternalServiceErrorError) ProtoMessage() {}
+
+func (x *UploadUserOrderInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UploadUserOrderInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadUserOrderNotImplementedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderNotImplementedError) Reset() {
+	*x = UploadUserOrderNotImplementedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderNotImplementedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderNotImplementedError) ProtoMessage() {}
+
+func (x *UploadUserOrderNotImplementedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderNotImplementedError.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderNotImplementedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UploadUserOrderNotImplementedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadUserOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique user order number
+	OrderNumber   string `protobuf:"bytes,1,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderRequest) Reset() {
+	*x = UploadUserOrderRequest{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderRequest) ProtoMessage() {}
+
+func (x *UploadUserOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderRequest.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadUserOrderRequest) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+type UploadUserOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      *string                `protobuf:"bytes,1,opt,name=accepted,proto3,oneof" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserOrderResponse) Reset() {
+	*x = UploadUserOrderResponse{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserOrderResponse) ProtoMessage() {}
+
+func (x *UploadUserOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserOrderResponse.ProtoReflect.Descriptor instead.
+func (*UploadUserOrderResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UploadUserOrderResponse) GetAccepted() string {
+	if x != nil && x.Accepted != nil {
+		return *x.Accepted
+	}
+	return ""
+}
+
+type ListUserOrderInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrderInvalidInputParameterError) Reset() {
+	*x = ListUserOrderInvalidInputParameterError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrderInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrderInvalidInputParameterError) ProtoMessage() {}
+
+func (x *ListUserOrderInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrderInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*ListUserOrderInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListUserOrderInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListUserOrderUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrderUserIsNotAuthenticatedError) Reset() {
+	*x = ListUserOrderUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrderUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrderUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *ListUserOrderUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrderUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*ListUserOrderUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListUserOrderUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListUserOrderInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrderInternalServiceErrorError) Reset() {
+	*x = ListUserOrderInternalServiceErrorError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrderInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrderInternalServiceErrorError) ProtoMessage() {}
+
+func (x *ListUserOrderInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrderInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*ListUserOrderInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListUserOrderInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListUserOrderNotImplementedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrderNotImplementedError) Reset() {
+	*x = ListUserOrderNotImplementedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrderNotImplementedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrderNotImplementedError) ProtoMessage() {}
+
+func (x *ListUserOrderNotImplementedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrderNotImplementedError.ProtoReflect.Descriptor instead.
+func (*ListUserOrderNotImplementedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListUserOrderNotImplementedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListUserOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of the orders in the response. All the orders are listed
+	// if it's absent
+	Limit *int32 `protobuf:"zigzag32,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// The position to continue the listing from. It's taken from the Next-Cursor
+	// header of the previous response
+	Cursor *string `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// The statuses of the listed orders. The orders of any status are listed if
+	// it's absent
+	Status []string `protobuf:"bytes,3,rep,name=status,proto3" json:"status,omitempty"`
+	// The orders uploaded at or after the time are listed
+	From *string `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// The orders uploaded before the time are listed
+	To *string `protobuf:"bytes,5,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// The direction of sorting by the upload time
+	Sort          *string `protobuf:"bytes,6,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrderRequest) Reset() {
+	*x = ListUserOrderRequest{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrderRequest) ProtoMessage() {}
+
+func (x *ListUserOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrderRequest.ProtoReflect.Descriptor instead.
+func (*ListUserOrderRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListUserOrderRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserOrderRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUserOrderRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListUserOrderRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *ListUserOrderRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *ListUserOrderRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+type ListUserOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The cursor of the next page. It's absent on the last page
+	NextCursor *string `protobuf:"bytes,1,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	// The link to the next page. It's absent on the last page
+	Link          *string  `protobuf:"bytes,2,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	Orders        []*Order `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	NoOrders      *string  `protobuf:"bytes,4,opt,name=no_orders,json=noOrders,proto3,oneof" json:"no_orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserOrderResponse) Reset() {
+	*x = ListUserOrderResponse{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrderResponse) ProtoMessage() {}
+
+func (x *ListUserOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrderResponse.ProtoReflect.Descriptor instead.
+func (*ListUserOrderResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListUserOrderResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListUserOrderResponse) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *ListUserOrderResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListUserOrderResponse) GetNoOrders() string {
+	if x != nil && x.NoOrders != nil {
+		return *x.NoOrders
+	}
+	return ""
+}
+
+type Order struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Number string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual       *int64 `protobuf:"zigzag64,3,opt,name=accrual,proto3,oneof" json:"accrual,omitempty"`
+	UploadedAt    string `protobuf:"bytes,4,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Order) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetAccrual() int64 {
+	if x != nil && x.Accrual != nil {
+		return *x.Accrual
+	}
+	return 0
+}
+
+func (x *Order) GetUploadedAt() string {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return ""
+}
+
+type GetUserOrderOrderNotFoundError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderOrderNotFoundError) Reset() {
+	*x = GetUserOrderOrderNotFoundError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderOrderNotFoundError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderOrderNotFoundError) ProtoMessage() {}
+
+func (x *GetUserOrderOrderNotFoundError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderOrderNotFoundError.ProtoReflect.Descriptor instead.
+func (*GetUserOrderOrderNotFoundError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserOrderOrderNotFoundError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserOrderInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderInvalidInputParameterError) Reset() {
+	*x = GetUserOrderInvalidInputParameterError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderInvalidInputParameterError) ProtoMessage() {}
+
+func (x *GetUserOrderInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*GetUserOrderInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserOrderInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserOrderUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderUserIsNotAuthenticatedError) Reset() {
+	*x = GetUserOrderUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *GetUserOrderUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*GetUserOrderUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserOrderUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserOrderInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderInternalServiceErrorError) Reset() {
+	*x = GetUserOrderInternalServiceErrorError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderInternalServiceErrorError) ProtoMessage() {}
+
+func (x *GetUserOrderInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*GetUserOrderInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUserOrderInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserOrderNotImplementedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderNotImplementedError) Reset() {
+	*x = GetUserOrderNotImplementedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderNotImplementedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderNotImplementedError) ProtoMessage() {}
+
+func (x *GetUserOrderNotImplementedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderNotImplementedError.ProtoReflect.Descriptor instead.
+func (*GetUserOrderNotImplementedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserOrderNotImplementedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique user order number
+	Number        string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderRequest) Reset() {
+	*x = GetUserOrderRequest{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderRequest) ProtoMessage() {}
+
+func (x *GetUserOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetUserOrderRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserOrderRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type GetUserOrderResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Number string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *int64 `protobuf:"zigzag64,3,opt,name=accrual,proto3,oneof" json:"accrual,omitempty"`
+	UploadedAt string `protobuf:"bytes,4,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	// The time the order has reached a final status
+	ProcessedAt *string `protobuf:"bytes,5,opt,name=processed_at,json=processedAt,proto3,oneof" json:"processed_at,omitempty"`
+	// The transitions of the order status in their order
+	History       []*OrderStatusChange `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrderResponse) Reset() {
+	*x = GetUserOrderResponse{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrderResponse) ProtoMessage() {}
+
+func (x *GetUserOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetUserOrderResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUserOrderResponse) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *GetUserOrderResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetUserOrderResponse) GetAccrual() int64 {
+	if x != nil && x.Accrual != nil {
+		return *x.Accrual
+	}
+	return 0
+}
+
+func (x *GetUserOrderResponse) GetUploadedAt() string {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return ""
+}
+
+func (x *GetUserOrderResponse) GetProcessedAt() string {
+	if x != nil && x.ProcessedAt != nil {
+		return *x.ProcessedAt
+	}
+	return ""
+}
+
+func (x *GetUserOrderResponse) GetHistory() []*OrderStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type OrderStatusChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The previous status. It's absent for the first status of the order
+	From   *string `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Status string  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// upload: the user uploaded the order, accrual: the accrual system processed
+	// the order, import: the order was imported in bulk, migration: the status was
+	// recorded before the history was kept, admin: the admin reprocessed the order
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	At            string `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusChange) ProtoMessage() {}
+
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OrderStatusChange) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type GetUserBalanceInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceInvalidInputParameterError) Reset() {
+	*x = GetUserBalanceInvalidInputParameterError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceInvalidInputParameterError) ProtoMessage() {}
+
+func (x *GetUserBalanceInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUserBalanceInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserBalanceUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceUserIsNotAuthenticatedError) Reset() {
+	*x = GetUserBalanceUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *GetUserBalanceUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserBalanceUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserBalanceInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceInternalServiceErrorError) Reset() {
+	*x = GetUserBalanceInternalServiceErrorError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceInternalServiceErrorError) ProtoMessage() {}
+
+func (x *GetUserBalanceInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetUserBalanceInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserBalanceNotImplementedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceNotImplementedError) Reset() {
+	*x = GetUserBalanceNotImplementedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceNotImplementedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceNotImplementedError) ProtoMessage() {}
+
+func (x *GetUserBalanceNotImplementedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceNotImplementedError.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceNotImplementedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetUserBalanceNotImplementedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceRequest) Reset() {
+	*x = GetUserBalanceRequest{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceRequest) ProtoMessage() {}
+
+func (x *GetUserBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{27}
+}
+
+type GetUserBalanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The amount of the points with at most 2 fractional digits
+	Current int64 `protobuf:"zigzag64,1,opt,name=current,proto3" json:"current,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Withdrawn     int64 `protobuf:"zigzag64,2,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBalanceResponse) Reset() {
+	*x = GetUserBalanceResponse{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceResponse) ProtoMessage() {}
+
+func (x *GetUserBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserBalanceResponse) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *GetUserBalanceResponse) GetWithdrawn() int64 {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return 0
+}
+
+type WithdrawInsufficientFundsError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawInsufficientFundsError) Reset() {
+	*x = WithdrawInsufficientFundsError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawInsufficientFundsError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawInsufficientFundsError) ProtoMessage() {}
+
+func (x *WithdrawInsufficientFundsError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawInsufficientFundsError.ProtoReflect.Descriptor instead.
+func (*WithdrawInsufficientFundsError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WithdrawInsufficientFundsError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WithdrawInvalidOrderNumberError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawInvalidOrderNumberError) Reset() {
+	*x = WithdrawInvalidOrderNumberError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawInvalidOrderNumberError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawInvalidOrderNumberError) ProtoMessage() {}
+
+func (x *WithdrawInvalidOrderNumberError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawInvalidOrderNumberError.ProtoReflect.Descriptor instead.
+func (*WithdrawInvalidOrderNumberError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WithdrawInvalidOrderNumberError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WithdrawInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawInvalidInputParameterError) Reset() {
+	*x = WithdrawInvalidInputParameterError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawInvalidInputParameterError) ProtoMessage() {}
+
+func (x *WithdrawInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*WithdrawInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WithdrawInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WithdrawUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawUserIsNotAuthenticatedError) Reset() {
+	*x = WithdrawUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *WithdrawUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*WithdrawUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WithdrawUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WithdrawInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawInternalServiceErrorError) Reset() {
+	*x = WithdrawInternalServiceErrorError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawInternalServiceErrorError) ProtoMessage() {}
+
+func (x *WithdrawInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*WithdrawInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WithdrawInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WithdrawNotImplementedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawNotImplementedError) Reset() {
+	*x = WithdrawNotImplementedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawNotImplementedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawNotImplementedError) ProtoMessage() {}
+
+func (x *WithdrawNotImplementedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawNotImplementedError.ProtoReflect.Descriptor instead.
+func (*WithdrawNotImplementedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WithdrawNotImplementedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WithdrawRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of the order paid by the points
+	Order string `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Sum           int64 `protobuf:"zigzag64,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WithdrawRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetSum() int64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+type WithdrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawResponse) ProtoMessage() {}
+
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{36}
+}
+
+type ListWithdrawalsInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalsInvalidInputParameterError) Reset() {
+	*x = ListWithdrawalsInvalidInputParameterError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalsInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsInvalidInputParameterError) ProtoMessage() {}
+
+func (x *ListWithdrawalsInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListWithdrawalsInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListWithdrawalsUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalsUserIsNotAuthenticatedError) Reset() {
+	*x = ListWithdrawalsUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalsUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *ListWithdrawalsUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListWithdrawalsUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListWithdrawalsInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalsInternalServiceErrorError) Reset() {
+	*x = ListWithdrawalsInternalServiceErrorError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalsInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsInternalServiceErrorError) ProtoMessage() {}
+
+func (x *ListWithdrawalsInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListWithdrawalsInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListWithdrawalsNotImplementedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalsNotImplementedError) Reset() {
+	*x = ListWithdrawalsNotImplementedError{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalsNotImplementedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsNotImplementedError) ProtoMessage() {}
+
+func (x *ListWithdrawalsNotImplementedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsNotImplementedError.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsNotImplementedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListWithdrawalsNotImplementedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListWithdrawalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalsRequest) Reset() {
+	*x = ListWithdrawalsRequest{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsRequest) ProtoMessage() {}
+
+func (x *ListWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{41}
+}
+
+type ListWithdrawalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawals   []*Withdrawal          `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	NoWithdrawals *string                `protobuf:"bytes,2,opt,name=no_withdrawals,json=noWithdrawals,proto3,oneof" json:"no_withdrawals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWithdrawalsResponse) Reset() {
+	*x = ListWithdrawalsResponse{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsResponse) ProtoMessage() {}
+
+func (x *ListWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListWithdrawalsResponse) GetWithdrawals() []*Withdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+func (x *ListWithdrawalsResponse) GetNoWithdrawals() string {
+	if x != nil && x.NoWithdrawals != nil {
+		return *x.NoWithdrawals
+	}
+	return ""
+}
+
+type Withdrawal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of the order paid by the points
+	Order string `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Sum           int64  `protobuf:"zigzag64,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	ProcessedAt   string `protobuf:"bytes,3,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	mi := &file_goagen_internal_balance_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_balance_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_balance_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Withdrawal) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetSum() int64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetProcessedAt() string {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return ""
+}
+
+var File_goagen_internal_balance_proto protoreflect.FileDescriptor
+
+const file_goagen_internal_balance_proto_rawDesc = "" +
+	"\n" +
+	"\x1dgoagen_internal_balance.proto\x12\abalance\"F\n" +
+	"0UploadUserOrderTheOrderBelongsToAnotherUserError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"<\n" +
+	"&UploadUserOrderInvalidOrderNumberError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"?\n" +
+	")UploadUserOrderInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"@\n" +
+	"*UploadUserOrderUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\">\n" +
+	"(UploadUserOrderInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\"UploadUserOrderNotImplementedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\";\n" +
+	"\x16UploadUserOrderRequest\x12!\n" +
+	"\forder_number\x18\x01 \x01(\tR\vorderNumber\"G\n" +
+	"\x17UploadUserOrderResponse\x12\x1f\n" +
+	"\baccepted\x18\x01 \x01(\tH\x00R\baccepted\x88\x01\x01B\v\n" +
+	"\t_accepted\"=\n" +
+	"'ListUserOrderInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\">\n" +
+	"(ListUserOrderUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"<\n" +
+	"&ListUserOrderInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"6\n" +
+	" ListUserOrderNotImplementedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xdb\x01\n" +
+	"\x14ListUserOrderRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x11H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x01R\x06cursor\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x03 \x03(\tR\x06status\x12\x17\n" +
+	"\x04from\x18\x04 \x01(\tH\x02R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x05 \x01(\tH\x03R\x02to\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\x06 \x01(\tH\x04R\x04sort\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursorB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\a\n" +
+	"\x05_sort\"\xc7\x01\n" +
+	"\x15ListUserOrderResponse\x12$\n" +
+	"\vnext_cursor\x18\x01 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x17\n" +
+	"\x04link\x18\x02 \x01(\tH\x01R\x04link\x88\x01\x01\x12&\n" +
+	"\x06orders\x18\x03 \x03(\v2\x0e.balance.OrderR\x06orders\x12 \n" +
+	"\tno_orders\x18\x04 \x01(\tH\x02R\bnoOrders\x88\x01\x01B\x0e\n" +
+	"\f_next_cursorB\a\n" +
+	"\x05_linkB\f\n" +
+	"\n" +
+	"_no_orders\"\x83\x01\n" +
+	"\x05Order\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\aaccrual\x18\x03 \x01(\x12H\x00R\aaccrual\x88\x01\x01\x12\x1f\n" +
+	"\vuploaded_at\x18\x04 \x01(\tR\n" +
+	"uploadedAtB\n" +
+	"\n" +
+	"\b_accrual\"4\n" +
+	"\x1eGetUserOrderOrderNotFoundError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"<\n" +
+	"&GetUserOrderInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"=\n" +
+	"'GetUserOrderUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\";\n" +
+	"%GetUserOrderInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"5\n" +
+	"\x1fGetUserOrderNotImplementedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"-\n" +
+	"\x13GetUserOrderRequest\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\"\x81\x02\n" +
+	"\x14GetUserOrderResponse\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\aaccrual\x18\x03 \x01(\x12H\x00R\aaccrual\x88\x01\x01\x12\x1f\n" +
+	"\vuploaded_at\x18\x04 \x01(\tR\n" +
+	"uploadedAt\x12&\n" +
+	"\fprocessed_at\x18\x05 \x01(\tH\x01R\vprocessedAt\x88\x01\x01\x124\n" +
+	"\ahistory\x18\x06 \x03(\v2\x1a.balance.OrderStatusChangeR\ahistoryB\n" +
+	"\n" +
+	"\b_accrualB\x0f\n" +
+	"\r_processed_at\"u\n" +
+	"\x11OrderStatusChange\x12\x17\n" +
+	"\x04from\x18\x01 \x01(\tH\x00R\x04from\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02at\x18\x04 \x01(\tR\x02atB\a\n" +
+	"\x05_from\">\n" +
+	"(GetUserBalanceInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"?\n" +
+	")GetUserBalanceUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"=\n" +
+	"'GetUserBalanceInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"7\n" +
+	"!GetUserBalanceNotImplementedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x17\n" +
+	"\x15GetUserBalanceRequest\"P\n" +
+	"\x16GetUserBalanceResponse\x12\x18\n" +
+	"\acurrent\x18\x01 \x01(\x12R\acurrent\x12\x1c\n" +
+	"\twithdrawn\x18\x02 \x01(\x12R\twithdrawn\"4\n" +
+	"\x1eWithdrawInsufficientFundsError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"5\n" +
+	"\x1fWithdrawInvalidOrderNumberError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\"WithdrawInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
+	"#WithdrawUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"7\n" +
+	"!WithdrawInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"1\n" +
+	"\x1bWithdrawNotImplementedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
+	"\x0fWithdrawRequest\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\tR\x05order\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x12R\x03sum\"\x12\n" +
+	"\x10WithdrawResponse\"?\n" +
+	")ListWithdrawalsInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"@\n" +
+	"*ListWithdrawalsUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\">\n" +
+	"(ListWithdrawalsInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\"ListWithdrawalsNotImplementedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x18\n" +
+	"\x16ListWithdrawalsRequest\"\x8f\x01\n" +
+	"\x17ListWithdrawalsResponse\x125\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x13.balance.WithdrawalR\vwithdrawals\x12*\n" +
+	"\x0eno_withdrawals\x18\x02 \x01(\tH\x00R\rnoWithdrawals\x88\x01\x01B\x11\n" +
+	"\x0f_no_withdrawals\"W\n" +
+	"\n" +
+	"Withdrawal\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\tR\x05order\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x12R\x03sum\x12!\n" +
+	"\fprocessed_at\x18\x03 \x01(\tR\vprocessedAt2\xe6\x03\n" +
+	"\aBalance\x12T\n" +
+	"\x0fUploadUserOrder\x12\x1f.balance.UploadUserOrderRequest\x1a .balance.UploadUserOrderResponse\x12N\n" +
+	"\rListUserOrder\x12\x1d.balance.ListUserOrderRequest\x1a\x1e.balance.ListUserOrderResponse\x12K\n" +
+	"\fGetUserOrder\x12\x1c.balance.GetUserOrderRequest\x1a\x1d.balance.GetUserOrderResponse\x12Q\n" +
+	"\x0eGetUserBalance\x12\x1e.balance.GetUserBalanceRequest\x1a\x1f.balance.GetUserBalanceResponse\x12?\n" +
+	"\bWithdraw\x12\x18.balance.WithdrawRequest\x1a\x19.balance.WithdrawResponse\x12T\n" +
+	"\x0fListWithdrawals\x12\x1f.balance.ListWithdrawalsRequest\x1a .balance.ListWithdrawalsResponseB\fZ\n" +
+	"/balancepbb\x06proto3"
+
+var (
+	file_goagen_internal_balance_proto_rawDescOnce sync.Once
+	file_goagen_internal_balance_proto_rawDescData []byte
+)
+
+func file_goagen_internal_balance_proto_rawDescGZIP() []byte {
+	file_goagen_internal_balance_proto_rawDescOnce.Do(func() {
+		file_goagen_internal_balance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_goagen_internal_balance_proto_rawDesc), len(file_goagen_internal_balance_proto_rawDesc)))
+	})
+	return file_goagen_internal_balance_proto_rawDescData
+}
+
+var file_goagen_internal_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_goagen_internal_balance_proto_goTypes = []any{
+	(*UploadUserOrderTheOrderBelongsToAnotherUserError)(nil), // 0: balance.UploadUserOrderTheOrderBelongsToAnotherUserError
+	(*UploadUserOrderInvalidOrderNumberError)(nil),           // 1: balance.UploadUserOrderInvalidOrderNumberError
+	(*UploadUserOrderInvalidInputParameterError)(nil),        // 2: balance.UploadUserOrderInvalidInputParameterError
+	(*UploadUserOrderUserIsNotAuthenticatedError)(nil),       // 3: balance.UploadUserOrderUserIsNotAuthenticatedError
+	(*UploadUserOrderInternalServiceErrorError)(nil),         // 4: balance.UploadUserOrderInternalServiceErrorError
+	(*UploadUserOrderNotImplementedError)(nil),               // 5: balance.UploadUserOrderNotImplementedError
+	(*UploadUserOrderRequest)(nil),                           // 6: balance.UploadUserOrderRequest
+	(*UploadUserOrderResponse)(nil),                          // 7: balance.UploadUserOrderResponse
+	(*ListUserOrderInvalidInputParameterError)(nil),          // 8: balance.ListUserOrderInvalidInputParameterError
+	(*ListUserOrderUserIsNotAuthenticatedError)(nil),         // 9: balance.ListUserOrderUserIsNotAuthenticatedError
+	(*ListUserOrderInternalServiceErrorError)(nil),           // 10: balance.ListUserOrderInternalServiceErrorError
+	(*ListUserOrderNotImplementedError)(nil),                 // 11: balance.ListUserOrderNotImplementedError
+	(*ListUserOrderRequest)(nil),                             // 12: balance.ListUserOrderRequest
+	(*ListUserOrderResponse)(nil),                            // 13: balance.ListUserOrderResponse
+	(*Order)(nil),                                            // 14: balance.Order
+	(*GetUserOrderOrderNotFoundError)(nil),                   // 15: balance.GetUserOrderOrderNotFoundError
+	(*GetUserOrderInvalidInputParameterError)(nil),           // 16: balance.GetUserOrderInvalidInputParameterError
+	(*GetUserOrderUserIsNotAuthenticatedError)(nil),          // 17: balance.GetUserOrderUserIsNotAuthenticatedError
+	(*GetUserOrderInternalServiceErrorError)(nil),            // 18: balance.GetUserOrderInternalServiceErrorError
+	(*GetUserOrderNotImplementedError)(nil),                  // 19: balance.GetUserOrderNotImplementedError
+	(*GetUserOrderRequest)(nil),                              // 20: balance.GetUserOrderRequest
+	(*GetUserOrderResponse)(nil),                             // 21: balance.GetUserOrderResponse
+	(*OrderStatusChange)(nil),                                // 22: balance.OrderStatusChange
+	(*GetUserBalanceInvalidInputParameterError)(nil),         // 23: balance.GetUserBalanceInvalidInputParameterError
+	(*GetUserBalanceUserIsNotAuthenticatedError)(nil),        // 24: balance.GetUserBalanceUserIsNotAuthenticatedError
+	(*GetUserBalanceInternalServiceErrorError)(nil),          // 25: balance.GetUserBalanceInternalServiceErrorError
+	(*GetUserBalanceNotImplementedError)(nil),                // 26: balance.GetUserBalanceNotImplementedError
+	(*GetUserBalanceRequest)(nil),                            // 27: balance.GetUserBalanceRequest
+	(*GetUserBalanceResponse)(nil),                           // 28: balance.GetUserBalanceResponse
+	(*WithdrawInsufficientFundsError)(nil),                   // 29: balance.WithdrawInsufficientFundsError
+	(*WithdrawInvalidOrderNumberError)(nil),                  // 30: balance.WithdrawInvalidOrderNumberError
+	(*WithdrawInvalidInputParameterError)(nil),               // 31: balance.WithdrawInvalidInputParameterError
+	(*WithdrawUserIsNotAuthenticatedError)(nil),              // 32: balance.WithdrawUserIsNotAuthenticatedError
+	(*WithdrawInternalServiceErrorError)(nil),                // 33: balance.WithdrawInternalServiceErrorError
+	(*WithdrawNotImplementedError)(nil),                      // 34: balance.WithdrawNotImplementedError
+	(*WithdrawRequest)(nil),                                  // 35: balance.WithdrawRequest
+	(*WithdrawResponse)(nil),                                 // 36: balance.WithdrawResponse
+	(*ListWithdrawalsInvalidInputParameterError)(nil),        // 37: balance.ListWithdrawalsInvalidInputParameterError
+	(*ListWithdrawalsUserIsNotAuthenticatedError)(nil),       // 38: balance.ListWithdrawalsUserIsNotAuthenticatedError
+	(*ListWithdrawalsInternalServiceErrorError)(nil),         // 39: balance.ListWithdrawalsInternalServiceErrorError
+	(*ListWithdrawalsNotImplementedError)(nil),               // 40: balance.ListWithdrawalsNotImplementedError
+	(*ListWithdrawalsRequest)(nil),                           // 41: balance.ListWithdrawalsRequest
+	(*ListWithdrawalsResponse)(nil),                          // 42: balance.ListWithdrawalsResponse
+	(*Withdrawal)(nil),                                       // 43: balance.Withdrawal
+}
+var file_goagen_internal_balance_proto_depIdxs = []int32{
+	14, // 0: balance.ListUserOrderResponse.orders:type_name -> balance.Order
+	22, // 1: balance.GetUserOrderResponse.history:type_name -> balance.OrderStatusChange
+	43, // 2: balance.ListWithdrawalsResponse.withdrawals:type_name -> balance.Withdrawal
+	6,  // 3: balance.Balance.UploadUserOrder:input_type -> balance.UploadUserOrderRequest
+	12, // 4: balance.Balance.ListUserOrder:input_type -> balance.ListUserOrderRequest
+	20, // 5: balance.Balance.GetUserOrder:input_type -> balance.GetUserOrderRequest
+	27, // 6: balance.Balance.GetUserBalance:input_type -> balance.GetUserBalanceRequest
+	35, // 7: balance.Balance.Withdraw:input_type -> balance.WithdrawRequest
+	41, // 8: balance.Balance.ListWithdrawals:input_type -> balance.ListWithdrawalsRequest
+	7,  // 9: balance.Balance.UploadUserOrder:output_type -> balance.UploadUserOrderResponse
+	13, // 10: balance.Balance.ListUserOrder:output_type -> balance.ListUserOrderResponse
+	21, // 11: balance.Balance.GetUserOrder:output_type -> balance.GetUserOrderResponse
+	28, // 12: balance.Balance.GetUserBalance:output_type -> balance.GetUserBalanceResponse
+	36, // 13: balance.Balance.Withdraw:output_type -> balance.WithdrawResponse
+	42, // 14: balance.Balance.ListWithdrawals:output_type -> balance.ListWithdrawalsResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_goagen_internal_balance_proto_init() }
+func file_goagen_internal_balance_proto_init() {
+	if File_goagen_internal_balance_proto != nil {
+		return
+	}
+	file_goagen_internal_balance_proto_msgTypes[7].OneofWrappers = []any{}
+	file_goagen_internal_balance_proto_msgTypes[12].OneofWrappers = []any{}
+	file_goagen_internal_balance_proto_msgTypes[13].OneofWrappers = []any{}
+	file_goagen_internal_balance_proto_msgTypes[14].OneofWrappers = []any{}
+	file_goagen_internal_balance_proto_msgTypes[21].OneofWrappers = []any{}
+	file_goagen_internal_balance_proto_msgTypes[22].OneofWrappers = []any{}
+	file_goagen_internal_balance_proto_msgTypes[42].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_internal_balance_proto_rawDesc), len(file_goagen_internal_balance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   44,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_goagen_internal_balance_proto_goTypes,
+		DependencyIndexes: file_goagen_internal_balance_proto_depIdxs,
+		MessageInfos:      file_goagen_internal_balance_proto_msgTypes,
+	}.Build()
+	File_goagen_internal_balance_proto = out.File
+	file_goagen_internal_balance_proto_goTypes = nil
+	file_goagen_internal_balance_proto_depIdxs = nil
+}
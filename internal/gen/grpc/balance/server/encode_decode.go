@@ -0,0 +1,300 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance gRPC server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/metadata"
+)
+
+// EncodeUploadUserOrderResponse encodes responses from the "balance" service
+// "UploadUserOrder" endpoint.
+func EncodeUploadUserOrderResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*balance.UploadUserOrderResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "UploadUserOrder", "*balance.UploadUserOrderResult", v)
+	}
+	resp := NewProtoUploadUserOrderResponse(result)
+	return resp, nil
+}
+
+// DecodeUploadUserOrderRequest decodes requests sent to "balance" service
+// "UploadUserOrder" endpoint.
+func DecodeUploadUserOrderRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		authorization string
+		err           error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			authorization = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *balancepb.UploadUserOrderRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*balancepb.UploadUserOrderRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("balance", "UploadUserOrder", "*balancepb.UploadUserOrderRequest", v)
+		}
+		if err = ValidateUploadUserOrderRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *balance.UploadUserOrderPayload
+	{
+		payload = NewUploadUserOrderPayload(message, authorization)
+		if strings.Contains(payload.Authorization, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Authorization, " ", 2)[1]
+			payload.Authorization = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeListUserOrderResponse encodes responses from the "balance" service
+// "ListUserOrder" endpoint.
+func EncodeListUserOrderResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*balance.ListUserOrderResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "ListUserOrder", "*balance.ListUserOrderResult", v)
+	}
+	resp := NewProtoListUserOrderResponse(result)
+	return resp, nil
+}
+
+// DecodeListUserOrderRequest decodes requests sent to "balance" service
+// "ListUserOrder" endpoint.
+func DecodeListUserOrderRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		authorization string
+		err           error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			authorization = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *balancepb.ListUserOrderRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*balancepb.ListUserOrderRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("balance", "ListUserOrder", "*balancepb.ListUserOrderRequest", v)
+		}
+		if err = ValidateListUserOrderRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *balance.ListUserOrderPayload
+	{
+		payload = NewListUserOrderPayload(message, authorization)
+		if strings.Contains(payload.Authorization, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Authorization, " ", 2)[1]
+			payload.Authorization = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeGetUserOrderResponse encodes responses from the "balance" service
+// "GetUserOrder" endpoint.
+func EncodeGetUserOrderResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*balance.OrderTimeline)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "GetUserOrder", "*balance.OrderTimeline", v)
+	}
+	resp := NewProtoGetUserOrderResponse(result)
+	return resp, nil
+}
+
+// DecodeGetUserOrderRequest decodes requests sent to "balance" service
+// "GetUserOrder" endpoint.
+func DecodeGetUserOrderRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		authorization string
+		err           error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			authorization = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *balancepb.GetUserOrderRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*balancepb.GetUserOrderRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("balance", "GetUserOrder", "*balancepb.GetUserOrderRequest", v)
+		}
+	}
+	var payload *balance.GetUserOrderPayload
+	{
+		payload = NewGetUserOrderPayload(message, authorization)
+		if strings.Contains(payload.Authorization, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Authorization, " ", 2)[1]
+			payload.Authorization = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeGetUserBalanceResponse encodes responses from the "balance" service
+// "GetUserBalance" endpoint.
+func EncodeGetUserBalanceResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*balance.UserBalance)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "GetUserBalance", "*balance.UserBalance", v)
+	}
+	resp := NewProtoGetUserBalanceResponse(result)
+	return resp, nil
+}
+
+// DecodeGetUserBalanceRequest decodes requests sent to "balance" service
+// "GetUserBalance" endpoint.
+func DecodeGetUserBalanceRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		authorization string
+		err           error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			authorization = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var payload *balance.GetUserBalancePayload
+	{
+		payload = NewGetUserBalancePayload(authorization)
+		if strings.Contains(payload.Authorization, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Authorization, " ", 2)[1]
+			payload.Authorization = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeWithdrawResponse encodes responses from the "balance" service
+// "Withdraw" endpoint.
+func EncodeWithdrawResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoWithdrawResponse()
+	return resp, nil
+}
+
+// DecodeWithdrawRequest decodes requests sent to "balance" service "Withdraw"
+// endpoint.
+func DecodeWithdrawRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		authorization string
+		err           error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			authorization = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *balancepb.WithdrawRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*balancepb.WithdrawRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("balance", "Withdraw", "*balancepb.WithdrawRequest", v)
+		}
+		if err = ValidateWithdrawRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *balance.WithdrawPayload
+	{
+		payload = NewWithdrawPayload(message, authorization)
+		if strings.Contains(payload.Authorization, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Authorization, " ", 2)[1]
+			payload.Authorization = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeListWithdrawalsResponse encodes responses from the "balance" service
+// "ListWithdrawals" endpoint.
+func EncodeListWithdrawalsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*balance.ListWithdrawalsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("balance", "ListWithdrawals", "*balance.ListWithdrawalsResult", v)
+	}
+	resp := NewProtoListWithdrawalsResponse(result)
+	return resp, nil
+}
+
+// DecodeListWithdrawalsRequest decodes requests sent to "balance" service
+// "ListWithdrawals" endpoint.
+func DecodeListWithdrawalsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		authorization string
+		err           error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			authorization = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var payload *balance.ListWithdrawalsPayload
+	{
+		payload = NewListWithdrawalsPayload(authorization)
+		if strings.Contains(payload.Authorization, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Authorization, " ", 2)[1]
+			payload.Authorization = cred
+		}
+	}
+	return payload, nil
+}
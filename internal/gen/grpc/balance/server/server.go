@@ -0,0 +1,327 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance gRPC server
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
+)
+
+// Server implements the balancepb.BalanceServer interface.
+type Server struct {
+	UploadUserOrderH goagrpc.UnaryHandler
+	ListUserOrderH   goagrpc.UnaryHandler
+	GetUserOrderH    goagrpc.UnaryHandler
+	GetUserBalanceH  goagrpc.UnaryHandler
+	WithdrawH        goagrpc.UnaryHandler
+	ListWithdrawalsH goagrpc.UnaryHandler
+	balancepb.UnimplementedBalanceServer
+}
+
+// New instantiates the server struct with the balance service endpoints.
+func New(e *balance.Endpoints, uh goagrpc.UnaryHandler) *Server {
+	return &Server{
+		UploadUserOrderH: NewUploadUserOrderHandler(e.UploadUserOrder, uh),
+		ListUserOrderH:   NewListUserOrderHandler(e.ListUserOrder, uh),
+		GetUserOrderH:    NewGetUserOrderHandler(e.GetUserOrder, uh),
+		GetUserBalanceH:  NewGetUserBalanceHandler(e.GetUserBalance, uh),
+		WithdrawH:        NewWithdrawHandler(e.Withdraw, uh),
+		ListWithdrawalsH: NewListWithdrawalsHandler(e.ListWithdrawals, uh),
+	}
+}
+
+// NewUploadUserOrderHandler creates a gRPC handler which serves the "balance"
+// service "UploadUserOrder" endpoint.
+func NewUploadUserOrderHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeUploadUserOrderRequest, EncodeUploadUserOrderResponse)
+	}
+	return h
+}
+
+// UploadUserOrder implements the "UploadUserOrder" method in
+// balancepb.BalanceServer interface.
+func (s *Server) UploadUserOrder(ctx context.Context, message *balancepb.UploadUserOrderRequest) (*balancepb.UploadUserOrderResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "UploadUserOrder")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+	resp, err := s.UploadUserOrderH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "The order belongs to another user":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.AlreadyExists, err, NewUploadUserOrderTheOrderBelongsToAnotherUserError(er))
+			case "Invalid order number":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewUploadUserOrderInvalidOrderNumberError(er))
+			case "missing_field":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewUploadUserOrderInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewUploadUserOrderUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewUploadUserOrderInternalServiceErrorError(er))
+			case "Not implemented":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unimplemented, err, NewUploadUserOrderNotImplementedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*balancepb.UploadUserOrderResponse), nil
+}
+
+// NewListUserOrderHandler creates a gRPC handler which serves the "balance"
+// service "ListUserOrder" endpoint.
+func NewListUserOrderHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListUserOrderRequest, EncodeListUserOrderResponse)
+	}
+	return h
+}
+
+// ListUserOrder implements the "ListUserOrder" method in
+// balancepb.BalanceServer interface.
+func (s *Server) ListUserOrder(ctx context.Context, message *balancepb.ListUserOrderRequest) (*balancepb.ListUserOrderResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ListUserOrder")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+	resp, err := s.ListUserOrderH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "missing_field":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewListUserOrderInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewListUserOrderUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewListUserOrderInternalServiceErrorError(er))
+			case "Not implemented":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unimplemented, err, NewListUserOrderNotImplementedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*balancepb.ListUserOrderResponse), nil
+}
+
+// NewGetUserOrderHandler creates a gRPC handler which serves the "balance"
+// service "GetUserOrder" endpoint.
+func NewGetUserOrderHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetUserOrderRequest, EncodeGetUserOrderResponse)
+	}
+	return h
+}
+
+// GetUserOrder implements the "GetUserOrder" method in balancepb.BalanceServer
+// interface.
+func (s *Server) GetUserOrder(ctx context.Context, message *balancepb.GetUserOrderRequest) (*balancepb.GetUserOrderResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetUserOrder")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+	resp, err := s.GetUserOrderH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "Order not found":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetUserOrderOrderNotFoundError(er))
+			case "missing_field":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetUserOrderInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewGetUserOrderUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetUserOrderInternalServiceErrorError(er))
+			case "Not implemented":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unimplemented, err, NewGetUserOrderNotImplementedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*balancepb.GetUserOrderResponse), nil
+}
+
+// NewGetUserBalanceHandler creates a gRPC handler which serves the "balance"
+// service "GetUserBalance" endpoint.
+func NewGetUserBalanceHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetUserBalanceRequest, EncodeGetUserBalanceResponse)
+	}
+	return h
+}
+
+// GetUserBalance implements the "GetUserBalance" method in
+// balancepb.BalanceServer interface.
+func (s *Server) GetUserBalance(ctx context.Context, message *balancepb.GetUserBalanceRequest) (*balancepb.GetUserBalanceResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetUserBalance")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+	resp, err := s.GetUserBalanceH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "missing_field":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewGetUserBalanceInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewGetUserBalanceUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewGetUserBalanceInternalServiceErrorError(er))
+			case "Not implemented":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unimplemented, err, NewGetUserBalanceNotImplementedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*balancepb.GetUserBalanceResponse), nil
+}
+
+// NewWithdrawHandler creates a gRPC handler which serves the "balance" service
+// "Withdraw" endpoint.
+func NewWithdrawHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeWithdrawRequest, EncodeWithdrawResponse)
+	}
+	return h
+}
+
+// Withdraw implements the "Withdraw" method in balancepb.BalanceServer
+// interface.
+func (s *Server) Withdraw(ctx context.Context, message *balancepb.WithdrawRequest) (*balancepb.WithdrawResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "Withdraw")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+	resp, err := s.WithdrawH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "Insufficient funds":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, NewWithdrawInsufficientFundsError(er))
+			case "Invalid order number":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewWithdrawInvalidOrderNumberError(er))
+			case "missing_field":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewWithdrawInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewWithdrawUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewWithdrawInternalServiceErrorError(er))
+			case "Not implemented":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unimplemented, err, NewWithdrawNotImplementedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*balancepb.WithdrawResponse), nil
+}
+
+// NewListWithdrawalsHandler creates a gRPC handler which serves the "balance"
+// service "ListWithdrawals" endpoint.
+func NewListWithdrawalsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListWithdrawalsRequest, EncodeListWithdrawalsResponse)
+	}
+	return h
+}
+
+// ListWithdrawals implements the "ListWithdrawals" method in
+// balancepb.BalanceServer interface.
+func (s *Server) ListWithdrawals(ctx context.Context, message *balancepb.ListWithdrawalsRequest) (*balancepb.ListWithdrawalsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ListWithdrawals")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+	resp, err := s.ListWithdrawalsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "missing_field":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewListWithdrawalsInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewListWithdrawalsUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewListWithdrawalsInternalServiceErrorError(er))
+			case "Not implemented":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unimplemented, err, NewListWithdrawalsNotImplementedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*balancepb.ListWithdrawalsResponse), nil
+}
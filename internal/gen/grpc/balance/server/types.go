@@ -0,0 +1,500 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance gRPC server types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// NewUploadUserOrderPayload builds the payload of the "UploadUserOrder"
+// endpoint of the "balance" service from the gRPC request type.
+func NewUploadUserOrderPayload(message *balancepb.UploadUserOrderRequest, authorization string) *balance.UploadUserOrderPayload {
+	v := &balance.UploadUserOrderPayload{
+		OrderNumber: message.OrderNumber,
+	}
+	v.Authorization = authorization
+	return v
+}
+
+// NewProtoUploadUserOrderResponse builds the gRPC response type from the
+// result of the "UploadUserOrder" endpoint of the "balance" service.
+func NewProtoUploadUserOrderResponse(result *balance.UploadUserOrderResult) *balancepb.UploadUserOrderResponse {
+	message := &balancepb.UploadUserOrderResponse{
+		Accepted: result.Accepted,
+	}
+	return message
+}
+
+// NewUploadUserOrderTheOrderBelongsToAnotherUserError builds the gRPC error
+// response type from the error of the "UploadUserOrder" endpoint of the
+// "balance" service.
+func NewUploadUserOrderTheOrderBelongsToAnotherUserError(er *service.GophermartError) *balancepb.UploadUserOrderTheOrderBelongsToAnotherUserError {
+	message := &balancepb.UploadUserOrderTheOrderBelongsToAnotherUserError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewUploadUserOrderInvalidOrderNumberError builds the gRPC error response
+// type from the error of the "UploadUserOrder" endpoint of the "balance"
+// service.
+func NewUploadUserOrderInvalidOrderNumberError(er *service.GophermartError) *balancepb.UploadUserOrderInvalidOrderNumberError {
+	message := &balancepb.UploadUserOrderInvalidOrderNumberError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewUploadUserOrderInvalidInputParameterError builds the gRPC error response
+// type from the error of the "UploadUserOrder" endpoint of the "balance"
+// service.
+func NewUploadUserOrderInvalidInputParameterError(er *service.GophermartError) *balancepb.UploadUserOrderInvalidInputParameterError {
+	message := &balancepb.UploadUserOrderInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewUploadUserOrderUserIsNotAuthenticatedError builds the gRPC error response
+// type from the error of the "UploadUserOrder" endpoint of the "balance"
+// service.
+func NewUploadUserOrderUserIsNotAuthenticatedError(er *service.GophermartError) *balancepb.UploadUserOrderUserIsNotAuthenticatedError {
+	message := &balancepb.UploadUserOrderUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewUploadUserOrderInternalServiceErrorError builds the gRPC error response
+// type from the error of the "UploadUserOrder" endpoint of the "balance"
+// service.
+func NewUploadUserOrderInternalServiceErrorError(er *service.GophermartError) *balancepb.UploadUserOrderInternalServiceErrorError {
+	message := &balancepb.UploadUserOrderInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewUploadUserOrderNotImplementedError builds the gRPC error response type
+// from the error of the "UploadUserOrder" endpoint of the "balance" service.
+func NewUploadUserOrderNotImplementedError(er *service.GophermartError) *balancepb.UploadUserOrderNotImplementedError {
+	message := &balancepb.UploadUserOrderNotImplementedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListUserOrderPayload builds the payload of the "ListUserOrder" endpoint
+// of the "balance" service from the gRPC request type.
+func NewListUserOrderPayload(message *balancepb.ListUserOrderRequest, authorization string) *balance.ListUserOrderPayload {
+	v := &balance.ListUserOrderPayload{
+		Cursor: message.Cursor,
+		From:   message.From,
+		To:     message.To,
+		Sort:   message.Sort,
+	}
+	if message.Limit != nil {
+		limit := int(*message.Limit)
+		v.Limit = &limit
+	}
+	if message.Status != nil {
+		v.Status = make([]string, len(message.Status))
+		for i, val := range message.Status {
+			v.Status[i] = val
+		}
+	}
+	v.Authorization = authorization
+	return v
+}
+
+// NewProtoListUserOrderResponse builds the gRPC response type from the result
+// of the "ListUserOrder" endpoint of the "balance" service.
+func NewProtoListUserOrderResponse(result *balance.ListUserOrderResult) *balancepb.ListUserOrderResponse {
+	message := &balancepb.ListUserOrderResponse{
+		NextCursor: result.NextCursor,
+		Link:       result.Link,
+		NoOrders:   result.NoOrders,
+	}
+	if result.Orders != nil {
+		message.Orders = make([]*balancepb.Order, len(result.Orders))
+		for i, val := range result.Orders {
+			message.Orders[i] = &balancepb.Order{
+				Number:     val.Number,
+				Status:     val.Status,
+				UploadedAt: val.UploadedAt,
+			}
+			if val.Accrual != nil {
+				accrual := int64(*val.Accrual)
+				message.Orders[i].Accrual = &accrual
+			}
+		}
+	}
+	return message
+}
+
+// NewListUserOrderInvalidInputParameterError builds the gRPC error response
+// type from the error of the "ListUserOrder" endpoint of the "balance" service.
+func NewListUserOrderInvalidInputParameterError(er *service.GophermartError) *balancepb.ListUserOrderInvalidInputParameterError {
+	message := &balancepb.ListUserOrderInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListUserOrderUserIsNotAuthenticatedError builds the gRPC error response
+// type from the error of the "ListUserOrder" endpoint of the "balance" service.
+func NewListUserOrderUserIsNotAuthenticatedError(er *service.GophermartError) *balancepb.ListUserOrderUserIsNotAuthenticatedError {
+	message := &balancepb.ListUserOrderUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListUserOrderInternalServiceErrorError builds the gRPC error response
+// type from the error of the "ListUserOrder" endpoint of the "balance" service.
+func NewListUserOrderInternalServiceErrorError(er *service.GophermartError) *balancepb.ListUserOrderInternalServiceErrorError {
+	message := &balancepb.ListUserOrderInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListUserOrderNotImplementedError builds the gRPC error response type from
+// the error of the "ListUserOrder" endpoint of the "balance" service.
+func NewListUserOrderNotImplementedError(er *service.GophermartError) *balancepb.ListUserOrderNotImplementedError {
+	message := &balancepb.ListUserOrderNotImplementedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserOrderPayload builds the payload of the "GetUserOrder" endpoint of
+// the "balance" service from the gRPC request type.
+func NewGetUserOrderPayload(message *balancepb.GetUserOrderRequest, authorization string) *balance.GetUserOrderPayload {
+	v := &balance.GetUserOrderPayload{
+		Number: message.Number,
+	}
+	v.Authorization = authorization
+	return v
+}
+
+// NewProtoGetUserOrderResponse builds the gRPC response type from the result
+// of the "GetUserOrder" endpoint of the "balance" service.
+func NewProtoGetUserOrderResponse(result *balance.OrderTimeline) *balancepb.GetUserOrderResponse {
+	message := &balancepb.GetUserOrderResponse{
+		Number:      result.Number,
+		Status:      result.Status,
+		UploadedAt:  result.UploadedAt,
+		ProcessedAt: result.ProcessedAt,
+	}
+	if result.Accrual != nil {
+		accrual := int64(*result.Accrual)
+		message.Accrual = &accrual
+	}
+	if result.History != nil {
+		message.History = make([]*balancepb.OrderStatusChange, len(result.History))
+		for i, val := range result.History {
+			message.History[i] = &balancepb.OrderStatusChange{
+				From:   val.From,
+				Status: val.Status,
+				Source: val.Source,
+				At:     val.At,
+			}
+		}
+	}
+	return message
+}
+
+// NewGetUserOrderOrderNotFoundError builds the gRPC error response type from
+// the error of the "GetUserOrder" endpoint of the "balance" service.
+func NewGetUserOrderOrderNotFoundError(er *service.GophermartError) *balancepb.GetUserOrderOrderNotFoundError {
+	message := &balancepb.GetUserOrderOrderNotFoundError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserOrderInvalidInputParameterError builds the gRPC error response
+// type from the error of the "GetUserOrder" endpoint of the "balance" service.
+func NewGetUserOrderInvalidInputParameterError(er *service.GophermartError) *balancepb.GetUserOrderInvalidInputParameterError {
+	message := &balancepb.GetUserOrderInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserOrderUserIsNotAuthenticatedError builds the gRPC error response
+// type from the error of the "GetUserOrder" endpoint of the "balance" service.
+func NewGetUserOrderUserIsNotAuthenticatedError(er *service.GophermartError) *balancepb.GetUserOrderUserIsNotAuthenticatedError {
+	message := &balancepb.GetUserOrderUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserOrderInternalServiceErrorError builds the gRPC error response type
+// from the error of the "GetUserOrder" endpoint of the "balance" service.
+func NewGetUserOrderInternalServiceErrorError(er *service.GophermartError) *balancepb.GetUserOrderInternalServiceErrorError {
+	message := &balancepb.GetUserOrderInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserOrderNotImplementedError builds the gRPC error response type from
+// the error of the "GetUserOrder" endpoint of the "balance" service.
+func NewGetUserOrderNotImplementedError(er *service.GophermartError) *balancepb.GetUserOrderNotImplementedError {
+	message := &balancepb.GetUserOrderNotImplementedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserBalancePayload builds the payload of the "GetUserBalance" endpoint
+// of the "balance" service from the gRPC request type.
+func NewGetUserBalancePayload(authorization string) *balance.GetUserBalancePayload {
+	v := &balance.GetUserBalancePayload{}
+	v.Authorization = authorization
+	return v
+}
+
+// NewProtoGetUserBalanceResponse builds the gRPC response type from the result
+// of the "GetUserBalance" endpoint of the "balance" service.
+func NewProtoGetUserBalanceResponse(result *balance.UserBalance) *balancepb.GetUserBalanceResponse {
+	message := &balancepb.GetUserBalanceResponse{
+		Current:   int64(result.Current),
+		Withdrawn: int64(result.Withdrawn),
+	}
+	return message
+}
+
+// NewGetUserBalanceInvalidInputParameterError builds the gRPC error response
+// type from the error of the "GetUserBalance" endpoint of the "balance"
+// service.
+func NewGetUserBalanceInvalidInputParameterError(er *service.GophermartError) *balancepb.GetUserBalanceInvalidInputParameterError {
+	message := &balancepb.GetUserBalanceInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserBalanceUserIsNotAuthenticatedError builds the gRPC error response
+// type from the error of the "GetUserBalance" endpoint of the "balance"
+// service.
+func NewGetUserBalanceUserIsNotAuthenticatedError(er *service.GophermartError) *balancepb.GetUserBalanceUserIsNotAuthenticatedError {
+	message := &balancepb.GetUserBalanceUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserBalanceInternalServiceErrorError builds the gRPC error response
+// type from the error of the "GetUserBalance" endpoint of the "balance"
+// service.
+func NewGetUserBalanceInternalServiceErrorError(er *service.GophermartError) *balancepb.GetUserBalanceInternalServiceErrorError {
+	message := &balancepb.GetUserBalanceInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewGetUserBalanceNotImplementedError builds the gRPC error response type
+// from the error of the "GetUserBalance" endpoint of the "balance" service.
+func NewGetUserBalanceNotImplementedError(er *service.GophermartError) *balancepb.GetUserBalanceNotImplementedError {
+	message := &balancepb.GetUserBalanceNotImplementedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewWithdrawPayload builds the payload of the "Withdraw" endpoint of the
+// "balance" service from the gRPC request type.
+func NewWithdrawPayload(message *balancepb.WithdrawRequest, authorization string) *balance.WithdrawPayload {
+	v := &balance.WithdrawPayload{
+		Order: message.Order,
+		Sum:   money.Amount(message.Sum),
+	}
+	v.Authorization = authorization
+	return v
+}
+
+// NewProtoWithdrawResponse builds the gRPC response type from the result of
+// the "Withdraw" endpoint of the "balance" service.
+func NewProtoWithdrawResponse() *balancepb.WithdrawResponse {
+	message := &balancepb.WithdrawResponse{}
+	return message
+}
+
+// NewWithdrawInsufficientFundsError builds the gRPC error response type from
+// the error of the "Withdraw" endpoint of the "balance" service.
+func NewWithdrawInsufficientFundsError(er *service.GophermartError) *balancepb.WithdrawInsufficientFundsError {
+	message := &balancepb.WithdrawInsufficientFundsError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewWithdrawInvalidOrderNumberError builds the gRPC error response type from
+// the error of the "Withdraw" endpoint of the "balance" service.
+func NewWithdrawInvalidOrderNumberError(er *service.GophermartError) *balancepb.WithdrawInvalidOrderNumberError {
+	message := &balancepb.WithdrawInvalidOrderNumberError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewWithdrawInvalidInputParameterError builds the gRPC error response type
+// from the error of the "Withdraw" endpoint of the "balance" service.
+func NewWithdrawInvalidInputParameterError(er *service.GophermartError) *balancepb.WithdrawInvalidInputParameterError {
+	message := &balancepb.WithdrawInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewWithdrawUserIsNotAuthenticatedError builds the gRPC error response type
+// from the error of the "Withdraw" endpoint of the "balance" service.
+func NewWithdrawUserIsNotAuthenticatedError(er *service.GophermartError) *balancepb.WithdrawUserIsNotAuthenticatedError {
+	message := &balancepb.WithdrawUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewWithdrawInternalServiceErrorError builds the gRPC error response type
+// from the error of the "Withdraw" endpoint of the "balance" service.
+func NewWithdrawInternalServiceErrorError(er *service.GophermartError) *balancepb.WithdrawInternalServiceErrorError {
+	message := &balancepb.WithdrawInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewWithdrawNotImplementedError builds the gRPC error response type from the
+// error of the "Withdraw" endpoint of the "balance" service.
+func NewWithdrawNotImplementedError(er *service.GophermartError) *balancepb.WithdrawNotImplementedError {
+	message := &balancepb.WithdrawNotImplementedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListWithdrawalsPayload builds the payload of the "ListWithdrawals"
+// endpoint of the "balance" service from the gRPC request type.
+func NewListWithdrawalsPayload(authorization string) *balance.ListWithdrawalsPayload {
+	v := &balance.ListWithdrawalsPayload{}
+	v.Authorization = authorization
+	return v
+}
+
+// NewProtoListWithdrawalsResponse builds the gRPC response type from the
+// result of the "ListWithdrawals" endpoint of the "balance" service.
+func NewProtoListWithdrawalsResponse(result *balance.ListWithdrawalsResult) *balancepb.ListWithdrawalsResponse {
+	message := &balancepb.ListWithdrawalsResponse{
+		NoWithdrawals: result.NoWithdrawals,
+	}
+	if result.Withdrawals != nil {
+		message.Withdrawals = make([]*balancepb.Withdrawal, len(result.Withdrawals))
+		for i, val := range result.Withdrawals {
+			message.Withdrawals[i] = &balancepb.Withdrawal{
+				Order:       val.Order,
+				Sum:         int64(val.Sum),
+				ProcessedAt: val.ProcessedAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewListWithdrawalsInvalidInputParameterError builds the gRPC error response
+// type from the error of the "ListWithdrawals" endpoint of the "balance"
+// service.
+func NewListWithdrawalsInvalidInputParameterError(er *service.GophermartError) *balancepb.ListWithdrawalsInvalidInputParameterError {
+	message := &balancepb.ListWithdrawalsInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListWithdrawalsUserIsNotAuthenticatedError builds the gRPC error response
+// type from the error of the "ListWithdrawals" endpoint of the "balance"
+// service.
+func NewListWithdrawalsUserIsNotAuthenticatedError(er *service.GophermartError) *balancepb.ListWithdrawalsUserIsNotAuthenticatedError {
+	message := &balancepb.ListWithdrawalsUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListWithdrawalsInternalServiceErrorError builds the gRPC error response
+// type from the error of the "ListWithdrawals" endpoint of the "balance"
+// service.
+func NewListWithdrawalsInternalServiceErrorError(er *service.GophermartError) *balancepb.ListWithdrawalsInternalServiceErrorError {
+	message := &balancepb.ListWithdrawalsInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewListWithdrawalsNotImplementedError builds the gRPC error response type
+// from the error of the "ListWithdrawals" endpoint of the "balance" service.
+func NewListWithdrawalsNotImplementedError(er *service.GophermartError) *balancepb.ListWithdrawalsNotImplementedError {
+	message := &balancepb.ListWithdrawalsNotImplementedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// ValidateUploadUserOrderRequest runs the validations defined on
+// UploadUserOrderRequest.
+func ValidateUploadUserOrderRequest(message *balancepb.UploadUserOrderRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.OrderNumber", message.OrderNumber, "[1-9][0-9]*"))
+	return
+}
+
+// ValidateListUserOrderRequest runs the validations defined on
+// ListUserOrderRequest.
+func ValidateListUserOrderRequest(message *balancepb.ListUserOrderRequest) (err error) {
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1000, false))
+		}
+	}
+	for _, e := range message.Status {
+		if !(e == "NEW" || e == "PROCESSING" || e == "INVALID" || e == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status[*]", e, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if message.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.from", *message.From, goa.FormatDateTime))
+	}
+	if message.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.to", *message.To, goa.FormatDateTime))
+	}
+	if message.Sort != nil {
+		if !(*message.Sort == "asc" || *message.Sort == "desc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.sort", *message.Sort, []any{"asc", "desc"}))
+		}
+	}
+	return
+}
+
+// ValidateWithdrawRequest runs the validations defined on WithdrawRequest.
+func ValidateWithdrawRequest(message *balancepb.WithdrawRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.order", message.Order, "[1-9][0-9]*"))
+	return
+}
@@ -0,0 +1,58 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user gRPC client CLI support package
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+)
+
+// BuildRegisterPayload builds the payload for the user register endpoint from
+// CLI flags.
+func BuildRegisterPayload(userRegisterMessage string) (*user.LoginPassword, error) {
+	var err error
+	var message userpb.RegisterRequest
+	{
+		if userRegisterMessage != "" {
+			err = json.Unmarshal([]byte(userRegisterMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"login\": \"Non quae consequatur iure aut rem.\",\n      \"password\": \"Ad ab sint facilis.\"\n   }'")
+			}
+		}
+	}
+	v := &user.LoginPassword{
+		Login:    message.Login,
+		Password: message.Password,
+	}
+
+	return v, nil
+}
+
+// BuildLoginPayload builds the payload for the user login endpoint from CLI
+// flags.
+func BuildLoginPayload(userLoginMessage string) (*user.LoginPassword, error) {
+	var err error
+	var message userpb.LoginRequest
+	{
+		if userLoginMessage != "" {
+			err = json.Unmarshal([]byte(userLoginMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"login\": \"Architecto dolores maxime.\",\n      \"password\": \"Quibusdam beatae nam illo inventore asperiores.\"\n   }'")
+			}
+		}
+	}
+	v := &user.LoginPassword{
+		Login:    message.Login,
+		Password: message.Password,
+	}
+
+	return v, nil
+}
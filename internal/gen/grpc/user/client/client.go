@@ -0,0 +1,88 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user gRPC client
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	goapb "goa.design/goa/v3/grpc/pb"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+)
+
+// Client lists the service endpoint gRPC clients.
+type Client struct {
+	grpccli userpb.UserClient
+	opts    []grpc.CallOption
+}
+
+// NewClient instantiates gRPC client for all the user service servers.
+func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
+	return &Client{
+		grpccli: userpb.NewUserClient(cc),
+		opts:    opts,
+	}
+}
+
+// Register calls the "Register" function in userpb.UserClient interface.
+func (c *Client) Register() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRegisterFunc(c.grpccli, c.opts...),
+			EncodeRegisterRequest,
+			DecodeRegisterResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *userpb.RegisterLoginIsTakenAlreadyError:
+				return nil, NewRegisterLoginIsTakenAlreadyError(message)
+			case *userpb.RegisterInvalidInputParameterError:
+				return nil, NewRegisterInvalidInputParameterError(message)
+			case *userpb.RegisterUserIsNotAuthenticatedError:
+				return nil, NewRegisterUserIsNotAuthenticatedError(message)
+			case *userpb.RegisterInternalServiceErrorError:
+				return nil, NewRegisterInternalServiceErrorError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Login calls the "Login" function in userpb.UserClient interface.
+func (c *Client) Login() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildLoginFunc(c.grpccli, c.opts...),
+			EncodeLoginRequest,
+			DecodeLoginResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *userpb.LoginInvalidInputParameterError:
+				return nil, NewLoginInvalidInputParameterError(message)
+			case *userpb.LoginUserIsNotAuthenticatedError:
+				return nil, NewLoginUserIsNotAuthenticatedError(message)
+			case *userpb.LoginInternalServiceErrorError:
+				return nil, NewLoginInternalServiceErrorError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user gRPC client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// BuildRegisterFunc builds the remote method to invoke for "user" service
+// "register" endpoint.
+func BuildRegisterFunc(grpccli userpb.UserClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Register(ctx, reqpb.(*userpb.RegisterRequest), opts...)
+		}
+		return grpccli.Register(ctx, &userpb.RegisterRequest{}, opts...)
+	}
+}
+
+// EncodeRegisterRequest encodes requests sent to user register endpoint.
+func EncodeRegisterRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*user.LoginPassword)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("user", "register", "*user.LoginPassword", v)
+	}
+	return NewProtoRegisterRequest(payload), nil
+}
+
+// DecodeRegisterResponse decodes responses from the user register endpoint.
+func DecodeRegisterResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*userpb.RegisterResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("user", "register", "*userpb.RegisterResponse", v)
+	}
+	res := NewRegisterResult(message)
+	return res, nil
+}
+
+// BuildLoginFunc builds the remote method to invoke for "user" service "login"
+// endpoint.
+func BuildLoginFunc(grpccli userpb.UserClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Login(ctx, reqpb.(*userpb.LoginRequest), opts...)
+		}
+		return grpccli.Login(ctx, &userpb.LoginRequest{}, opts...)
+	}
+}
+
+// EncodeLoginRequest encodes requests sent to user login endpoint.
+func EncodeLoginRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*user.LoginPassword)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("user", "login", "*user.LoginPassword", v)
+	}
+	return NewProtoLoginRequest(payload), nil
+}
+
+// DecodeLoginResponse decodes responses from the user login endpoint.
+func DecodeLoginResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*userpb.LoginResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("user", "login", "*userpb.LoginResponse", v)
+	}
+	res := NewLoginResult(message)
+	return res, nil
+}
@@ -0,0 +1,115 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user gRPC client types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+)
+
+// NewProtoRegisterRequest builds the gRPC request type from the payload of the
+// "register" endpoint of the "user" service.
+func NewProtoRegisterRequest(payload *user.LoginPassword) *userpb.RegisterRequest {
+	message := &userpb.RegisterRequest{
+		Login:    payload.Login,
+		Password: payload.Password,
+	}
+	return message
+}
+
+// NewRegisterResult builds the result type of the "register" endpoint of the
+// "user" service from the gRPC response type.
+func NewRegisterResult(message *userpb.RegisterResponse) *service.JWTToken {
+	result := &service.JWTToken{
+		AuthToken: message.AuthToken,
+	}
+	return result
+}
+
+// NewRegisterLoginIsTakenAlreadyError builds the error type of the "register"
+// endpoint of the "user" service from the gRPC error response type.
+func NewRegisterLoginIsTakenAlreadyError(message *userpb.RegisterLoginIsTakenAlreadyError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewRegisterInvalidInputParameterError builds the error type of the
+// "register" endpoint of the "user" service from the gRPC error response type.
+func NewRegisterInvalidInputParameterError(message *userpb.RegisterInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewRegisterUserIsNotAuthenticatedError builds the error type of the
+// "register" endpoint of the "user" service from the gRPC error response type.
+func NewRegisterUserIsNotAuthenticatedError(message *userpb.RegisterUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewRegisterInternalServiceErrorError builds the error type of the "register"
+// endpoint of the "user" service from the gRPC error response type.
+func NewRegisterInternalServiceErrorError(message *userpb.RegisterInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewProtoLoginRequest builds the gRPC request type from the payload of the
+// "login" endpoint of the "user" service.
+func NewProtoLoginRequest(payload *user.LoginPassword) *userpb.LoginRequest {
+	message := &userpb.LoginRequest{
+		Login:    payload.Login,
+		Password: payload.Password,
+	}
+	return message
+}
+
+// NewLoginResult builds the result type of the "login" endpoint of the "user"
+// service from the gRPC response type.
+func NewLoginResult(message *userpb.LoginResponse) *service.JWTToken {
+	result := &service.JWTToken{
+		AuthToken: message.AuthToken,
+	}
+	return result
+}
+
+// NewLoginInvalidInputParameterError builds the error type of the "login"
+// endpoint of the "user" service from the gRPC error response type.
+func NewLoginInvalidInputParameterError(message *userpb.LoginInvalidInputParameterError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewLoginUserIsNotAuthenticatedError builds the error type of the "login"
+// endpoint of the "user" service from the gRPC error response type.
+func NewLoginUserIsNotAuthenticatedError(message *userpb.LoginUserIsNotAuthenticatedError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
+
+// NewLoginInternalServiceErrorError builds the error type of the "login"
+// endpoint of the "user" service from the gRPC error response type.
+func NewLoginInternalServiceErrorError(message *userpb.LoginInternalServiceErrorError) *service.GophermartError {
+	er := &service.GophermartError{
+		Name: message.Name,
+	}
+	return er
+}
@@ -0,0 +1,635 @@
+// Code generated with goa v3.23.4, DO NOT EDIT.
+//
+// user protocol buffer definition
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: goagen_internal_user.proto
+
+package userpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RegisterLoginIsTakenAlreadyError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterLoginIsTakenAlreadyError) Reset() {
+	*x = RegisterLoginIsTakenAlreadyError{}
+	mi := &file_goagen_internal_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterLoginIsTakenAlreadyError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterLoginIsTakenAlreadyError) ProtoMessage() {}
+
+func (x *RegisterLoginIsTakenAlreadyError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterLoginIsTakenAlreadyError.ProtoReflect.Descriptor instead.
+func (*RegisterLoginIsTakenAlreadyError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterLoginIsTakenAlreadyError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterInvalidInputParameterError) Reset() {
+	*x = RegisterInvalidInputParameterError{}
+	mi := &file_goagen_internal_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterInvalidInputParameterError) ProtoMessage() {}
+
+func (x *RegisterInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*RegisterInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserIsNotAuthenticatedError) Reset() {
+	*x = RegisterUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *RegisterUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*RegisterUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterInternalServiceErrorError) Reset() {
+	*x = RegisterInternalServiceErrorError{}
+	mi := &file_goagen_internal_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterInternalServiceErrorError) ProtoMessage() {}
+
+func (x *RegisterInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*RegisterInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RegisterInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_goagen_internal_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegisterRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A JWT token used to authenticate a request
+	AuthToken     string `protobuf:"bytes,1,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_goagen_internal_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterResponse) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type LoginInvalidInputParameterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginInvalidInputParameterError) Reset() {
+	*x = LoginInvalidInputParameterError{}
+	mi := &file_goagen_internal_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginInvalidInputParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginInvalidInputParameterError) ProtoMessage() {}
+
+func (x *LoginInvalidInputParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginInvalidInputParameterError.ProtoReflect.Descriptor instead.
+func (*LoginInvalidInputParameterError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LoginInvalidInputParameterError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LoginUserIsNotAuthenticatedError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginUserIsNotAuthenticatedError) Reset() {
+	*x = LoginUserIsNotAuthenticatedError{}
+	mi := &file_goagen_internal_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginUserIsNotAuthenticatedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginUserIsNotAuthenticatedError) ProtoMessage() {}
+
+func (x *LoginUserIsNotAuthenticatedError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginUserIsNotAuthenticatedError.ProtoReflect.Descriptor instead.
+func (*LoginUserIsNotAuthenticatedError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoginUserIsNotAuthenticatedError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LoginInternalServiceErrorError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier to map an error to HTTP status codes and gRPC codes
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginInternalServiceErrorError) Reset() {
+	*x = LoginInternalServiceErrorError{}
+	mi := &file_goagen_internal_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginInternalServiceErrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginInternalServiceErrorError) ProtoMessage() {}
+
+func (x *LoginInternalServiceErrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginInternalServiceErrorError.ProtoReflect.Descriptor instead.
+func (*LoginInternalServiceErrorError) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoginInternalServiceErrorError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_goagen_internal_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LoginRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A JWT token used to authenticate a request
+	AuthToken     string `protobuf:"bytes,1,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_goagen_internal_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_internal_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_internal_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LoginResponse) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+var File_goagen_internal_user_proto protoreflect.FileDescriptor
+
+const file_goagen_internal_user_proto_rawDesc = "" +
+	"\n" +
+	"\x1agoagen_internal_user.proto\x12\x04user\"6\n" +
+	" RegisterLoginIsTakenAlreadyError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\"RegisterInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
+	"#RegisterUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"7\n" +
+	"!RegisterInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x0fRegisterRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"1\n" +
+	"\x10RegisterResponse\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x01 \x01(\tR\tauthToken\"5\n" +
+	"\x1fLoginInvalidInputParameterError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"6\n" +
+	" LoginUserIsNotAuthenticatedError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"4\n" +
+	"\x1eLoginInternalServiceErrorError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\".\n" +
+	"\rLoginResponse\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x01 \x01(\tR\tauthToken2s\n" +
+	"\x04User\x129\n" +
+	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\x120\n" +
+	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponseB\tZ\a/userpbb\x06proto3"
+
+var (
+	file_goagen_internal_user_proto_rawDescOnce sync.Once
+	file_goagen_internal_user_proto_rawDescData []byte
+)
+
+func file_goagen_internal_user_proto_rawDescGZIP() []byte {
+	file_goagen_internal_user_proto_rawDescOnce.Do(func() {
+		file_goagen_internal_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_goagen_internal_user_proto_rawDesc), len(file_goagen_internal_user_proto_rawDesc)))
+	})
+	return file_goagen_internal_user_proto_rawDescData
+}
+
+var file_goagen_internal_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_goagen_internal_user_proto_goTypes = []any{
+	(*RegisterLoginIsTakenAlreadyError)(nil),    // 0: user.RegisterLoginIsTakenAlreadyError
+	(*RegisterInvalidInputParameterError)(nil),  // 1: user.RegisterInvalidInputParameterError
+	(*RegisterUserIsNotAuthenticatedError)(nil), // 2: user.RegisterUserIsNotAuthenticatedError
+	(*RegisterInternalServiceErrorError)(nil),   // 3: user.RegisterInternalServiceErrorError
+	(*RegisterRequest)(nil),                     // 4: user.RegisterRequest
+	(*RegisterResponse)(nil),                    // 5: user.RegisterResponse
+	(*LoginInvalidInputParameterError)(nil),     // 6: user.LoginInvalidInputParameterError
+	(*LoginUserIsNotAuthenticatedError)(nil),    // 7: user.LoginUserIsNotAuthenticatedError
+	(*LoginInternalServiceErrorError)(nil),      // 8: user.LoginInternalServiceErrorError
+	(*LoginRequest)(nil),                        // 9: user.LoginRequest
+	(*LoginResponse)(nil),                       // 10: user.LoginResponse
+}
+var file_goagen_internal_user_proto_depIdxs = []int32{
+	4,  // 0: user.User.Register:input_type -> user.RegisterRequest
+	9,  // 1: user.User.Login:input_type -> user.LoginRequest
+	5,  // 2: user.User.Register:output_type -> user.RegisterResponse
+	10, // 3: user.User.Login:output_type -> user.LoginResponse
+	2,  // [2:4] is the sub-list for method output_type
+	0,  // [0:2] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_goagen_internal_user_proto_init() }
+func file_goagen_internal_user_proto_init() {
+	if File_goagen_internal_user_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_internal_user_proto_rawDesc), len(file_goagen_internal_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_goagen_internal_user_proto_goTypes,
+		DependencyIndexes: file_goagen_internal_user_proto_depIdxs,
+		MessageInfos:      file_goagen_internal_user_proto_msgTypes,
+	}.Build()
+	File_goagen_internal_user_proto = out.File
+	file_goagen_internal_user_proto_goTypes = nil
+	file_goagen_internal_user_proto_depIdxs = nil
+}
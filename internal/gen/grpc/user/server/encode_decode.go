@@ -0,0 +1,77 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user gRPC server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"context"
+
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// EncodeRegisterResponse encodes responses from the "user" service "register"
+// endpoint.
+func EncodeRegisterResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*service.JWTToken)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("user", "register", "*service.JWTToken", v)
+	}
+	resp := NewProtoRegisterResponse(result)
+	return resp, nil
+}
+
+// DecodeRegisterRequest decodes requests sent to "user" service "register"
+// endpoint.
+func DecodeRegisterRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *userpb.RegisterRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*userpb.RegisterRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("user", "register", "*userpb.RegisterRequest", v)
+		}
+	}
+	var payload *user.LoginPassword
+	{
+		payload = NewRegisterPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeLoginResponse encodes responses from the "user" service "login"
+// endpoint.
+func EncodeLoginResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*service.JWTToken)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("user", "login", "*service.JWTToken", v)
+	}
+	resp := NewProtoLoginResponse(result)
+	return resp, nil
+}
+
+// DecodeLoginRequest decodes requests sent to "user" service "login" endpoint.
+func DecodeLoginRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *userpb.LoginRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*userpb.LoginRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("user", "login", "*userpb.LoginRequest", v)
+		}
+	}
+	var payload *user.LoginPassword
+	{
+		payload = NewLoginPayload(message)
+	}
+	return payload, nil
+}
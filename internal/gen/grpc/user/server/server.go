@@ -0,0 +1,113 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user gRPC server
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
+)
+
+// Server implements the userpb.UserServer interface.
+type Server struct {
+	RegisterH goagrpc.UnaryHandler
+	LoginH    goagrpc.UnaryHandler
+	userpb.UnimplementedUserServer
+}
+
+// New instantiates the server struct with the user service endpoints.
+func New(e *user.Endpoints, uh goagrpc.UnaryHandler) *Server {
+	return &Server{
+		RegisterH: NewRegisterHandler(e.Register, uh),
+		LoginH:    NewLoginHandler(e.Login, uh),
+	}
+}
+
+// NewRegisterHandler creates a gRPC handler which serves the "user" service
+// "register" endpoint.
+func NewRegisterHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRegisterRequest, EncodeRegisterResponse)
+	}
+	return h
+}
+
+// Register implements the "Register" method in userpb.UserServer interface.
+func (s *Server) Register(ctx context.Context, message *userpb.RegisterRequest) (*userpb.RegisterResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "register")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+	resp, err := s.RegisterH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "Login is taken already":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.AlreadyExists, err, NewRegisterLoginIsTakenAlreadyError(er))
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewRegisterInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewRegisterUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewRegisterInternalServiceErrorError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*userpb.RegisterResponse), nil
+}
+
+// NewLoginHandler creates a gRPC handler which serves the "user" service
+// "login" endpoint.
+func NewLoginHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeLoginRequest, EncodeLoginResponse)
+	}
+	return h
+}
+
+// Login implements the "Login" method in userpb.UserServer interface.
+func (s *Server) Login(ctx context.Context, message *userpb.LoginRequest) (*userpb.LoginResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "login")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+	resp, err := s.LoginH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "Invalid input parameter":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewLoginInvalidInputParameterError(er))
+			case "User is not authenticated":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewLoginUserIsNotAuthenticatedError(er))
+			case "Internal service error":
+				var er *service.GophermartError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Internal, err, NewLoginInternalServiceErrorError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*userpb.LoginResponse), nil
+}
@@ -0,0 +1,115 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user gRPC server types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+)
+
+// NewRegisterPayload builds the payload of the "register" endpoint of the
+// "user" service from the gRPC request type.
+func NewRegisterPayload(message *userpb.RegisterRequest) *user.LoginPassword {
+	v := &user.LoginPassword{
+		Login:    message.Login,
+		Password: message.Password,
+	}
+	return v
+}
+
+// NewProtoRegisterResponse builds the gRPC response type from the result of
+// the "register" endpoint of the "user" service.
+func NewProtoRegisterResponse(result *service.JWTToken) *userpb.RegisterResponse {
+	message := &userpb.RegisterResponse{
+		AuthToken: result.AuthToken,
+	}
+	return message
+}
+
+// NewRegisterLoginIsTakenAlreadyError builds the gRPC error response type from
+// the error of the "register" endpoint of the "user" service.
+func NewRegisterLoginIsTakenAlreadyError(er *service.GophermartError) *userpb.RegisterLoginIsTakenAlreadyError {
+	message := &userpb.RegisterLoginIsTakenAlreadyError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewRegisterInvalidInputParameterError builds the gRPC error response type
+// from the error of the "register" endpoint of the "user" service.
+func NewRegisterInvalidInputParameterError(er *service.GophermartError) *userpb.RegisterInvalidInputParameterError {
+	message := &userpb.RegisterInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewRegisterUserIsNotAuthenticatedError builds the gRPC error response type
+// from the error of the "register" endpoint of the "user" service.
+func NewRegisterUserIsNotAuthenticatedError(er *service.GophermartError) *userpb.RegisterUserIsNotAuthenticatedError {
+	message := &userpb.RegisterUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewRegisterInternalServiceErrorError builds the gRPC error response type
+// from the error of the "register" endpoint of the "user" service.
+func NewRegisterInternalServiceErrorError(er *service.GophermartError) *userpb.RegisterInternalServiceErrorError {
+	message := &userpb.RegisterInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewLoginPayload builds the payload of the "login" endpoint of the "user"
+// service from the gRPC request type.
+func NewLoginPayload(message *userpb.LoginRequest) *user.LoginPassword {
+	v := &user.LoginPassword{
+		Login:    message.Login,
+		Password: message.Password,
+	}
+	return v
+}
+
+// NewProtoLoginResponse builds the gRPC response type from the result of the
+// "login" endpoint of the "user" service.
+func NewProtoLoginResponse(result *service.JWTToken) *userpb.LoginResponse {
+	message := &userpb.LoginResponse{
+		AuthToken: result.AuthToken,
+	}
+	return message
+}
+
+// NewLoginInvalidInputParameterError builds the gRPC error response type from
+// the error of the "login" endpoint of the "user" service.
+func NewLoginInvalidInputParameterError(er *service.GophermartError) *userpb.LoginInvalidInputParameterError {
+	message := &userpb.LoginInvalidInputParameterError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewLoginUserIsNotAuthenticatedError builds the gRPC error response type from
+// the error of the "login" endpoint of the "user" service.
+func NewLoginUserIsNotAuthenticatedError(er *service.GophermartError) *userpb.LoginUserIsNotAuthenticatedError {
+	message := &userpb.LoginUserIsNotAuthenticatedError{
+		Name: er.Name,
+	}
+	return message
+}
+
+// NewLoginInternalServiceErrorError builds the gRPC error response type from
+// the error of the "login" endpoint of the "user" service.
+func NewLoginInternalServiceErrorError(er *service.GophermartError) *userpb.LoginInternalServiceErrorError {
+	message := &userpb.LoginInternalServiceErrorError{
+		Name: er.Name,
+	}
+	return message
+}
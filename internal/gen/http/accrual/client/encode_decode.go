@@ -13,9 +13,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	accrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildGetOrderRequest instantiates a HTTP request object with method and path
@@ -48,6 +50,7 @@ func (c *Client) BuildGetOrderRequest(ctx context.Context, v any) (*http.Request
 // should be restored after having been read.
 // DecodeGetOrderResponse may return the following errors:
 //   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - "Too many requests" (type *service.RateLimitError): http.StatusTooManyRequests
 //   - error: internal error
 func DecodeGetOrderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -64,19 +67,48 @@ func DecodeGetOrderResponse(decoder func(*http.Response) goahttp.Decoder, restor
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
+		case http.StatusNoContent:
+			res := NewGetOrderResultNoContent()
+			tmp := "yes"
+			res.NotRegistered = &tmp
+			return res, nil
 		case http.StatusOK:
 			var (
-				body GetOrderResponseBody
+				body GetOrderOKResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("accrual", "GetOrder", err)
 			}
+			err = ValidateGetOrderOKResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("accrual", "GetOrder", err)
+			}
 			res := NewGetOrderResultOK(&body)
 			return res, nil
 		case http.StatusInternalServerError:
 			return nil, NewGetOrderInternalServiceError()
+		case http.StatusTooManyRequests:
+			var (
+				retryAfter int
+				err        error
+			)
+			{
+				retryAfterRaw := resp.Header.Get("Retry-After")
+				if retryAfterRaw == "" {
+					return nil, goahttp.ErrValidationError("accrual", "GetOrder", goa.MissingFieldError("retry_after", "header"))
+				}
+				v, err2 := strconv.ParseInt(retryAfterRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("retry_after", retryAfterRaw, "integer"))
+				}
+				retryAfter = int(v)
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("accrual", "GetOrder", err)
+			}
+			return nil, NewGetOrderTooManyRequests(retryAfter)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("accrual", "GetOrder", resp.StatusCode, string(body))
@@ -10,23 +10,36 @@ package client
 import (
 	accrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
 	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
 )
 
-// GetOrderResponseBody is the type of the "accrual" service "GetOrder"
+// GetOrderOKResponseBody is the type of the "accrual" service "GetOrder"
 // endpoint HTTP response body.
-type GetOrderResponseBody struct {
-	Order   *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
-	Status  *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
-	Accrual *uint   `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+type GetOrderOKResponseBody struct {
+	Order  *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual       *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	NotRegistered *string       `json:"-"`
+}
+
+// NewGetOrderResultNoContent builds a "accrual" service "GetOrder" endpoint
+// result from a HTTP "NoContent" response.
+func NewGetOrderResultNoContent() *accrual.GetOrderResult {
+	v := &accrual.GetOrderResult{}
+
+	return v
 }
 
 // NewGetOrderResultOK builds a "accrual" service "GetOrder" endpoint result
 // from a HTTP "OK" response.
-func NewGetOrderResultOK(body *GetOrderResponseBody) *accrual.GetOrderResult {
+func NewGetOrderResultOK(body *GetOrderOKResponseBody) *accrual.GetOrderResult {
 	v := &accrual.GetOrderResult{
-		Order:   body.Order,
-		Status:  body.Status,
-		Accrual: body.Accrual,
+		Order:         body.Order,
+		Status:        body.Status,
+		Accrual:       body.Accrual,
+		NotRegistered: body.NotRegistered,
 	}
 
 	return v
@@ -39,3 +52,23 @@ func NewGetOrderInternalServiceError() *service.GophermartError {
 
 	return v
 }
+
+// NewGetOrderTooManyRequests builds a accrual service GetOrder endpoint Too
+// many requests error.
+func NewGetOrderTooManyRequests(retryAfter int) *service.RateLimitError {
+	v := &service.RateLimitError{}
+	v.RetryAfter = retryAfter
+
+	return v
+}
+
+// ValidateGetOrderOKResponseBody runs the validations defined on
+// GetOrderOKResponseBody
+func ValidateGetOrderOKResponseBody(body *GetOrderOKResponseBody) (err error) {
+	if body.Status != nil {
+		if !(*body.Status == "REGISTERED" || *body.Status == "INVALID" || *body.Status == "PROCESSING" || *body.Status == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"REGISTERED", "INVALID", "PROCESSING", "PROCESSED"}))
+		}
+	}
+	return
+}
@@ -0,0 +1,85 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// accrual HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	accrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeGetOrderResponse returns an encoder for responses returned by the
+// accrual GetOrder endpoint.
+func EncodeGetOrderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*accrual.GetOrderResult)
+		if res.NotRegistered != nil && *res.NotRegistered == "yes" {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+		enc := encoder(ctx, w)
+		body := NewGetOrderOKResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetOrderRequest returns a decoder for requests sent to the accrual
+// GetOrder endpoint.
+func DecodeGetOrderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*accrual.GetOrderPayload, error) {
+	return func(r *http.Request) (*accrual.GetOrderPayload, error) {
+		var (
+			number string
+
+			params = mux.Vars(r)
+		)
+		number = params["number"]
+		payload := NewGetOrderPayload(number)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetOrderError returns an encoder for errors returned by the GetOrder
+// accrual endpoint.
+func EncodeGetOrderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Internal service error":
+			var res *service.GophermartError
+			errors.As(v, &res)
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		case "Too many requests":
+			var res *service.RateLimitError
+			errors.As(v, &res)
+			{
+				val := res.RetryAfter
+				retryAfters := strconv.Itoa(val)
+				w.Header().Set("Retry-After", retryAfters)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return nil
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
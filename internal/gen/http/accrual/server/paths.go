@@ -0,0 +1,17 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// HTTP request path constructors for the accrual service.
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"fmt"
+)
+
+// GetOrderAccrualPath returns the URL path to the accrual service GetOrder HTTP endpoint.
+func GetOrderAccrualPath(number string) string {
+	return fmt.Sprintf("/api/orders/%v", number)
+}
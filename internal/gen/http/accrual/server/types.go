@@ -0,0 +1,43 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// accrual HTTP server types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	accrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	"github.com/oleshko-g/oggophermart/internal/money"
+)
+
+// GetOrderOKResponseBody is the type of the "accrual" service "GetOrder"
+// endpoint HTTP response body.
+type GetOrderOKResponseBody struct {
+	Order  *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual       *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	NotRegistered *string       `json:"-"`
+}
+
+// NewGetOrderOKResponseBody builds the HTTP response body from the result of
+// the "GetOrder" endpoint of the "accrual" service.
+func NewGetOrderOKResponseBody(res *accrual.GetOrderResult) *GetOrderOKResponseBody {
+	body := &GetOrderOKResponseBody{
+		Order:         res.Order,
+		Status:        res.Status,
+		Accrual:       res.Accrual,
+		NotRegistered: res.NotRegistered,
+	}
+	return body
+}
+
+// NewGetOrderPayload builds a accrual service GetOrder endpoint payload.
+func NewGetOrderPayload(number string) *accrual.GetOrderPayload {
+	v := &accrual.GetOrderPayload{}
+	v.Number = number
+
+	return v
+}
@@ -0,0 +1,256 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	admin "github.com/oleshko-g/oggophermart/internal/gen/admin"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSearchUsersPayload builds the payload for the admin SearchUsers
+// endpoint from CLI flags.
+func BuildSearchUsersPayload(adminSearchUsersLogin string, adminSearchUsersRole string, adminSearchUsersLimit string, adminSearchUsersAuthorization string) (*admin.SearchUsersPayload, error) {
+	var err error
+	var login *string
+	{
+		if adminSearchUsersLogin != "" {
+			login = &adminSearchUsersLogin
+		}
+	}
+	var role *string
+	{
+		if adminSearchUsersRole != "" {
+			role = &adminSearchUsersRole
+			if !(*role == "user" || *role == "support" || *role == "admin") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("role", *role, []any{"user", "support", "admin"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if adminSearchUsersLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(adminSearchUsersLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = adminSearchUsersAuthorization
+	}
+	v := &admin.SearchUsersPayload{}
+	v.Login = login
+	v.Role = role
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListUserOrdersPayload builds the payload for the admin ListUserOrders
+// endpoint from CLI flags.
+func BuildListUserOrdersPayload(adminListUserOrdersUserID string, adminListUserOrdersLimit string, adminListUserOrdersAuthorization string) (*admin.ListUserOrdersPayload, error) {
+	var err error
+	var userID string
+	{
+		userID = adminListUserOrdersUserID
+		err = goa.MergeErrors(err, goa.ValidateFormat("user_id", userID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if adminListUserOrdersLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(adminListUserOrdersLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = adminListUserOrdersAuthorization
+	}
+	v := &admin.ListUserOrdersPayload{}
+	v.UserID = userID
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetUserLedgerPayload builds the payload for the admin GetUserLedger
+// endpoint from CLI flags.
+func BuildGetUserLedgerPayload(adminGetUserLedgerUserID string, adminGetUserLedgerLimit string, adminGetUserLedgerAuthorization string) (*admin.GetUserLedgerPayload, error) {
+	var err error
+	var userID string
+	{
+		userID = adminGetUserLedgerUserID
+		err = goa.MergeErrors(err, goa.ValidateFormat("user_id", userID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if adminGetUserLedgerLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(adminGetUserLedgerLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = adminGetUserLedgerAuthorization
+	}
+	v := &admin.GetUserLedgerPayload{}
+	v.UserID = userID
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildAdjustBalancePayload builds the payload for the admin AdjustBalance
+// endpoint from CLI flags.
+func BuildAdjustBalancePayload(adminAdjustBalanceBody string, adminAdjustBalanceUserID string, adminAdjustBalanceAuthorization string) (*admin.AdjustBalancePayload, error) {
+	var err error
+	var body AdjustBalanceRequestBody
+	{
+		err = json.Unmarshal([]byte(adminAdjustBalanceBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"amount\": 729.98,\n      \"reason\": \"Compensation of the delayed accrual\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Reason) > 500 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", body.Reason, utf8.RuneCountInString(body.Reason), 500, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var userID string
+	{
+		userID = adminAdjustBalanceUserID
+		err = goa.MergeErrors(err, goa.ValidateFormat("user_id", userID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = adminAdjustBalanceAuthorization
+	}
+	v := &admin.AdjustBalancePayload{
+		Amount: body.Amount,
+		Reason: body.Reason,
+	}
+	v.UserID = userID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildSetUserRolePayload builds the payload for the admin SetUserRole
+// endpoint from CLI flags.
+func BuildSetUserRolePayload(adminSetUserRoleBody string, adminSetUserRoleUserID string, adminSetUserRoleAuthorization string) (*admin.SetUserRolePayload, error) {
+	var err error
+	var body SetUserRoleRequestBody
+	{
+		err = json.Unmarshal([]byte(adminSetUserRoleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"role\": \"user\"\n   }'")
+		}
+		if !(body.Role == "user" || body.Role == "support" || body.Role == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", body.Role, []any{"user", "support", "admin"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var userID string
+	{
+		userID = adminSetUserRoleUserID
+		err = goa.MergeErrors(err, goa.ValidateFormat("user_id", userID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = adminSetUserRoleAuthorization
+	}
+	v := &admin.SetUserRolePayload{
+		Role: body.Role,
+	}
+	v.UserID = userID
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildReprocessOrderPayload builds the payload for the admin ReprocessOrder
+// endpoint from CLI flags.
+func BuildReprocessOrderPayload(adminReprocessOrderNumber string, adminReprocessOrderAuthorization string) (*admin.ReprocessOrderPayload, error) {
+	var number string
+	{
+		number = adminReprocessOrderNumber
+	}
+	var authorization string
+	{
+		authorization = adminReprocessOrderAuthorization
+	}
+	v := &admin.ReprocessOrderPayload{}
+	v.Number = number
+	v.Authorization = authorization
+
+	return v, nil
+}
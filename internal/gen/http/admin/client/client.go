@@ -0,0 +1,220 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin client HTTP transport
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the admin service endpoint HTTP clients.
+type Client struct {
+	// SearchUsers Doer is the HTTP client used to make requests to the SearchUsers
+	// endpoint.
+	SearchUsersDoer goahttp.Doer
+
+	// ListUserOrders Doer is the HTTP client used to make requests to the
+	// ListUserOrders endpoint.
+	ListUserOrdersDoer goahttp.Doer
+
+	// GetUserLedger Doer is the HTTP client used to make requests to the
+	// GetUserLedger endpoint.
+	GetUserLedgerDoer goahttp.Doer
+
+	// AdjustBalance Doer is the HTTP client used to make requests to the
+	// AdjustBalance endpoint.
+	AdjustBalanceDoer goahttp.Doer
+
+	// SetUserRole Doer is the HTTP client used to make requests to the SetUserRole
+	// endpoint.
+	SetUserRoleDoer goahttp.Doer
+
+	// ReprocessOrder Doer is the HTTP client used to make requests to the
+	// ReprocessOrder endpoint.
+	ReprocessOrderDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the admin service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		SearchUsersDoer:     doer,
+		ListUserOrdersDoer:  doer,
+		GetUserLedgerDoer:   doer,
+		AdjustBalanceDoer:   doer,
+		SetUserRoleDoer:     doer,
+		ReprocessOrderDoer:  doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// SearchUsers returns an endpoint that makes HTTP requests to the admin
+// service SearchUsers server.
+func (c *Client) SearchUsers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchUsersRequest(c.encoder)
+		decodeResponse = DecodeSearchUsersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchUsersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchUsersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "SearchUsers", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListUserOrders returns an endpoint that makes HTTP requests to the admin
+// service ListUserOrders server.
+func (c *Client) ListUserOrders() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListUserOrdersRequest(c.encoder)
+		decodeResponse = DecodeListUserOrdersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListUserOrdersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListUserOrdersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "ListUserOrders", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetUserLedger returns an endpoint that makes HTTP requests to the admin
+// service GetUserLedger server.
+func (c *Client) GetUserLedger() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetUserLedgerRequest(c.encoder)
+		decodeResponse = DecodeGetUserLedgerResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetUserLedgerRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetUserLedgerDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "GetUserLedger", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AdjustBalance returns an endpoint that makes HTTP requests to the admin
+// service AdjustBalance server.
+func (c *Client) AdjustBalance() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAdjustBalanceRequest(c.encoder)
+		decodeResponse = DecodeAdjustBalanceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAdjustBalanceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AdjustBalanceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "AdjustBalance", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetUserRole returns an endpoint that makes HTTP requests to the admin
+// service SetUserRole server.
+func (c *Client) SetUserRole() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetUserRoleRequest(c.encoder)
+		decodeResponse = DecodeSetUserRoleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetUserRoleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetUserRoleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "SetUserRole", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReprocessOrder returns an endpoint that makes HTTP requests to the admin
+// service ReprocessOrder server.
+func (c *Client) ReprocessOrder() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReprocessOrderRequest(c.encoder)
+		decodeResponse = DecodeReprocessOrderResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReprocessOrderRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReprocessOrderDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "ReprocessOrder", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,1125 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	admin "github.com/oleshko-g/oggophermart/internal/gen/admin"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSearchUsersRequest instantiates a HTTP request object with method and
+// path set to call the "admin" service "SearchUsers" endpoint
+func (c *Client) BuildSearchUsersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchUsersAdminPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "SearchUsers", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchUsersRequest returns an encoder for requests sent to the admin
+// SearchUsers server.
+func EncodeSearchUsersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.SearchUsersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "SearchUsers", "*admin.SearchUsersPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Login != nil {
+			values.Add("login", *p.Login)
+		}
+		if p.Role != nil {
+			values.Add("role", *p.Role)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchUsersResponse returns a decoder for responses returned by the
+// admin SearchUsers endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSearchUsersResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "User not found" (type *service.GophermartError): http.StatusNotFound
+//   - "User is not authorized" (type *service.GophermartError): http.StatusForbidden
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSearchUsersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchUsersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "SearchUsers", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAdminUserResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "SearchUsers", err)
+			}
+			res := NewSearchUsersAdminUserOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "SearchUsers", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "SearchUsers", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "SearchUsers", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("admin", "SearchUsers", err)
+				}
+				return nil, NewSearchUsersMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewSearchUsersUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("admin", "SearchUsers", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewSearchUsersInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewSearchUsersUserNotFound()
+		case http.StatusForbidden:
+			return nil, NewSearchUsersUserIsNotAuthorized()
+		case http.StatusInternalServerError:
+			return nil, NewSearchUsersInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "SearchUsers", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListUserOrdersRequest instantiates a HTTP request object with method
+// and path set to call the "admin" service "ListUserOrders" endpoint
+func (c *Client) BuildListUserOrdersRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+	)
+	{
+		p, ok := v.(*admin.ListUserOrdersPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "ListUserOrders", "*admin.ListUserOrdersPayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUserOrdersAdminPath(userID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "ListUserOrders", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListUserOrdersRequest returns an encoder for requests sent to the
+// admin ListUserOrders server.
+func EncodeListUserOrdersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.ListUserOrdersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "ListUserOrders", "*admin.ListUserOrdersPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListUserOrdersResponse returns a decoder for responses returned by the
+// admin ListUserOrders endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListUserOrdersResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "User not found" (type *service.GophermartError): http.StatusNotFound
+//   - "User is not authorized" (type *service.GophermartError): http.StatusForbidden
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListUserOrdersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListUserOrdersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "ListUserOrders", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateOrderResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "ListUserOrders", err)
+			}
+			res := NewListUserOrdersOrderOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "ListUserOrders", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "ListUserOrders", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "ListUserOrders", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("admin", "ListUserOrders", err)
+				}
+				return nil, NewListUserOrdersMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewListUserOrdersUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("admin", "ListUserOrders", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewListUserOrdersInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewListUserOrdersUserNotFound()
+		case http.StatusForbidden:
+			return nil, NewListUserOrdersUserIsNotAuthorized()
+		case http.StatusInternalServerError:
+			return nil, NewListUserOrdersInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "ListUserOrders", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetUserLedgerRequest instantiates a HTTP request object with method and
+// path set to call the "admin" service "GetUserLedger" endpoint
+func (c *Client) BuildGetUserLedgerRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+	)
+	{
+		p, ok := v.(*admin.GetUserLedgerPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "GetUserLedger", "*admin.GetUserLedgerPayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetUserLedgerAdminPath(userID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "GetUserLedger", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetUserLedgerRequest returns an encoder for requests sent to the admin
+// GetUserLedger server.
+func EncodeGetUserLedgerRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.GetUserLedgerPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "GetUserLedger", "*admin.GetUserLedgerPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetUserLedgerResponse returns a decoder for responses returned by the
+// admin GetUserLedger endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetUserLedgerResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "User not found" (type *service.GophermartError): http.StatusNotFound
+//   - "User is not authorized" (type *service.GophermartError): http.StatusForbidden
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetUserLedgerResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetUserLedgerResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "GetUserLedger", err)
+			}
+			err = ValidateGetUserLedgerResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "GetUserLedger", err)
+			}
+			res := NewGetUserLedgerLedgerOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "GetUserLedger", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "GetUserLedger", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "GetUserLedger", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("admin", "GetUserLedger", err)
+				}
+				return nil, NewGetUserLedgerMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewGetUserLedgerUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("admin", "GetUserLedger", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewGetUserLedgerInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewGetUserLedgerUserNotFound()
+		case http.StatusForbidden:
+			return nil, NewGetUserLedgerUserIsNotAuthorized()
+		case http.StatusInternalServerError:
+			return nil, NewGetUserLedgerInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "GetUserLedger", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAdjustBalanceRequest instantiates a HTTP request object with method and
+// path set to call the "admin" service "AdjustBalance" endpoint
+func (c *Client) BuildAdjustBalanceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+	)
+	{
+		p, ok := v.(*admin.AdjustBalancePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "AdjustBalance", "*admin.AdjustBalancePayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AdjustBalanceAdminPath(userID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "AdjustBalance", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAdjustBalanceRequest returns an encoder for requests sent to the admin
+// AdjustBalance server.
+func EncodeAdjustBalanceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdjustBalancePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "AdjustBalance", "*admin.AdjustBalancePayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewAdjustBalanceRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("admin", "AdjustBalance", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAdjustBalanceResponse returns a decoder for responses returned by the
+// admin AdjustBalance endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeAdjustBalanceResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Insufficient balance" (type *service.GophermartError): http.StatusPaymentRequired
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "User not found" (type *service.GophermartError): http.StatusNotFound
+//   - "User is not authorized" (type *service.GophermartError): http.StatusForbidden
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeAdjustBalanceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body AdjustBalanceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "AdjustBalance", err)
+			}
+			err = ValidateAdjustBalanceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "AdjustBalance", err)
+			}
+			res := NewAdjustBalanceLedgerEntryCreated(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "AdjustBalance", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "AdjustBalance", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "AdjustBalance", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("admin", "AdjustBalance", err)
+				}
+				return nil, NewAdjustBalanceMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewAdjustBalanceUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("admin", "AdjustBalance", resp.StatusCode, string(body))
+			}
+		case http.StatusPaymentRequired:
+			return nil, NewAdjustBalanceInsufficientBalance()
+		case http.StatusBadRequest:
+			return nil, NewAdjustBalanceInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewAdjustBalanceUserNotFound()
+		case http.StatusForbidden:
+			return nil, NewAdjustBalanceUserIsNotAuthorized()
+		case http.StatusInternalServerError:
+			return nil, NewAdjustBalanceInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "AdjustBalance", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetUserRoleRequest instantiates a HTTP request object with method and
+// path set to call the "admin" service "SetUserRole" endpoint
+func (c *Client) BuildSetUserRoleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+	)
+	{
+		p, ok := v.(*admin.SetUserRolePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "SetUserRole", "*admin.SetUserRolePayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetUserRoleAdminPath(userID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "SetUserRole", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetUserRoleRequest returns an encoder for requests sent to the admin
+// SetUserRole server.
+func EncodeSetUserRoleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.SetUserRolePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "SetUserRole", "*admin.SetUserRolePayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewSetUserRoleRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("admin", "SetUserRole", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetUserRoleResponse returns a decoder for responses returned by the
+// admin SetUserRole endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSetUserRoleResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "User not found" (type *service.GophermartError): http.StatusNotFound
+//   - "User is not authorized" (type *service.GophermartError): http.StatusForbidden
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSetUserRoleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetUserRoleResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "SetUserRole", err)
+			}
+			err = ValidateSetUserRoleResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "SetUserRole", err)
+			}
+			res := NewSetUserRoleAdminUserOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "SetUserRole", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "SetUserRole", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "SetUserRole", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("admin", "SetUserRole", err)
+				}
+				return nil, NewSetUserRoleMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewSetUserRoleUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("admin", "SetUserRole", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewSetUserRoleInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewSetUserRoleUserNotFound()
+		case http.StatusForbidden:
+			return nil, NewSetUserRoleUserIsNotAuthorized()
+		case http.StatusInternalServerError:
+			return nil, NewSetUserRoleInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "SetUserRole", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReprocessOrderRequest instantiates a HTTP request object with method
+// and path set to call the "admin" service "ReprocessOrder" endpoint
+func (c *Client) BuildReprocessOrderRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		number string
+	)
+	{
+		p, ok := v.(*admin.ReprocessOrderPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "ReprocessOrder", "*admin.ReprocessOrderPayload", v)
+		}
+		number = p.Number
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReprocessOrderAdminPath(number)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "ReprocessOrder", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReprocessOrderRequest returns an encoder for requests sent to the
+// admin ReprocessOrder server.
+func EncodeReprocessOrderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.ReprocessOrderPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "ReprocessOrder", "*admin.ReprocessOrderPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeReprocessOrderResponse returns a decoder for responses returned by the
+// admin ReprocessOrder endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeReprocessOrderResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Order not found" (type *service.GophermartError): http.StatusNotFound
+//   - "User not found" (type *service.GophermartError): http.StatusNotFound
+//   - "The order isn't processed yet" (type *service.GophermartError): http.StatusConflict
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "User is not authorized" (type *service.GophermartError): http.StatusForbidden
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeReprocessOrderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "ReprocessOrder", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "ReprocessOrder", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("admin", "ReprocessOrder", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("admin", "ReprocessOrder", err)
+				}
+				return nil, NewReprocessOrderMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewReprocessOrderUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("admin", "ReprocessOrder", resp.StatusCode, string(body))
+			}
+		case http.StatusNotFound:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "Order not found":
+				return nil, NewReprocessOrderOrderNotFound()
+			case "User not found":
+				return nil, NewReprocessOrderUserNotFound()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("admin", "ReprocessOrder", resp.StatusCode, string(body))
+			}
+		case http.StatusConflict:
+			return nil, NewReprocessOrderTheOrderIsnTProcessedYet()
+		case http.StatusBadRequest:
+			return nil, NewReprocessOrderInvalidInputParameter()
+		case http.StatusForbidden:
+			return nil, NewReprocessOrderUserIsNotAuthorized()
+		case http.StatusInternalServerError:
+			return nil, NewReprocessOrderInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "ReprocessOrder", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalAdminUserResponseToAdminAdminUser builds a value of type
+// *admin.AdminUser from a value of type *AdminUserResponse.
+func unmarshalAdminUserResponseToAdminAdminUser(v *AdminUserResponse) *admin.AdminUser {
+	res := &admin.AdminUser{
+		ID:        *v.ID,
+		Login:     *v.Login,
+		Role:      *v.Role,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalOrderResponseToAdminOrder builds a value of type *admin.Order from
+// a value of type *OrderResponse.
+func unmarshalOrderResponseToAdminOrder(v *OrderResponse) *admin.Order {
+	res := &admin.Order{
+		Number:     *v.Number,
+		Status:     *v.Status,
+		Accrual:    v.Accrual,
+		UploadedAt: *v.UploadedAt,
+	}
+
+	return res
+}
+
+// unmarshalLedgerEntryResponseBodyToAdminLedgerEntry builds a value of type
+// *admin.LedgerEntry from a value of type *LedgerEntryResponseBody.
+func unmarshalLedgerEntryResponseBodyToAdminLedgerEntry(v *LedgerEntryResponseBody) *admin.LedgerEntry {
+	res := &admin.LedgerEntry{
+		ID:        *v.ID,
+		Kind:      *v.Kind,
+		Amount:    *v.Amount,
+		Order:     v.Order,
+		Reason:    v.Reason,
+		ActorID:   v.ActorID,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
@@ -0,0 +1,719 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin HTTP client types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	admin "github.com/oleshko-g/oggophermart/internal/gen/admin"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// AdjustBalanceRequestBody is the type of the "admin" service "AdjustBalance"
+// endpoint HTTP request body.
+type AdjustBalanceRequestBody struct {
+	// The amount of the points with at most 2 fractional digits
+	Amount money.Amount `form:"amount" json:"amount" xml:"amount"`
+	// Why the balance is adjusted
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
+// SetUserRoleRequestBody is the type of the "admin" service "SetUserRole"
+// endpoint HTTP request body.
+type SetUserRoleRequestBody struct {
+	Role string `form:"role" json:"role" xml:"role"`
+}
+
+// SearchUsersResponseBody is the type of the "admin" service "SearchUsers"
+// endpoint HTTP response body.
+type SearchUsersResponseBody []*AdminUserResponse
+
+// ListUserOrdersResponseBody is the type of the "admin" service
+// "ListUserOrders" endpoint HTTP response body.
+type ListUserOrdersResponseBody []*OrderResponse
+
+// GetUserLedgerResponseBody is the type of the "admin" service "GetUserLedger"
+// endpoint HTTP response body.
+type GetUserLedgerResponseBody struct {
+	// The amount of the points with at most 2 fractional digits
+	Balance *money.Amount `form:"balance,omitempty" json:"balance,omitempty" xml:"balance,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Withdrawn *money.Amount `form:"withdrawn,omitempty" json:"withdrawn,omitempty" xml:"withdrawn,omitempty"`
+	// The latest entries go first
+	Entries []*LedgerEntryResponseBody `form:"entries,omitempty" json:"entries,omitempty" xml:"entries,omitempty"`
+}
+
+// AdjustBalanceResponseBody is the type of the "admin" service "AdjustBalance"
+// endpoint HTTP response body.
+type AdjustBalanceResponseBody struct {
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// accrual: the order was processed, withdrawal: the user withdrew the points,
+	// adjustment: the admin adjusted the balance, reversal: the accrual of the
+	// reprocessed order was taken back
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Amount *money.Amount `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// The number of the order of the entry
+	Order *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	// Why the admin changed the balance
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// The ID of the admin who changed the balance
+	ActorID   *string `form:"actor_id,omitempty" json:"actor_id,omitempty" xml:"actor_id,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// SetUserRoleResponseBody is the type of the "admin" service "SetUserRole"
+// endpoint HTTP response body.
+type SetUserRoleResponseBody struct {
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Login     *string `form:"login,omitempty" json:"login,omitempty" xml:"login,omitempty"`
+	Role      *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// AdminUserResponse is used to define fields on response body types.
+type AdminUserResponse struct {
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Login     *string `form:"login,omitempty" json:"login,omitempty" xml:"login,omitempty"`
+	Role      *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// OrderResponse is used to define fields on response body types.
+type OrderResponse struct {
+	Number *string `form:"number,omitempty" json:"number,omitempty" xml:"number,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	UploadedAt *string       `form:"uploaded_at,omitempty" json:"uploaded_at,omitempty" xml:"uploaded_at,omitempty"`
+}
+
+// LedgerEntryResponseBody is used to define fields on response body types.
+type LedgerEntryResponseBody struct {
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// accrual: the order was processed, withdrawal: the user withdrew the points,
+	// adjustment: the admin adjusted the balance, reversal: the accrual of the
+	// reprocessed order was taken back
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Amount *money.Amount `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// The number of the order of the entry
+	Order *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	// Why the admin changed the balance
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// The ID of the admin who changed the balance
+	ActorID   *string `form:"actor_id,omitempty" json:"actor_id,omitempty" xml:"actor_id,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// NewAdjustBalanceRequestBody builds the HTTP request body from the payload of
+// the "AdjustBalance" endpoint of the "admin" service.
+func NewAdjustBalanceRequestBody(p *admin.AdjustBalancePayload) *AdjustBalanceRequestBody {
+	body := &AdjustBalanceRequestBody{
+		Amount: p.Amount,
+		Reason: p.Reason,
+	}
+	return body
+}
+
+// NewSetUserRoleRequestBody builds the HTTP request body from the payload of
+// the "SetUserRole" endpoint of the "admin" service.
+func NewSetUserRoleRequestBody(p *admin.SetUserRolePayload) *SetUserRoleRequestBody {
+	body := &SetUserRoleRequestBody{
+		Role: p.Role,
+	}
+	return body
+}
+
+// NewSearchUsersAdminUserOK builds a "admin" service "SearchUsers" endpoint
+// result from a HTTP "OK" response.
+func NewSearchUsersAdminUserOK(body []*AdminUserResponse) []*admin.AdminUser {
+	v := make([]*admin.AdminUser, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalAdminUserResponseToAdminAdminUser(val)
+	}
+
+	return v
+}
+
+// NewSearchUsersMissingField builds a admin service SearchUsers endpoint
+// missing_field error.
+func NewSearchUsersMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewSearchUsersUserIsNotAuthenticated builds a admin service SearchUsers
+// endpoint User is not authenticated error.
+func NewSearchUsersUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSearchUsersInvalidInputParameter builds a admin service SearchUsers
+// endpoint Invalid input parameter error.
+func NewSearchUsersInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSearchUsersUserNotFound builds a admin service SearchUsers endpoint User
+// not found error.
+func NewSearchUsersUserNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSearchUsersUserIsNotAuthorized builds a admin service SearchUsers
+// endpoint User is not authorized error.
+func NewSearchUsersUserIsNotAuthorized() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSearchUsersInternalServiceError builds a admin service SearchUsers
+// endpoint Internal service error error.
+func NewSearchUsersInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrdersOrderOK builds a "admin" service "ListUserOrders" endpoint
+// result from a HTTP "OK" response.
+func NewListUserOrdersOrderOK(body []*OrderResponse) []*admin.Order {
+	v := make([]*admin.Order, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalOrderResponseToAdminOrder(val)
+	}
+
+	return v
+}
+
+// NewListUserOrdersMissingField builds a admin service ListUserOrders endpoint
+// missing_field error.
+func NewListUserOrdersMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewListUserOrdersUserIsNotAuthenticated builds a admin service
+// ListUserOrders endpoint User is not authenticated error.
+func NewListUserOrdersUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrdersInvalidInputParameter builds a admin service ListUserOrders
+// endpoint Invalid input parameter error.
+func NewListUserOrdersInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrdersUserNotFound builds a admin service ListUserOrders endpoint
+// User not found error.
+func NewListUserOrdersUserNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrdersUserIsNotAuthorized builds a admin service ListUserOrders
+// endpoint User is not authorized error.
+func NewListUserOrdersUserIsNotAuthorized() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrdersInternalServiceError builds a admin service ListUserOrders
+// endpoint Internal service error error.
+func NewListUserOrdersInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserLedgerLedgerOK builds a "admin" service "GetUserLedger" endpoint
+// result from a HTTP "OK" response.
+func NewGetUserLedgerLedgerOK(body *GetUserLedgerResponseBody) *admin.Ledger {
+	v := &admin.Ledger{
+		Balance:   *body.Balance,
+		Withdrawn: *body.Withdrawn,
+	}
+	v.Entries = make([]*admin.LedgerEntry, len(body.Entries))
+	for i, val := range body.Entries {
+		if val == nil {
+			v.Entries[i] = nil
+			continue
+		}
+		v.Entries[i] = unmarshalLedgerEntryResponseBodyToAdminLedgerEntry(val)
+	}
+
+	return v
+}
+
+// NewGetUserLedgerMissingField builds a admin service GetUserLedger endpoint
+// missing_field error.
+func NewGetUserLedgerMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewGetUserLedgerUserIsNotAuthenticated builds a admin service GetUserLedger
+// endpoint User is not authenticated error.
+func NewGetUserLedgerUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserLedgerInvalidInputParameter builds a admin service GetUserLedger
+// endpoint Invalid input parameter error.
+func NewGetUserLedgerInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserLedgerUserNotFound builds a admin service GetUserLedger endpoint
+// User not found error.
+func NewGetUserLedgerUserNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserLedgerUserIsNotAuthorized builds a admin service GetUserLedger
+// endpoint User is not authorized error.
+func NewGetUserLedgerUserIsNotAuthorized() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserLedgerInternalServiceError builds a admin service GetUserLedger
+// endpoint Internal service error error.
+func NewGetUserLedgerInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewAdjustBalanceLedgerEntryCreated builds a "admin" service "AdjustBalance"
+// endpoint result from a HTTP "Created" response.
+func NewAdjustBalanceLedgerEntryCreated(body *AdjustBalanceResponseBody) *admin.LedgerEntry {
+	v := &admin.LedgerEntry{
+		ID:        *body.ID,
+		Kind:      *body.Kind,
+		Amount:    *body.Amount,
+		Order:     body.Order,
+		Reason:    body.Reason,
+		ActorID:   body.ActorID,
+		CreatedAt: *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewAdjustBalanceMissingField builds a admin service AdjustBalance endpoint
+// missing_field error.
+func NewAdjustBalanceMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewAdjustBalanceUserIsNotAuthenticated builds a admin service AdjustBalance
+// endpoint User is not authenticated error.
+func NewAdjustBalanceUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewAdjustBalanceInsufficientBalance builds a admin service AdjustBalance
+// endpoint Insufficient balance error.
+func NewAdjustBalanceInsufficientBalance() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewAdjustBalanceInvalidInputParameter builds a admin service AdjustBalance
+// endpoint Invalid input parameter error.
+func NewAdjustBalanceInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewAdjustBalanceUserNotFound builds a admin service AdjustBalance endpoint
+// User not found error.
+func NewAdjustBalanceUserNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewAdjustBalanceUserIsNotAuthorized builds a admin service AdjustBalance
+// endpoint User is not authorized error.
+func NewAdjustBalanceUserIsNotAuthorized() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewAdjustBalanceInternalServiceError builds a admin service AdjustBalance
+// endpoint Internal service error error.
+func NewAdjustBalanceInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSetUserRoleAdminUserOK builds a "admin" service "SetUserRole" endpoint
+// result from a HTTP "OK" response.
+func NewSetUserRoleAdminUserOK(body *SetUserRoleResponseBody) *admin.AdminUser {
+	v := &admin.AdminUser{
+		ID:        *body.ID,
+		Login:     *body.Login,
+		Role:      *body.Role,
+		CreatedAt: *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewSetUserRoleMissingField builds a admin service SetUserRole endpoint
+// missing_field error.
+func NewSetUserRoleMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewSetUserRoleUserIsNotAuthenticated builds a admin service SetUserRole
+// endpoint User is not authenticated error.
+func NewSetUserRoleUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSetUserRoleInvalidInputParameter builds a admin service SetUserRole
+// endpoint Invalid input parameter error.
+func NewSetUserRoleInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSetUserRoleUserNotFound builds a admin service SetUserRole endpoint User
+// not found error.
+func NewSetUserRoleUserNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSetUserRoleUserIsNotAuthorized builds a admin service SetUserRole
+// endpoint User is not authorized error.
+func NewSetUserRoleUserIsNotAuthorized() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewSetUserRoleInternalServiceError builds a admin service SetUserRole
+// endpoint Internal service error error.
+func NewSetUserRoleInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewReprocessOrderMissingField builds a admin service ReprocessOrder endpoint
+// missing_field error.
+func NewReprocessOrderMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewReprocessOrderUserIsNotAuthenticated builds a admin service
+// ReprocessOrder endpoint User is not authenticated error.
+func NewReprocessOrderUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewReprocessOrderOrderNotFound builds a admin service ReprocessOrder
+// endpoint Order not found error.
+func NewReprocessOrderOrderNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewReprocessOrderUserNotFound builds a admin service ReprocessOrder endpoint
+// User not found error.
+func NewReprocessOrderUserNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewReprocessOrderTheOrderIsnTProcessedYet builds a admin service
+// ReprocessOrder endpoint The order isn't processed yet error.
+func NewReprocessOrderTheOrderIsnTProcessedYet() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewReprocessOrderInvalidInputParameter builds a admin service ReprocessOrder
+// endpoint Invalid input parameter error.
+func NewReprocessOrderInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewReprocessOrderUserIsNotAuthorized builds a admin service ReprocessOrder
+// endpoint User is not authorized error.
+func NewReprocessOrderUserIsNotAuthorized() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewReprocessOrderInternalServiceError builds a admin service ReprocessOrder
+// endpoint Internal service error error.
+func NewReprocessOrderInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// ValidateGetUserLedgerResponseBody runs the validations defined on
+// GetUserLedgerResponseBody
+func ValidateGetUserLedgerResponseBody(body *GetUserLedgerResponseBody) (err error) {
+	if body.Balance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("balance", "body"))
+	}
+	if body.Withdrawn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withdrawn", "body"))
+	}
+	if body.Entries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entries", "body"))
+	}
+	for _, e := range body.Entries {
+		if e != nil {
+			if err2 := ValidateLedgerEntryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAdjustBalanceResponseBody runs the validations defined on
+// AdjustBalanceResponseBody
+func ValidateAdjustBalanceResponseBody(body *AdjustBalanceResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "accrual" || *body.Kind == "withdrawal" || *body.Kind == "adjustment" || *body.Kind == "reversal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"accrual", "withdrawal", "adjustment", "reversal"}))
+		}
+	}
+	if body.ActorID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.actor_id", *body.ActorID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSetUserRoleResponseBody runs the validations defined on
+// SetUserRoleResponseBody
+func ValidateSetUserRoleResponseBody(body *SetUserRoleResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Login == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("login", "body"))
+	}
+	if body.Role == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("role", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Role != nil {
+		if !(*body.Role == "user" || *body.Role == "support" || *body.Role == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"user", "support", "admin"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAdminUserResponse runs the validations defined on AdminUserResponse
+func ValidateAdminUserResponse(body *AdminUserResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Login == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("login", "body"))
+	}
+	if body.Role == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("role", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Role != nil {
+		if !(*body.Role == "user" || *body.Role == "support" || *body.Role == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"user", "support", "admin"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateOrderResponse runs the validations defined on OrderResponse
+func ValidateOrderResponse(body *OrderResponse) (err error) {
+	if body.Number == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("number", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.UploadedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uploaded_at", "body"))
+	}
+	if body.Number != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.number", *body.Number, "[1-9][0-9]*"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "NEW" || *body.Status == "PROCESSING" || *body.Status == "INVALID" || *body.Status == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if body.UploadedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uploaded_at", *body.UploadedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateLedgerEntryResponseBody runs the validations defined on
+// LedgerEntryResponseBody
+func ValidateLedgerEntryResponseBody(body *LedgerEntryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "accrual" || *body.Kind == "withdrawal" || *body.Kind == "adjustment" || *body.Kind == "reversal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"accrual", "withdrawal", "adjustment", "reversal"}))
+		}
+	}
+	if body.ActorID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.actor_id", *body.ActorID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
@@ -0,0 +1,42 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// HTTP request path constructors for the admin service.
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"fmt"
+)
+
+// SearchUsersAdminPath returns the URL path to the admin service SearchUsers HTTP endpoint.
+func SearchUsersAdminPath() string {
+	return "/api/admin/users"
+}
+
+// ListUserOrdersAdminPath returns the URL path to the admin service ListUserOrders HTTP endpoint.
+func ListUserOrdersAdminPath(userID string) string {
+	return fmt.Sprintf("/api/admin/users/%v/orders", userID)
+}
+
+// GetUserLedgerAdminPath returns the URL path to the admin service GetUserLedger HTTP endpoint.
+func GetUserLedgerAdminPath(userID string) string {
+	return fmt.Sprintf("/api/admin/users/%v/ledger", userID)
+}
+
+// AdjustBalanceAdminPath returns the URL path to the admin service AdjustBalance HTTP endpoint.
+func AdjustBalanceAdminPath(userID string) string {
+	return fmt.Sprintf("/api/admin/users/%v/adjustments", userID)
+}
+
+// SetUserRoleAdminPath returns the URL path to the admin service SetUserRole HTTP endpoint.
+func SetUserRoleAdminPath(userID string) string {
+	return fmt.Sprintf("/api/admin/users/%v/role", userID)
+}
+
+// ReprocessOrderAdminPath returns the URL path to the admin service ReprocessOrder HTTP endpoint.
+func ReprocessOrderAdminPath(number string) string {
+	return fmt.Sprintf("/api/admin/orders/%v/reprocess", number)
+}
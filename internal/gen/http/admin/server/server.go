@@ -0,0 +1,420 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin HTTP server
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	admin "github.com/oleshko-g/oggophermart/internal/gen/admin"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Server lists the admin service endpoint HTTP handlers.
+type Server struct {
+	Mounts         []*MountPoint
+	SearchUsers    http.Handler
+	ListUserOrders http.Handler
+	GetUserLedger  http.Handler
+	AdjustBalance  http.Handler
+	SetUserRole    http.Handler
+	ReprocessOrder http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the admin service endpoints using the
+// provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *admin.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"SearchUsers", "GET", "/api/admin/users"},
+			{"ListUserOrders", "GET", "/api/admin/users/{user_id}/orders"},
+			{"GetUserLedger", "GET", "/api/admin/users/{user_id}/ledger"},
+			{"AdjustBalance", "POST", "/api/admin/users/{user_id}/adjustments"},
+			{"SetUserRole", "PUT", "/api/admin/users/{user_id}/role"},
+			{"ReprocessOrder", "POST", "/api/admin/orders/{number}/reprocess"},
+		},
+		SearchUsers:    NewSearchUsersHandler(e.SearchUsers, mux, decoder, encoder, errhandler, formatter),
+		ListUserOrders: NewListUserOrdersHandler(e.ListUserOrders, mux, decoder, encoder, errhandler, formatter),
+		GetUserLedger:  NewGetUserLedgerHandler(e.GetUserLedger, mux, decoder, encoder, errhandler, formatter),
+		AdjustBalance:  NewAdjustBalanceHandler(e.AdjustBalance, mux, decoder, encoder, errhandler, formatter),
+		SetUserRole:    NewSetUserRoleHandler(e.SetUserRole, mux, decoder, encoder, errhandler, formatter),
+		ReprocessOrder: NewReprocessOrderHandler(e.ReprocessOrder, mux, decoder, encoder, errhandler, formatter),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "admin" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.SearchUsers = m(s.SearchUsers)
+	s.ListUserOrders = m(s.ListUserOrders)
+	s.GetUserLedger = m(s.GetUserLedger)
+	s.AdjustBalance = m(s.AdjustBalance)
+	s.SetUserRole = m(s.SetUserRole)
+	s.ReprocessOrder = m(s.ReprocessOrder)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return admin.MethodNames[:] }
+
+// Mount configures the mux to serve the admin endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountSearchUsersHandler(mux, h.SearchUsers)
+	MountListUserOrdersHandler(mux, h.ListUserOrders)
+	MountGetUserLedgerHandler(mux, h.GetUserLedger)
+	MountAdjustBalanceHandler(mux, h.AdjustBalance)
+	MountSetUserRoleHandler(mux, h.SetUserRole)
+	MountReprocessOrderHandler(mux, h.ReprocessOrder)
+}
+
+// Mount configures the mux to serve the admin endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountSearchUsersHandler configures the mux to serve the "admin" service
+// "SearchUsers" endpoint.
+func MountSearchUsersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/admin/users", f)
+}
+
+// NewSearchUsersHandler creates a HTTP handler which loads the HTTP request
+// and calls the "admin" service "SearchUsers" endpoint.
+func NewSearchUsersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchUsersRequest(mux, decoder)
+		encodeResponse = EncodeSearchUsersResponse(encoder)
+		encodeError    = EncodeSearchUsersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "SearchUsers")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListUserOrdersHandler configures the mux to serve the "admin" service
+// "ListUserOrders" endpoint.
+func MountListUserOrdersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/admin/users/{user_id}/orders", f)
+}
+
+// NewListUserOrdersHandler creates a HTTP handler which loads the HTTP request
+// and calls the "admin" service "ListUserOrders" endpoint.
+func NewListUserOrdersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListUserOrdersRequest(mux, decoder)
+		encodeResponse = EncodeListUserOrdersResponse(encoder)
+		encodeError    = EncodeListUserOrdersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListUserOrders")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetUserLedgerHandler configures the mux to serve the "admin" service
+// "GetUserLedger" endpoint.
+func MountGetUserLedgerHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/admin/users/{user_id}/ledger", f)
+}
+
+// NewGetUserLedgerHandler creates a HTTP handler which loads the HTTP request
+// and calls the "admin" service "GetUserLedger" endpoint.
+func NewGetUserLedgerHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetUserLedgerRequest(mux, decoder)
+		encodeResponse = EncodeGetUserLedgerResponse(encoder)
+		encodeError    = EncodeGetUserLedgerError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetUserLedger")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAdjustBalanceHandler configures the mux to serve the "admin" service
+// "AdjustBalance" endpoint.
+func MountAdjustBalanceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/admin/users/{user_id}/adjustments", f)
+}
+
+// NewAdjustBalanceHandler creates a HTTP handler which loads the HTTP request
+// and calls the "admin" service "AdjustBalance" endpoint.
+func NewAdjustBalanceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAdjustBalanceRequest(mux, decoder)
+		encodeResponse = EncodeAdjustBalanceResponse(encoder)
+		encodeError    = EncodeAdjustBalanceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "AdjustBalance")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetUserRoleHandler configures the mux to serve the "admin" service
+// "SetUserRole" endpoint.
+func MountSetUserRoleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/api/admin/users/{user_id}/role", f)
+}
+
+// NewSetUserRoleHandler creates a HTTP handler which loads the HTTP request
+// and calls the "admin" service "SetUserRole" endpoint.
+func NewSetUserRoleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetUserRoleRequest(mux, decoder)
+		encodeResponse = EncodeSetUserRoleResponse(encoder)
+		encodeError    = EncodeSetUserRoleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "SetUserRole")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountReprocessOrderHandler configures the mux to serve the "admin" service
+// "ReprocessOrder" endpoint.
+func MountReprocessOrderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/admin/orders/{number}/reprocess", f)
+}
+
+// NewReprocessOrderHandler creates a HTTP handler which loads the HTTP request
+// and calls the "admin" service "ReprocessOrder" endpoint.
+func NewReprocessOrderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReprocessOrderRequest(mux, decoder)
+		encodeResponse = EncodeReprocessOrderResponse(encoder)
+		encodeError    = EncodeReprocessOrderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ReprocessOrder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,288 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// admin HTTP server types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"unicode/utf8"
+
+	admin "github.com/oleshko-g/oggophermart/internal/gen/admin"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// AdjustBalanceRequestBody is the type of the "admin" service "AdjustBalance"
+// endpoint HTTP request body.
+type AdjustBalanceRequestBody struct {
+	// The amount of the points with at most 2 fractional digits
+	Amount *money.Amount `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// Why the balance is adjusted
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// SetUserRoleRequestBody is the type of the "admin" service "SetUserRole"
+// endpoint HTTP request body.
+type SetUserRoleRequestBody struct {
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+}
+
+// SearchUsersResponseBody is the type of the "admin" service "SearchUsers"
+// endpoint HTTP response body.
+type SearchUsersResponseBody []*AdminUserResponse
+
+// ListUserOrdersResponseBody is the type of the "admin" service
+// "ListUserOrders" endpoint HTTP response body.
+type ListUserOrdersResponseBody []*OrderResponse
+
+// GetUserLedgerResponseBody is the type of the "admin" service "GetUserLedger"
+// endpoint HTTP response body.
+type GetUserLedgerResponseBody struct {
+	// The amount of the points with at most 2 fractional digits
+	Balance money.Amount `form:"balance" json:"balance" xml:"balance"`
+	// The amount of the points with at most 2 fractional digits
+	Withdrawn money.Amount `form:"withdrawn" json:"withdrawn" xml:"withdrawn"`
+	// The latest entries go first
+	Entries []*LedgerEntryResponseBody `form:"entries" json:"entries" xml:"entries"`
+}
+
+// AdjustBalanceResponseBody is the type of the "admin" service "AdjustBalance"
+// endpoint HTTP response body.
+type AdjustBalanceResponseBody struct {
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// accrual: the order was processed, withdrawal: the user withdrew the points,
+	// adjustment: the admin adjusted the balance, reversal: the accrual of the
+	// reprocessed order was taken back
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The amount of the points with at most 2 fractional digits
+	Amount money.Amount `form:"amount" json:"amount" xml:"amount"`
+	// The number of the order of the entry
+	Order *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	// Why the admin changed the balance
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// The ID of the admin who changed the balance
+	ActorID   *string `form:"actor_id,omitempty" json:"actor_id,omitempty" xml:"actor_id,omitempty"`
+	CreatedAt string  `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// SetUserRoleResponseBody is the type of the "admin" service "SetUserRole"
+// endpoint HTTP response body.
+type SetUserRoleResponseBody struct {
+	ID        string `form:"id" json:"id" xml:"id"`
+	Login     string `form:"login" json:"login" xml:"login"`
+	Role      string `form:"role" json:"role" xml:"role"`
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// AdminUserResponse is used to define fields on response body types.
+type AdminUserResponse struct {
+	ID        string `form:"id" json:"id" xml:"id"`
+	Login     string `form:"login" json:"login" xml:"login"`
+	Role      string `form:"role" json:"role" xml:"role"`
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// OrderResponse is used to define fields on response body types.
+type OrderResponse struct {
+	Number string `form:"number" json:"number" xml:"number"`
+	Status string `form:"status" json:"status" xml:"status"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	UploadedAt string        `form:"uploaded_at" json:"uploaded_at" xml:"uploaded_at"`
+}
+
+// LedgerEntryResponseBody is used to define fields on response body types.
+type LedgerEntryResponseBody struct {
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// accrual: the order was processed, withdrawal: the user withdrew the points,
+	// adjustment: the admin adjusted the balance, reversal: the accrual of the
+	// reprocessed order was taken back
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The amount of the points with at most 2 fractional digits
+	Amount money.Amount `form:"amount" json:"amount" xml:"amount"`
+	// The number of the order of the entry
+	Order *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	// Why the admin changed the balance
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// The ID of the admin who changed the balance
+	ActorID   *string `form:"actor_id,omitempty" json:"actor_id,omitempty" xml:"actor_id,omitempty"`
+	CreatedAt string  `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// NewSearchUsersResponseBody builds the HTTP response body from the result of
+// the "SearchUsers" endpoint of the "admin" service.
+func NewSearchUsersResponseBody(res []*admin.AdminUser) SearchUsersResponseBody {
+	body := make([]*AdminUserResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalAdminAdminUserToAdminUserResponse(val)
+	}
+	return body
+}
+
+// NewListUserOrdersResponseBody builds the HTTP response body from the result
+// of the "ListUserOrders" endpoint of the "admin" service.
+func NewListUserOrdersResponseBody(res []*admin.Order) ListUserOrdersResponseBody {
+	body := make([]*OrderResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalAdminOrderToOrderResponse(val)
+	}
+	return body
+}
+
+// NewGetUserLedgerResponseBody builds the HTTP response body from the result
+// of the "GetUserLedger" endpoint of the "admin" service.
+func NewGetUserLedgerResponseBody(res *admin.Ledger) *GetUserLedgerResponseBody {
+	body := &GetUserLedgerResponseBody{
+		Balance:   res.Balance,
+		Withdrawn: res.Withdrawn,
+	}
+	if res.Entries != nil {
+		body.Entries = make([]*LedgerEntryResponseBody, len(res.Entries))
+		for i, val := range res.Entries {
+			if val == nil {
+				body.Entries[i] = nil
+				continue
+			}
+			body.Entries[i] = marshalAdminLedgerEntryToLedgerEntryResponseBody(val)
+		}
+	} else {
+		body.Entries = []*LedgerEntryResponseBody{}
+	}
+	return body
+}
+
+// NewAdjustBalanceResponseBody builds the HTTP response body from the result
+// of the "AdjustBalance" endpoint of the "admin" service.
+func NewAdjustBalanceResponseBody(res *admin.LedgerEntry) *AdjustBalanceResponseBody {
+	body := &AdjustBalanceResponseBody{
+		ID:        res.ID,
+		Kind:      res.Kind,
+		Amount:    res.Amount,
+		Order:     res.Order,
+		Reason:    res.Reason,
+		ActorID:   res.ActorID,
+		CreatedAt: res.CreatedAt,
+	}
+	return body
+}
+
+// NewSetUserRoleResponseBody builds the HTTP response body from the result of
+// the "SetUserRole" endpoint of the "admin" service.
+func NewSetUserRoleResponseBody(res *admin.AdminUser) *SetUserRoleResponseBody {
+	body := &SetUserRoleResponseBody{
+		ID:        res.ID,
+		Login:     res.Login,
+		Role:      res.Role,
+		CreatedAt: res.CreatedAt,
+	}
+	return body
+}
+
+// NewSearchUsersPayload builds a admin service SearchUsers endpoint payload.
+func NewSearchUsersPayload(login *string, role *string, limit int, authorization string) *admin.SearchUsersPayload {
+	v := &admin.SearchUsersPayload{}
+	v.Login = login
+	v.Role = role
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewListUserOrdersPayload builds a admin service ListUserOrders endpoint
+// payload.
+func NewListUserOrdersPayload(userID string, limit int, authorization string) *admin.ListUserOrdersPayload {
+	v := &admin.ListUserOrdersPayload{}
+	v.UserID = userID
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewGetUserLedgerPayload builds a admin service GetUserLedger endpoint
+// payload.
+func NewGetUserLedgerPayload(userID string, limit int, authorization string) *admin.GetUserLedgerPayload {
+	v := &admin.GetUserLedgerPayload{}
+	v.UserID = userID
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewAdjustBalancePayload builds a admin service AdjustBalance endpoint
+// payload.
+func NewAdjustBalancePayload(body *AdjustBalanceRequestBody, userID string, authorization string) *admin.AdjustBalancePayload {
+	v := &admin.AdjustBalancePayload{
+		Amount: *body.Amount,
+		Reason: *body.Reason,
+	}
+	v.UserID = userID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewSetUserRolePayload builds a admin service SetUserRole endpoint payload.
+func NewSetUserRolePayload(body *SetUserRoleRequestBody, userID string, authorization string) *admin.SetUserRolePayload {
+	v := &admin.SetUserRolePayload{
+		Role: *body.Role,
+	}
+	v.UserID = userID
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewReprocessOrderPayload builds a admin service ReprocessOrder endpoint
+// payload.
+func NewReprocessOrderPayload(number string, authorization string) *admin.ReprocessOrderPayload {
+	v := &admin.ReprocessOrderPayload{}
+	v.Number = number
+	v.Authorization = authorization
+
+	return v
+}
+
+// ValidateAdjustBalanceRequestBody runs the validations defined on
+// AdjustBalanceRequestBody
+func ValidateAdjustBalanceRequestBody(body *AdjustBalanceRequestBody) (err error) {
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Reason != nil {
+		if utf8.RuneCountInString(*body.Reason) > 500 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 500, false))
+		}
+	}
+	return
+}
+
+// ValidateSetUserRoleRequestBody runs the validations defined on
+// SetUserRoleRequestBody
+func ValidateSetUserRoleRequestBody(body *SetUserRoleRequestBody) (err error) {
+	if body.Role == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("role", "body"))
+	}
+	if body.Role != nil {
+		if !(*body.Role == "user" || *body.Role == "support" || *body.Role == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"user", "support", "admin"}))
+		}
+	}
+	return
+}
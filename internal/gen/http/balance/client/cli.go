@@ -0,0 +1,245 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildUploadUserOrderPayload builds the payload for the balance
+// UploadUserOrder endpoint from CLI flags.
+func BuildUploadUserOrderPayload(balanceUploadUserOrderBody string, balanceUploadUserOrderAuthorization string) (*balance.UploadUserOrderPayload, error) {
+	var body string
+	{
+		body = balanceUploadUserOrderBody
+	}
+	var authorization string
+	{
+		authorization = balanceUploadUserOrderAuthorization
+	}
+	v := body
+	res := &balance.UploadUserOrderPayload{
+		OrderNumber: v,
+	}
+	res.Authorization = authorization
+
+	return res, nil
+}
+
+// BuildUploadUserOrdersPayload builds the payload for the balance
+// UploadUserOrders endpoint from CLI flags.
+func BuildUploadUserOrdersPayload(balanceUploadUserOrdersAuthorization string, balanceUploadUserOrdersContentType string) (*balance.UploadUserOrdersPayload, error) {
+	var authorization string
+	{
+		authorization = balanceUploadUserOrdersAuthorization
+	}
+	var contentType *string
+	{
+		if balanceUploadUserOrdersContentType != "" {
+			contentType = &balanceUploadUserOrdersContentType
+		}
+	}
+	v := &balance.UploadUserOrdersPayload{}
+	v.Authorization = authorization
+	v.ContentType = contentType
+
+	return v, nil
+}
+
+// BuildListUserOrderPayload builds the payload for the balance ListUserOrder
+// endpoint from CLI flags.
+func BuildListUserOrderPayload(balanceListUserOrderLimit string, balanceListUserOrderCursor string, balanceListUserOrderStatus string, balanceListUserOrderFrom string, balanceListUserOrderTo string, balanceListUserOrderSort string, balanceListUserOrderAuthorization string) (*balance.ListUserOrderPayload, error) {
+	var err error
+	var limit *int
+	{
+		if balanceListUserOrderLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(balanceListUserOrderLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+			if *limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if balanceListUserOrderCursor != "" {
+			cursor = &balanceListUserOrderCursor
+		}
+	}
+	var status []string
+	{
+		if balanceListUserOrderStatus != "" {
+			err = json.Unmarshal([]byte(balanceListUserOrderStatus), &status)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for status, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"NEW\",\n      \"PROCESSING\",\n      \"INVALID\",\n      \"PROCESSED\"\n   ]'")
+			}
+			for _, e := range status {
+				if !(e == "NEW" || e == "PROCESSING" || e == "INVALID" || e == "PROCESSED") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("status[*]", e, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from *string
+	{
+		if balanceListUserOrderFrom != "" {
+			from = &balanceListUserOrderFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if balanceListUserOrderTo != "" {
+			to = &balanceListUserOrderTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sort *string
+	{
+		if balanceListUserOrderSort != "" {
+			sort = &balanceListUserOrderSort
+			if !(*sort == "asc" || *sort == "desc") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"asc", "desc"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = balanceListUserOrderAuthorization
+	}
+	v := &balance.ListUserOrderPayload{}
+	v.Limit = limit
+	v.Cursor = cursor
+	v.Status = status
+	v.From = from
+	v.To = to
+	v.Sort = sort
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetUserOrderPayload builds the payload for the balance GetUserOrder
+// endpoint from CLI flags.
+func BuildGetUserOrderPayload(balanceGetUserOrderNumber string, balanceGetUserOrderAuthorization string) (*balance.GetUserOrderPayload, error) {
+	var number string
+	{
+		number = balanceGetUserOrderNumber
+	}
+	var authorization string
+	{
+		authorization = balanceGetUserOrderAuthorization
+	}
+	v := &balance.GetUserOrderPayload{}
+	v.Number = number
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildStreamUserOrderEventsPayload builds the payload for the balance
+// StreamUserOrderEvents endpoint from CLI flags.
+func BuildStreamUserOrderEventsPayload(balanceStreamUserOrderEventsAuthorization string, balanceStreamUserOrderEventsLastEventID string) (*balance.StreamUserOrderEventsPayload, error) {
+	var authorization string
+	{
+		authorization = balanceStreamUserOrderEventsAuthorization
+	}
+	var lastEventID *string
+	{
+		if balanceStreamUserOrderEventsLastEventID != "" {
+			lastEventID = &balanceStreamUserOrderEventsLastEventID
+		}
+	}
+	v := &balance.StreamUserOrderEventsPayload{}
+	v.Authorization = authorization
+	v.LastEventID = lastEventID
+
+	return v, nil
+}
+
+// BuildGetUserBalancePayload builds the payload for the balance GetUserBalance
+// endpoint from CLI flags.
+func BuildGetUserBalancePayload(balanceGetUserBalanceAuthorization string) (*balance.GetUserBalancePayload, error) {
+	var authorization string
+	{
+		authorization = balanceGetUserBalanceAuthorization
+	}
+	v := &balance.GetUserBalancePayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildWithdrawPayload builds the payload for the balance Withdraw endpoint
+// from CLI flags.
+func BuildWithdrawPayload(balanceWithdrawBody string, balanceWithdrawAuthorization string) (*balance.WithdrawPayload, error) {
+	var err error
+	var body WithdrawRequestBody
+	{
+		err = json.Unmarshal([]byte(balanceWithdrawBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"order\": \"2377225624\",\n      \"sum\": 729.98\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.order", body.Order, "[1-9][0-9]*"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = balanceWithdrawAuthorization
+	}
+	v := &balance.WithdrawPayload{
+		Order: body.Order,
+		Sum:   body.Sum,
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListWithdrawalsPayload builds the payload for the balance
+// ListWithdrawals endpoint from CLI flags.
+func BuildListWithdrawalsPayload(balanceListWithdrawalsAuthorization string) (*balance.ListWithdrawalsPayload, error) {
+	var authorization string
+	{
+		authorization = balanceListWithdrawalsAuthorization
+	}
+	v := &balance.ListWithdrawalsPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
@@ -0,0 +1,292 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance client HTTP transport
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the balance service endpoint HTTP clients.
+type Client struct {
+	// UploadUserOrder Doer is the HTTP client used to make requests to the
+	// UploadUserOrder endpoint.
+	UploadUserOrderDoer goahttp.Doer
+
+	// UploadUserOrders Doer is the HTTP client used to make requests to the
+	// UploadUserOrders endpoint.
+	UploadUserOrdersDoer goahttp.Doer
+
+	// ListUserOrder Doer is the HTTP client used to make requests to the
+	// ListUserOrder endpoint.
+	ListUserOrderDoer goahttp.Doer
+
+	// GetUserOrder Doer is the HTTP client used to make requests to the
+	// GetUserOrder endpoint.
+	GetUserOrderDoer goahttp.Doer
+
+	// StreamUserOrderEvents Doer is the HTTP client used to make requests to the
+	// StreamUserOrderEvents endpoint.
+	StreamUserOrderEventsDoer goahttp.Doer
+
+	// GetUserBalance Doer is the HTTP client used to make requests to the
+	// GetUserBalance endpoint.
+	GetUserBalanceDoer goahttp.Doer
+
+	// Withdraw Doer is the HTTP client used to make requests to the Withdraw
+	// endpoint.
+	WithdrawDoer goahttp.Doer
+
+	// ListWithdrawals Doer is the HTTP client used to make requests to the
+	// ListWithdrawals endpoint.
+	ListWithdrawalsDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the balance service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		UploadUserOrderDoer:       doer,
+		UploadUserOrdersDoer:      doer,
+		ListUserOrderDoer:         doer,
+		GetUserOrderDoer:          doer,
+		StreamUserOrderEventsDoer: doer,
+		GetUserBalanceDoer:        doer,
+		WithdrawDoer:              doer,
+		ListWithdrawalsDoer:       doer,
+		RestoreResponseBody:       restoreBody,
+		scheme:                    scheme,
+		host:                      host,
+		decoder:                   dec,
+		encoder:                   enc,
+	}
+}
+
+// UploadUserOrder returns an endpoint that makes HTTP requests to the balance
+// service UploadUserOrder server.
+func (c *Client) UploadUserOrder() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadUserOrderRequest(c.encoder)
+		decodeResponse = DecodeUploadUserOrderResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadUserOrderRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadUserOrderDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "UploadUserOrder", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UploadUserOrders returns an endpoint that makes HTTP requests to the balance
+// service UploadUserOrders server.
+func (c *Client) UploadUserOrders() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadUserOrdersRequest(c.encoder)
+		decodeResponse = DecodeUploadUserOrdersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadUserOrdersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadUserOrdersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "UploadUserOrders", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListUserOrder returns an endpoint that makes HTTP requests to the balance
+// service ListUserOrder server.
+func (c *Client) ListUserOrder() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListUserOrderRequest(c.encoder)
+		decodeResponse = DecodeListUserOrderResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListUserOrderRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListUserOrderDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "ListUserOrder", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetUserOrder returns an endpoint that makes HTTP requests to the balance
+// service GetUserOrder server.
+func (c *Client) GetUserOrder() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetUserOrderRequest(c.encoder)
+		decodeResponse = DecodeGetUserOrderResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetUserOrderRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetUserOrderDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "GetUserOrder", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// StreamUserOrderEvents returns an endpoint that makes HTTP requests to the
+// balance service StreamUserOrderEvents server.
+func (c *Client) StreamUserOrderEvents() goa.Endpoint {
+	var (
+		encodeRequest = EncodeStreamUserOrderEventsRequest(c.encoder)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStreamUserOrderEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		// For SSE endpoints, connect and return a stream
+		resp, err := c.StreamUserOrderEventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "StreamUserOrderEvents", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status from SSE endpoint: %d", resp.StatusCode)
+		}
+
+		contentType := resp.Header.Get("Content-Type")
+		if contentType != "" && !strings.HasPrefix(contentType, "text/event-stream") {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content type: %s (expected text/event-stream)", contentType)
+		}
+
+		return NewStreamUserOrderEventsStream(resp, c.decoder), nil
+	}
+}
+
+// GetUserBalance returns an endpoint that makes HTTP requests to the balance
+// service GetUserBalance server.
+func (c *Client) GetUserBalance() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetUserBalanceRequest(c.encoder)
+		decodeResponse = DecodeGetUserBalanceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetUserBalanceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetUserBalanceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "GetUserBalance", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Withdraw returns an endpoint that makes HTTP requests to the balance service
+// Withdraw server.
+func (c *Client) Withdraw() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeWithdrawRequest(c.encoder)
+		decodeResponse = DecodeWithdrawResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildWithdrawRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.WithdrawDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "Withdraw", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWithdrawals returns an endpoint that makes HTTP requests to the balance
+// service ListWithdrawals server.
+func (c *Client) ListWithdrawals() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWithdrawalsRequest(c.encoder)
+		decodeResponse = DecodeListWithdrawalsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWithdrawalsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWithdrawalsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "ListWithdrawals", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,1420 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildUploadUserOrderRequest instantiates a HTTP request object with method
+// and path set to call the "balance" service "UploadUserOrder" endpoint
+func (c *Client) BuildUploadUserOrderRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadUserOrderBalancePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "UploadUserOrder", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUploadUserOrderRequest returns an encoder for requests sent to the
+// balance UploadUserOrder server.
+func EncodeUploadUserOrderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.UploadUserOrderPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "UploadUserOrder", "*balance.UploadUserOrderPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := p.OrderNumber
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("balance", "UploadUserOrder", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUploadUserOrderResponse returns a decoder for responses returned by
+// the balance UploadUserOrder endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUploadUserOrderResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "The order belongs to another user" (type *service.GophermartError): http.StatusConflict
+//   - "Invalid order number" (type *service.GophermartError): http.StatusUnprocessableEntity
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUploadUserOrderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			res := NewUploadUserOrderResultAccepted()
+			tmp := "yes"
+			res.Accepted = &tmp
+			return res, nil
+		case http.StatusOK:
+			res := NewUploadUserOrderResultOK()
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "UploadUserOrder", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "UploadUserOrder", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "UploadUserOrder", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "UploadUserOrder", err)
+				}
+				return nil, NewUploadUserOrderMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewUploadUserOrderUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "UploadUserOrder", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewUploadUserOrderInvalidInputParameter()
+		case http.StatusConflict:
+			return nil, NewUploadUserOrderTheOrderBelongsToAnotherUser()
+		case http.StatusUnprocessableEntity:
+			return nil, NewUploadUserOrderInvalidOrderNumber()
+		case http.StatusInternalServerError:
+			return nil, NewUploadUserOrderInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "UploadUserOrder", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUploadUserOrdersRequest instantiates a HTTP request object with method
+// and path set to call the "balance" service "UploadUserOrders" endpoint
+func (c *Client) BuildUploadUserOrdersRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*balance.UploadUserOrdersRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("balance", "UploadUserOrders", "balance.UploadUserOrdersRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadUserOrdersBalancePath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "UploadUserOrders", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUploadUserOrdersRequest returns an encoder for requests sent to the
+// balance UploadUserOrders server.
+func EncodeUploadUserOrdersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*balance.UploadUserOrdersRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "UploadUserOrders", "*balance.UploadUserOrdersRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.ContentType != nil {
+			head := *p.ContentType
+			req.Header.Set("Content-Type", head)
+		}
+		return nil
+	}
+}
+
+// DecodeUploadUserOrdersResponse returns a decoder for responses returned by
+// the balance UploadUserOrders endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUploadUserOrdersResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Too many orders" (type *service.GophermartError): http.StatusRequestEntityTooLarge
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUploadUserOrdersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UploadUserOrdersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "UploadUserOrders", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateOrderUploadResult(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("balance", "UploadUserOrders", err)
+			}
+			res := NewUploadUserOrdersResultOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "UploadUserOrders", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "UploadUserOrders", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "UploadUserOrders", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "UploadUserOrders", err)
+				}
+				return nil, NewUploadUserOrdersMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewUploadUserOrdersUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "UploadUserOrders", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewUploadUserOrdersInvalidInputParameter()
+		case http.StatusRequestEntityTooLarge:
+			return nil, NewUploadUserOrdersTooManyOrders()
+		case http.StatusInternalServerError:
+			return nil, NewUploadUserOrdersInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "UploadUserOrders", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildUploadUserOrdersStreamPayload creates a streaming endpoint request
+// payload from the method payload and the path to the file to be streamed
+func BuildUploadUserOrdersStreamPayload(payload any, fpath string) (*balance.UploadUserOrdersRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &balance.UploadUserOrdersRequestData{
+		Payload: payload.(*balance.UploadUserOrdersPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildListUserOrderRequest instantiates a HTTP request object with method and
+// path set to call the "balance" service "ListUserOrder" endpoint
+func (c *Client) BuildListUserOrderRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUserOrderBalancePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "ListUserOrder", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListUserOrderRequest returns an encoder for requests sent to the
+// balance ListUserOrder server.
+func EncodeListUserOrderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.ListUserOrderPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "ListUserOrder", "*balance.ListUserOrderPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		for _, value := range p.Status {
+			values.Add("status", value)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		if p.Sort != nil {
+			values.Add("sort", *p.Sort)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListUserOrderResponse returns a decoder for responses returned by the
+// balance ListUserOrder endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListUserOrderResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListUserOrderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			res := NewListUserOrderResultNoContent()
+			tmp := "yes"
+			res.NoOrders = &tmp
+			return res, nil
+		case http.StatusOK:
+			var (
+				body ListUserOrderResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "ListUserOrder", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateOrder(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("balance", "ListUserOrder", err)
+			}
+			var (
+				nextCursor *string
+				link       *string
+			)
+			nextCursorRaw := resp.Header.Get("Next-Cursor")
+			if nextCursorRaw != "" {
+				nextCursor = &nextCursorRaw
+			}
+			linkRaw := resp.Header.Get("Link")
+			if linkRaw != "" {
+				link = &linkRaw
+			}
+			res := NewListUserOrderResultOK(body, nextCursor, link)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "ListUserOrder", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "ListUserOrder", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "ListUserOrder", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "ListUserOrder", err)
+				}
+				return nil, NewListUserOrderMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewListUserOrderUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "ListUserOrder", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewListUserOrderInvalidInputParameter()
+		case http.StatusInternalServerError:
+			return nil, NewListUserOrderInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "ListUserOrder", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetUserOrderRequest instantiates a HTTP request object with method and
+// path set to call the "balance" service "GetUserOrder" endpoint
+func (c *Client) BuildGetUserOrderRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		number string
+	)
+	{
+		p, ok := v.(*balance.GetUserOrderPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("balance", "GetUserOrder", "*balance.GetUserOrderPayload", v)
+		}
+		number = p.Number
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetUserOrderBalancePath(number)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "GetUserOrder", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetUserOrderRequest returns an encoder for requests sent to the
+// balance GetUserOrder server.
+func EncodeGetUserOrderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.GetUserOrderPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "GetUserOrder", "*balance.GetUserOrderPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetUserOrderResponse returns a decoder for responses returned by the
+// balance GetUserOrder endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetUserOrderResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Order not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetUserOrderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetUserOrderResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "GetUserOrder", err)
+			}
+			err = ValidateGetUserOrderResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("balance", "GetUserOrder", err)
+			}
+			res := NewGetUserOrderOrderTimelineOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "GetUserOrder", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "GetUserOrder", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "GetUserOrder", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "GetUserOrder", err)
+				}
+				return nil, NewGetUserOrderMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewGetUserOrderUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "GetUserOrder", resp.StatusCode, string(body))
+			}
+		case http.StatusNotFound:
+			return nil, NewGetUserOrderOrderNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewGetUserOrderInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "GetUserOrder", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildStreamUserOrderEventsRequest instantiates a HTTP request object with
+// method and path set to call the "balance" service "StreamUserOrderEvents"
+// endpoint
+func (c *Client) BuildStreamUserOrderEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StreamUserOrderEventsBalancePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "StreamUserOrderEvents", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStreamUserOrderEventsRequest returns an encoder for requests sent to
+// the balance StreamUserOrderEvents server.
+func EncodeStreamUserOrderEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.StreamUserOrderEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "StreamUserOrderEvents", "*balance.StreamUserOrderEventsPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.LastEventID != nil {
+			head := *p.LastEventID
+			req.Header.Set("Last-Event-ID", head)
+		}
+		return nil
+	}
+}
+
+// DecodeStreamUserOrderEventsResponse returns a decoder for responses returned
+// by the balance StreamUserOrderEvents endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeStreamUserOrderEventsResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeStreamUserOrderEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body StreamUserOrderEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "StreamUserOrderEvents", err)
+			}
+			err = ValidateStreamUserOrderEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("balance", "StreamUserOrderEvents", err)
+			}
+			res := NewStreamUserOrderEventsOrderEventOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "StreamUserOrderEvents", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "StreamUserOrderEvents", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "StreamUserOrderEvents", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "StreamUserOrderEvents", err)
+				}
+				return nil, NewStreamUserOrderEventsMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewStreamUserOrderEventsUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "StreamUserOrderEvents", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewStreamUserOrderEventsInvalidInputParameter()
+		case http.StatusInternalServerError:
+			return nil, NewStreamUserOrderEventsInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "StreamUserOrderEvents", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetUserBalanceRequest instantiates a HTTP request object with method
+// and path set to call the "balance" service "GetUserBalance" endpoint
+func (c *Client) BuildGetUserBalanceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetUserBalanceBalancePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "GetUserBalance", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetUserBalanceRequest returns an encoder for requests sent to the
+// balance GetUserBalance server.
+func EncodeGetUserBalanceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.GetUserBalancePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "GetUserBalance", "*balance.GetUserBalancePayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetUserBalanceResponse returns a decoder for responses returned by the
+// balance GetUserBalance endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetUserBalanceResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetUserBalanceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetUserBalanceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "GetUserBalance", err)
+			}
+			err = ValidateGetUserBalanceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("balance", "GetUserBalance", err)
+			}
+			res := NewGetUserBalanceUserBalanceOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "GetUserBalance", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "GetUserBalance", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "GetUserBalance", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "GetUserBalance", err)
+				}
+				return nil, NewGetUserBalanceMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewGetUserBalanceUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "GetUserBalance", resp.StatusCode, string(body))
+			}
+		case http.StatusInternalServerError:
+			return nil, NewGetUserBalanceInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "GetUserBalance", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildWithdrawRequest instantiates a HTTP request object with method and path
+// set to call the "balance" service "Withdraw" endpoint
+func (c *Client) BuildWithdrawRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: WithdrawBalancePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "Withdraw", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeWithdrawRequest returns an encoder for requests sent to the balance
+// Withdraw server.
+func EncodeWithdrawRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.WithdrawPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "Withdraw", "*balance.WithdrawPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewWithdrawRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("balance", "Withdraw", err)
+		}
+		return nil
+	}
+}
+
+// DecodeWithdrawResponse returns a decoder for responses returned by the
+// balance Withdraw endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeWithdrawResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Insufficient funds" (type *service.GophermartError): http.StatusPaymentRequired
+//   - "Invalid order number" (type *service.GophermartError): http.StatusUnprocessableEntity
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeWithdrawResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "Withdraw", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "Withdraw", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "Withdraw", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "Withdraw", err)
+				}
+				return nil, NewWithdrawMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewWithdrawUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "Withdraw", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewWithdrawInvalidInputParameter()
+		case http.StatusPaymentRequired:
+			return nil, NewWithdrawInsufficientFunds()
+		case http.StatusUnprocessableEntity:
+			return nil, NewWithdrawInvalidOrderNumber()
+		case http.StatusInternalServerError:
+			return nil, NewWithdrawInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "Withdraw", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWithdrawalsRequest instantiates a HTTP request object with method
+// and path set to call the "balance" service "ListWithdrawals" endpoint
+func (c *Client) BuildListWithdrawalsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWithdrawalsBalancePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "ListWithdrawals", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWithdrawalsRequest returns an encoder for requests sent to the
+// balance ListWithdrawals server.
+func EncodeListWithdrawalsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.ListWithdrawalsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "ListWithdrawals", "*balance.ListWithdrawalsPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListWithdrawalsResponse returns a decoder for responses returned by
+// the balance ListWithdrawals endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListWithdrawalsResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListWithdrawalsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			res := NewListWithdrawalsResultNoContent()
+			tmp := "yes"
+			res.NoWithdrawals = &tmp
+			return res, nil
+		case http.StatusOK:
+			var (
+				body ListWithdrawalsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "ListWithdrawals", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWithdrawal(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("balance", "ListWithdrawals", err)
+			}
+			res := NewListWithdrawalsResultOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "ListWithdrawals", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "ListWithdrawals", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("balance", "ListWithdrawals", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("balance", "ListWithdrawals", err)
+				}
+				return nil, NewListWithdrawalsMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewListWithdrawalsUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("balance", "ListWithdrawals", resp.StatusCode, string(body))
+			}
+		case http.StatusInternalServerError:
+			return nil, NewListWithdrawalsInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "ListWithdrawals", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalOrderUploadResultToBalanceOrderUploadResult builds a value of type
+// *balance.OrderUploadResult from a value of type *OrderUploadResult.
+func unmarshalOrderUploadResultToBalanceOrderUploadResult(v *OrderUploadResult) *balance.OrderUploadResult {
+	res := &balance.OrderUploadResult{
+		Number: *v.Number,
+		Result: *v.Result,
+	}
+
+	return res
+}
+
+// unmarshalOrderToBalanceOrder builds a value of type *balance.Order from a
+// value of type *Order.
+func unmarshalOrderToBalanceOrder(v *Order) *balance.Order {
+	res := &balance.Order{
+		Number:     *v.Number,
+		Status:     *v.Status,
+		Accrual:    v.Accrual,
+		UploadedAt: *v.UploadedAt,
+	}
+
+	return res
+}
+
+// unmarshalOrderStatusChangeResponseBodyToBalanceOrderStatusChange builds a
+// value of type *balance.OrderStatusChange from a value of type
+// *OrderStatusChangeResponseBody.
+func unmarshalOrderStatusChangeResponseBodyToBalanceOrderStatusChange(v *OrderStatusChangeResponseBody) *balance.OrderStatusChange {
+	res := &balance.OrderStatusChange{
+		From:   v.From,
+		Status: *v.Status,
+		Source: *v.Source,
+		At:     *v.At,
+	}
+
+	return res
+}
+
+// unmarshalWithdrawalToBalanceWithdrawal builds a value of type
+// *balance.Withdrawal from a value of type *Withdrawal.
+func unmarshalWithdrawalToBalanceWithdrawal(v *Withdrawal) *balance.Withdrawal {
+	res := &balance.Withdrawal{
+		Order:       *v.Order,
+		Sum:         *v.Sum,
+		ProcessedAt: *v.ProcessedAt,
+	}
+
+	return res
+}
@@ -0,0 +1,52 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// HTTP request path constructors for the balance service.
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"fmt"
+)
+
+// UploadUserOrderBalancePath returns the URL path to the balance service UploadUserOrder HTTP endpoint.
+func UploadUserOrderBalancePath() string {
+	return "/api/user/orders"
+}
+
+// UploadUserOrdersBalancePath returns the URL path to the balance service UploadUserOrders HTTP endpoint.
+func UploadUserOrdersBalancePath() string {
+	return "/api/user/orders/batch"
+}
+
+// ListUserOrderBalancePath returns the URL path to the balance service ListUserOrder HTTP endpoint.
+func ListUserOrderBalancePath() string {
+	return "/api/user/orders"
+}
+
+// GetUserOrderBalancePath returns the URL path to the balance service GetUserOrder HTTP endpoint.
+func GetUserOrderBalancePath(number string) string {
+	return fmt.Sprintf("/api/user/orders/%v", number)
+}
+
+// StreamUserOrderEventsBalancePath returns the URL path to the balance service StreamUserOrderEvents HTTP endpoint.
+func StreamUserOrderEventsBalancePath() string {
+	return "/api/user/orders/events"
+}
+
+// GetUserBalanceBalancePath returns the URL path to the balance service GetUserBalance HTTP endpoint.
+func GetUserBalanceBalancePath() string {
+	return "/api/user/balance"
+}
+
+// WithdrawBalancePath returns the URL path to the balance service Withdraw HTTP endpoint.
+func WithdrawBalancePath() string {
+	return "/api/user/balance/withdraw"
+}
+
+// ListWithdrawalsBalancePath returns the URL path to the balance service ListWithdrawals HTTP endpoint.
+func ListWithdrawalsBalancePath() string {
+	return "/api/user/withdrawals"
+}
@@ -0,0 +1,765 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// balance HTTP client types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// WithdrawRequestBody is the type of the "balance" service "Withdraw" endpoint
+// HTTP request body.
+type WithdrawRequestBody struct {
+	// The number of the order paid by the points
+	Order string `form:"order" json:"order" xml:"order"`
+	// The amount of the points with at most 2 fractional digits
+	Sum money.Amount `form:"sum" json:"sum" xml:"sum"`
+}
+
+// UploadUserOrdersResponseBody is the type of the "balance" service
+// "UploadUserOrders" endpoint HTTP response body.
+type UploadUserOrdersResponseBody []*OrderUploadResult
+
+// ListUserOrderResponseBody is the type of the "balance" service
+// "ListUserOrder" endpoint HTTP response body.
+type ListUserOrderResponseBody []*Order
+
+// GetUserOrderResponseBody is the type of the "balance" service "GetUserOrder"
+// endpoint HTTP response body.
+type GetUserOrderResponseBody struct {
+	Number *string `form:"number,omitempty" json:"number,omitempty" xml:"number,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	UploadedAt *string       `form:"uploaded_at,omitempty" json:"uploaded_at,omitempty" xml:"uploaded_at,omitempty"`
+	// The time the order has reached a final status
+	ProcessedAt *string `form:"processed_at,omitempty" json:"processed_at,omitempty" xml:"processed_at,omitempty"`
+	// The transitions of the order status in their order
+	History []*OrderStatusChangeResponseBody `form:"history,omitempty" json:"history,omitempty" xml:"history,omitempty"`
+}
+
+// StreamUserOrderEventsResponseBody is the type of the "balance" service
+// "StreamUserOrderEvents" endpoint HTTP response body.
+type StreamUserOrderEventsResponseBody struct {
+	// The ID to resume the stream after the event. It's empty for the heartbeats
+	ID   *string `json:"-"`
+	Type *string `json:"-"`
+	// The order number
+	Number *string `form:"number,omitempty" json:"number,omitempty" xml:"number,omitempty"`
+	// The previous status. It's absent for the first status of the order
+	From   *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	At      *string       `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// GetUserBalanceResponseBody is the type of the "balance" service
+// "GetUserBalance" endpoint HTTP response body.
+type GetUserBalanceResponseBody struct {
+	// The amount of the points with at most 2 fractional digits
+	Current *money.Amount `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Withdrawn *money.Amount `form:"withdrawn,omitempty" json:"withdrawn,omitempty" xml:"withdrawn,omitempty"`
+}
+
+// ListWithdrawalsResponseBody is the type of the "balance" service
+// "ListWithdrawals" endpoint HTTP response body.
+type ListWithdrawalsResponseBody []*Withdrawal
+
+// OrderUploadResult is used to define fields on response body types.
+type OrderUploadResult struct {
+	Number *string `form:"number,omitempty" json:"number,omitempty" xml:"number,omitempty"`
+	// accepted: stored for processing, duplicate: uploaded by the user before,
+	// conflict: uploaded by another user, invalid: not a valid order number
+	Result *string `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+}
+
+// Order is used to define fields on response body types.
+type Order struct {
+	Number *string `form:"number,omitempty" json:"number,omitempty" xml:"number,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	UploadedAt *string       `form:"uploaded_at,omitempty" json:"uploaded_at,omitempty" xml:"uploaded_at,omitempty"`
+}
+
+// OrderStatusChangeResponseBody is used to define fields on response body
+// types.
+type OrderStatusChangeResponseBody struct {
+	// The previous status. It's absent for the first status of the order
+	From   *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// upload: the user uploaded the order, accrual: the accrual system processed
+	// the order, import: the order was imported in bulk, migration: the status was
+	// recorded before the history was kept, admin: the admin reprocessed the order
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	At     *string `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// Withdrawal is used to define fields on response body types.
+type Withdrawal struct {
+	// The number of the order paid by the points
+	Order *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Sum         *money.Amount `form:"sum,omitempty" json:"sum,omitempty" xml:"sum,omitempty"`
+	ProcessedAt *string       `form:"processed_at,omitempty" json:"processed_at,omitempty" xml:"processed_at,omitempty"`
+}
+
+// NewWithdrawRequestBody builds the HTTP request body from the payload of the
+// "Withdraw" endpoint of the "balance" service.
+func NewWithdrawRequestBody(p *balance.WithdrawPayload) *WithdrawRequestBody {
+	body := &WithdrawRequestBody{
+		Order: p.Order,
+		Sum:   p.Sum,
+	}
+	return body
+}
+
+// NewUploadUserOrderResultAccepted builds a "balance" service
+// "UploadUserOrder" endpoint result from a HTTP "Accepted" response.
+func NewUploadUserOrderResultAccepted() *balance.UploadUserOrderResult {
+	v := &balance.UploadUserOrderResult{}
+
+	return v
+}
+
+// NewUploadUserOrderResultOK builds a "balance" service "UploadUserOrder"
+// endpoint result from a HTTP "OK" response.
+func NewUploadUserOrderResultOK() *balance.UploadUserOrderResult {
+	v := &balance.UploadUserOrderResult{}
+
+	return v
+}
+
+// NewUploadUserOrderMissingField builds a balance service UploadUserOrder
+// endpoint missing_field error.
+func NewUploadUserOrderMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewUploadUserOrderUserIsNotAuthenticated builds a balance service
+// UploadUserOrder endpoint User is not authenticated error.
+func NewUploadUserOrderUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrderInvalidInputParameter builds a balance service
+// UploadUserOrder endpoint Invalid input parameter error.
+func NewUploadUserOrderInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrderTheOrderBelongsToAnotherUser builds a balance service
+// UploadUserOrder endpoint The order belongs to another user error.
+func NewUploadUserOrderTheOrderBelongsToAnotherUser() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrderInvalidOrderNumber builds a balance service
+// UploadUserOrder endpoint Invalid order number error.
+func NewUploadUserOrderInvalidOrderNumber() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrderInternalServiceError builds a balance service
+// UploadUserOrder endpoint Internal service error error.
+func NewUploadUserOrderInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrdersResultOK builds a "balance" service "UploadUserOrders"
+// endpoint result from a HTTP "OK" response.
+func NewUploadUserOrdersResultOK(body []*OrderUploadResult) *balance.UploadUserOrdersResult {
+	v := make([]*balance.OrderUploadResult, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalOrderUploadResultToBalanceOrderUploadResult(val)
+	}
+	res := &balance.UploadUserOrdersResult{
+		Orders: v,
+	}
+
+	return res
+}
+
+// NewUploadUserOrdersMissingField builds a balance service UploadUserOrders
+// endpoint missing_field error.
+func NewUploadUserOrdersMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewUploadUserOrdersUserIsNotAuthenticated builds a balance service
+// UploadUserOrders endpoint User is not authenticated error.
+func NewUploadUserOrdersUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrdersInvalidInputParameter builds a balance service
+// UploadUserOrders endpoint Invalid input parameter error.
+func NewUploadUserOrdersInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrdersTooManyOrders builds a balance service UploadUserOrders
+// endpoint Too many orders error.
+func NewUploadUserOrdersTooManyOrders() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUploadUserOrdersInternalServiceError builds a balance service
+// UploadUserOrders endpoint Internal service error error.
+func NewUploadUserOrdersInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrderResultNoContent builds a "balance" service "ListUserOrder"
+// endpoint result from a HTTP "NoContent" response.
+func NewListUserOrderResultNoContent() *balance.ListUserOrderResult {
+	v := &balance.ListUserOrderResult{}
+
+	return v
+}
+
+// NewListUserOrderResultOK builds a "balance" service "ListUserOrder" endpoint
+// result from a HTTP "OK" response.
+func NewListUserOrderResultOK(body []*Order, nextCursor *string, link *string) *balance.ListUserOrderResult {
+	v := make([]*balance.Order, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalOrderToBalanceOrder(val)
+	}
+	res := &balance.ListUserOrderResult{
+		Orders: v,
+	}
+	res.NextCursor = nextCursor
+	res.Link = link
+
+	return res
+}
+
+// NewListUserOrderMissingField builds a balance service ListUserOrder endpoint
+// missing_field error.
+func NewListUserOrderMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewListUserOrderUserIsNotAuthenticated builds a balance service
+// ListUserOrder endpoint User is not authenticated error.
+func NewListUserOrderUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrderInvalidInputParameter builds a balance service ListUserOrder
+// endpoint Invalid input parameter error.
+func NewListUserOrderInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListUserOrderInternalServiceError builds a balance service ListUserOrder
+// endpoint Internal service error error.
+func NewListUserOrderInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserOrderOrderTimelineOK builds a "balance" service "GetUserOrder"
+// endpoint result from a HTTP "OK" response.
+func NewGetUserOrderOrderTimelineOK(body *GetUserOrderResponseBody) *balance.OrderTimeline {
+	v := &balance.OrderTimeline{
+		Number:      *body.Number,
+		Status:      *body.Status,
+		Accrual:     body.Accrual,
+		UploadedAt:  *body.UploadedAt,
+		ProcessedAt: body.ProcessedAt,
+	}
+	v.History = make([]*balance.OrderStatusChange, len(body.History))
+	for i, val := range body.History {
+		if val == nil {
+			v.History[i] = nil
+			continue
+		}
+		v.History[i] = unmarshalOrderStatusChangeResponseBodyToBalanceOrderStatusChange(val)
+	}
+
+	return v
+}
+
+// NewGetUserOrderMissingField builds a balance service GetUserOrder endpoint
+// missing_field error.
+func NewGetUserOrderMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewGetUserOrderUserIsNotAuthenticated builds a balance service GetUserOrder
+// endpoint User is not authenticated error.
+func NewGetUserOrderUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserOrderOrderNotFound builds a balance service GetUserOrder endpoint
+// Order not found error.
+func NewGetUserOrderOrderNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserOrderInternalServiceError builds a balance service GetUserOrder
+// endpoint Internal service error error.
+func NewGetUserOrderInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewStreamUserOrderEventsOrderEventOK builds a "balance" service
+// "StreamUserOrderEvents" endpoint result from a HTTP "OK" response.
+func NewStreamUserOrderEventsOrderEventOK(body *StreamUserOrderEventsResponseBody) *balance.OrderEvent {
+	v := &balance.OrderEvent{
+		ID:      *body.ID,
+		Type:    *body.Type,
+		Number:  body.Number,
+		From:    body.From,
+		Status:  body.Status,
+		Source:  body.Source,
+		Accrual: body.Accrual,
+		At:      body.At,
+	}
+
+	return v
+}
+
+// NewStreamUserOrderEventsMissingField builds a balance service
+// StreamUserOrderEvents endpoint missing_field error.
+func NewStreamUserOrderEventsMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewStreamUserOrderEventsUserIsNotAuthenticated builds a balance service
+// StreamUserOrderEvents endpoint User is not authenticated error.
+func NewStreamUserOrderEventsUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewStreamUserOrderEventsInvalidInputParameter builds a balance service
+// StreamUserOrderEvents endpoint Invalid input parameter error.
+func NewStreamUserOrderEventsInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewStreamUserOrderEventsInternalServiceError builds a balance service
+// StreamUserOrderEvents endpoint Internal service error error.
+func NewStreamUserOrderEventsInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserBalanceUserBalanceOK builds a "balance" service "GetUserBalance"
+// endpoint result from a HTTP "OK" response.
+func NewGetUserBalanceUserBalanceOK(body *GetUserBalanceResponseBody) *balance.UserBalance {
+	v := &balance.UserBalance{
+		Current:   *body.Current,
+		Withdrawn: *body.Withdrawn,
+	}
+
+	return v
+}
+
+// NewGetUserBalanceMissingField builds a balance service GetUserBalance
+// endpoint missing_field error.
+func NewGetUserBalanceMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewGetUserBalanceUserIsNotAuthenticated builds a balance service
+// GetUserBalance endpoint User is not authenticated error.
+func NewGetUserBalanceUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetUserBalanceInternalServiceError builds a balance service
+// GetUserBalance endpoint Internal service error error.
+func NewGetUserBalanceInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewWithdrawMissingField builds a balance service Withdraw endpoint
+// missing_field error.
+func NewWithdrawMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewWithdrawUserIsNotAuthenticated builds a balance service Withdraw endpoint
+// User is not authenticated error.
+func NewWithdrawUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewWithdrawInvalidInputParameter builds a balance service Withdraw endpoint
+// Invalid input parameter error.
+func NewWithdrawInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewWithdrawInsufficientFunds builds a balance service Withdraw endpoint
+// Insufficient funds error.
+func NewWithdrawInsufficientFunds() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewWithdrawInvalidOrderNumber builds a balance service Withdraw endpoint
+// Invalid order number error.
+func NewWithdrawInvalidOrderNumber() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewWithdrawInternalServiceError builds a balance service Withdraw endpoint
+// Internal service error error.
+func NewWithdrawInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWithdrawalsResultNoContent builds a "balance" service
+// "ListWithdrawals" endpoint result from a HTTP "NoContent" response.
+func NewListWithdrawalsResultNoContent() *balance.ListWithdrawalsResult {
+	v := &balance.ListWithdrawalsResult{}
+
+	return v
+}
+
+// NewListWithdrawalsResultOK builds a "balance" service "ListWithdrawals"
+// endpoint result from a HTTP "OK" response.
+func NewListWithdrawalsResultOK(body []*Withdrawal) *balance.ListWithdrawalsResult {
+	v := make([]*balance.Withdrawal, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalWithdrawalToBalanceWithdrawal(val)
+	}
+	res := &balance.ListWithdrawalsResult{
+		Withdrawals: v,
+	}
+
+	return res
+}
+
+// NewListWithdrawalsMissingField builds a balance service ListWithdrawals
+// endpoint missing_field error.
+func NewListWithdrawalsMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewListWithdrawalsUserIsNotAuthenticated builds a balance service
+// ListWithdrawals endpoint User is not authenticated error.
+func NewListWithdrawalsUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWithdrawalsInternalServiceError builds a balance service
+// ListWithdrawals endpoint Internal service error error.
+func NewListWithdrawalsInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// ValidateGetUserOrderResponseBody runs the validations defined on
+// GetUserOrderResponseBody
+func ValidateGetUserOrderResponseBody(body *GetUserOrderResponseBody) (err error) {
+	if body.Number == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("number", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.UploadedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uploaded_at", "body"))
+	}
+	if body.History == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("history", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "NEW" || *body.Status == "PROCESSING" || *body.Status == "INVALID" || *body.Status == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if body.UploadedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uploaded_at", *body.UploadedAt, goa.FormatDateTime))
+	}
+	if body.ProcessedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.processed_at", *body.ProcessedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.History {
+		if e != nil {
+			if err2 := ValidateOrderStatusChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateStreamUserOrderEventsResponseBody runs the validations defined on
+// StreamUserOrderEventsResponseBody
+func ValidateStreamUserOrderEventsResponseBody(body *StreamUserOrderEventsResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "status" || *body.Type == "heartbeat") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"status", "heartbeat"}))
+		}
+	}
+	if body.From != nil {
+		if !(*body.From == "NEW" || *body.From == "PROCESSING" || *body.From == "INVALID" || *body.From == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.from", *body.From, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "NEW" || *body.Status == "PROCESSING" || *body.Status == "INVALID" || *body.Status == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if body.Source != nil {
+		if !(*body.Source == "upload" || *body.Source == "accrual" || *body.Source == "import" || *body.Source == "migration" || *body.Source == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"upload", "accrual", "import", "migration", "admin"}))
+		}
+	}
+	if body.At != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.at", *body.At, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetUserBalanceResponseBody runs the validations defined on
+// GetUserBalanceResponseBody
+func ValidateGetUserBalanceResponseBody(body *GetUserBalanceResponseBody) (err error) {
+	if body.Current == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("current", "body"))
+	}
+	if body.Withdrawn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withdrawn", "body"))
+	}
+	return
+}
+
+// ValidateOrderUploadResult runs the validations defined on OrderUploadResult
+func ValidateOrderUploadResult(body *OrderUploadResult) (err error) {
+	if body.Number == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("number", "body"))
+	}
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	if body.Result != nil {
+		if !(*body.Result == "accepted" || *body.Result == "duplicate" || *body.Result == "conflict" || *body.Result == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.result", *body.Result, []any{"accepted", "duplicate", "conflict", "invalid"}))
+		}
+	}
+	return
+}
+
+// ValidateOrder runs the validations defined on Order
+func ValidateOrder(body *Order) (err error) {
+	if body.Number == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("number", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.UploadedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uploaded_at", "body"))
+	}
+	if body.Number != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.number", *body.Number, "[1-9][0-9]*"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "NEW" || *body.Status == "PROCESSING" || *body.Status == "INVALID" || *body.Status == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if body.UploadedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.uploaded_at", *body.UploadedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateOrderStatusChangeResponseBody runs the validations defined on
+// OrderStatusChangeResponseBody
+func ValidateOrderStatusChangeResponseBody(body *OrderStatusChangeResponseBody) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.At == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("at", "body"))
+	}
+	if body.From != nil {
+		if !(*body.From == "NEW" || *body.From == "PROCESSING" || *body.From == "INVALID" || *body.From == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.from", *body.From, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "NEW" || *body.Status == "PROCESSING" || *body.Status == "INVALID" || *body.Status == "PROCESSED") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"NEW", "PROCESSING", "INVALID", "PROCESSED"}))
+		}
+	}
+	if body.Source != nil {
+		if !(*body.Source == "upload" || *body.Source == "accrual" || *body.Source == "import" || *body.Source == "migration" || *body.Source == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"upload", "accrual", "import", "migration", "admin"}))
+		}
+	}
+	if body.At != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.at", *body.At, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateWithdrawal runs the validations defined on Withdrawal
+func ValidateWithdrawal(body *Withdrawal) (err error) {
+	if body.Order == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("order", "body"))
+	}
+	if body.Sum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sum", "body"))
+	}
+	if body.ProcessedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("processed_at", "body"))
+	}
+	if body.ProcessedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.processed_at", *body.ProcessedAt, goa.FormatDateTime))
+	}
+	return
+}
@@ -7,12 +7,46 @@
 
 package server
 
+import (
+	"fmt"
+)
+
 // UploadUserOrderBalancePath returns the URL path to the balance service UploadUserOrder HTTP endpoint.
 func UploadUserOrderBalancePath() string {
 	return "/api/user/orders"
 }
 
+// UploadUserOrdersBalancePath returns the URL path to the balance service UploadUserOrders HTTP endpoint.
+func UploadUserOrdersBalancePath() string {
+	return "/api/user/orders/batch"
+}
+
 // ListUserOrderBalancePath returns the URL path to the balance service ListUserOrder HTTP endpoint.
 func ListUserOrderBalancePath() string {
 	return "/api/user/orders"
 }
+
+// GetUserOrderBalancePath returns the URL path to the balance service GetUserOrder HTTP endpoint.
+func GetUserOrderBalancePath(number string) string {
+	return fmt.Sprintf("/api/user/orders/%v", number)
+}
+
+// StreamUserOrderEventsBalancePath returns the URL path to the balance service StreamUserOrderEvents HTTP endpoint.
+func StreamUserOrderEventsBalancePath() string {
+	return "/api/user/orders/events"
+}
+
+// GetUserBalanceBalancePath returns the URL path to the balance service GetUserBalance HTTP endpoint.
+func GetUserBalanceBalancePath() string {
+	return "/api/user/balance"
+}
+
+// WithdrawBalancePath returns the URL path to the balance service Withdraw HTTP endpoint.
+func WithdrawBalancePath() string {
+	return "/api/user/balance/withdraw"
+}
+
+// ListWithdrawalsBalancePath returns the URL path to the balance service ListWithdrawals HTTP endpoint.
+func ListWithdrawalsBalancePath() string {
+	return "/api/user/withdrawals"
+}
@@ -18,9 +18,15 @@ import (
 
 // Server lists the balance service endpoint HTTP handlers.
 type Server struct {
-	Mounts          []*MountPoint
-	UploadUserOrder http.Handler
-	ListUserOrder   http.Handler
+	Mounts                []*MountPoint
+	UploadUserOrder       http.Handler
+	UploadUserOrders      http.Handler
+	ListUserOrder         http.Handler
+	GetUserOrder          http.Handler
+	StreamUserOrderEvents http.Handler
+	GetUserBalance        http.Handler
+	Withdraw              http.Handler
+	ListWithdrawals       http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -51,10 +57,22 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"UploadUserOrder", "POST", "/api/user/orders"},
+			{"UploadUserOrders", "POST", "/api/user/orders/batch"},
 			{"ListUserOrder", "GET", "/api/user/orders"},
+			{"GetUserOrder", "GET", "/api/user/orders/{number}"},
+			{"StreamUserOrderEvents", "GET", "/api/user/orders/events"},
+			{"GetUserBalance", "GET", "/api/user/balance"},
+			{"Withdraw", "POST", "/api/user/balance/withdraw"},
+			{"ListWithdrawals", "GET", "/api/user/withdrawals"},
 		},
-		UploadUserOrder: NewUploadUserOrderHandler(e.UploadUserOrder, mux, decoder, encoder, errhandler, formatter),
-		ListUserOrder:   NewListUserOrderHandler(e.ListUserOrder, mux, decoder, encoder, errhandler, formatter),
+		UploadUserOrder:       NewUploadUserOrderHandler(e.UploadUserOrder, mux, decoder, encoder, errhandler, formatter),
+		UploadUserOrders:      NewUploadUserOrdersHandler(e.UploadUserOrders, mux, decoder, encoder, errhandler, formatter),
+		ListUserOrder:         NewListUserOrderHandler(e.ListUserOrder, mux, decoder, encoder, errhandler, formatter),
+		GetUserOrder:          NewGetUserOrderHandler(e.GetUserOrder, mux, decoder, encoder, errhandler, formatter),
+		StreamUserOrderEvents: NewStreamUserOrderEventsHandler(e.StreamUserOrderEvents, mux, decoder, encoder, errhandler, formatter),
+		GetUserBalance:        NewGetUserBalanceHandler(e.GetUserBalance, mux, decoder, encoder, errhandler, formatter),
+		Withdraw:              NewWithdrawHandler(e.Withdraw, mux, decoder, encoder, errhandler, formatter),
+		ListWithdrawals:       NewListWithdrawalsHandler(e.ListWithdrawals, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -64,7 +82,13 @@ func (s *Server) Service() string { return "balance" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.UploadUserOrder = m(s.UploadUserOrder)
+	s.UploadUserOrders = m(s.UploadUserOrders)
 	s.ListUserOrder = m(s.ListUserOrder)
+	s.GetUserOrder = m(s.GetUserOrder)
+	s.StreamUserOrderEvents = m(s.StreamUserOrderEvents)
+	s.GetUserBalance = m(s.GetUserBalance)
+	s.Withdraw = m(s.Withdraw)
+	s.ListWithdrawals = m(s.ListWithdrawals)
 }
 
 // MethodNames returns the methods served.
@@ -73,7 +97,13 @@ func (s *Server) MethodNames() []string { return balance.MethodNames[:] }
 // Mount configures the mux to serve the balance endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountUploadUserOrderHandler(mux, h.UploadUserOrder)
+	MountUploadUserOrdersHandler(mux, h.UploadUserOrders)
 	MountListUserOrderHandler(mux, h.ListUserOrder)
+	MountGetUserOrderHandler(mux, h.GetUserOrder)
+	MountStreamUserOrderEventsHandler(mux, h.StreamUserOrderEvents)
+	MountGetUserBalanceHandler(mux, h.GetUserBalance)
+	MountWithdrawHandler(mux, h.Withdraw)
+	MountListWithdrawalsHandler(mux, h.ListWithdrawals)
 }
 
 // Mount configures the mux to serve the balance endpoints.
@@ -134,6 +164,60 @@ func NewUploadUserOrderHandler(
 	})
 }
 
+// MountUploadUserOrdersHandler configures the mux to serve the "balance"
+// service "UploadUserOrders" endpoint.
+func MountUploadUserOrdersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/user/orders/batch", f)
+}
+
+// NewUploadUserOrdersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "balance" service "UploadUserOrders" endpoint.
+func NewUploadUserOrdersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadUserOrdersRequest(mux, decoder)
+		encodeResponse = EncodeUploadUserOrdersResponse(encoder)
+		encodeError    = EncodeUploadUserOrdersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "UploadUserOrders")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &balance.UploadUserOrdersRequestData{Payload: payload, Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountListUserOrderHandler configures the mux to serve the "balance" service
 // "ListUserOrder" endpoint.
 func MountListUserOrderHandler(mux goahttp.Muxer, h http.Handler) {
@@ -186,3 +270,274 @@ func NewListUserOrderHandler(
 		}
 	})
 }
+
+// MountGetUserOrderHandler configures the mux to serve the "balance" service
+// "GetUserOrder" endpoint.
+func MountGetUserOrderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/user/orders/{number}", f)
+}
+
+// NewGetUserOrderHandler creates a HTTP handler which loads the HTTP request
+// and calls the "balance" service "GetUserOrder" endpoint.
+func NewGetUserOrderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetUserOrderRequest(mux, decoder)
+		encodeResponse = EncodeGetUserOrderResponse(encoder)
+		encodeError    = EncodeGetUserOrderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetUserOrder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountStreamUserOrderEventsHandler configures the mux to serve the "balance"
+// service "StreamUserOrderEvents" endpoint.
+func MountStreamUserOrderEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/user/orders/events", f)
+}
+
+// NewStreamUserOrderEventsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "balance" service "StreamUserOrderEvents" endpoint.
+func NewStreamUserOrderEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest = DecodeStreamUserOrderEventsRequest(mux, decoder)
+		encodeError   = EncodeStreamUserOrderEventsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "StreamUserOrderEvents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		// Set Last-Event-ID header if present
+		if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+			ctx = context.WithValue(ctx, "last-event-id", lastEventID)
+			payload.LastEventID = &lastEventID
+		}
+		v := &balance.StreamUserOrderEventsEndpointInput{
+			Stream: &StreamUserOrderEventsServerStream{
+				w: w,
+				r: r,
+			},
+			Payload: payload,
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountGetUserBalanceHandler configures the mux to serve the "balance" service
+// "GetUserBalance" endpoint.
+func MountGetUserBalanceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/user/balance", f)
+}
+
+// NewGetUserBalanceHandler creates a HTTP handler which loads the HTTP request
+// and calls the "balance" service "GetUserBalance" endpoint.
+func NewGetUserBalanceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetUserBalanceRequest(mux, decoder)
+		encodeResponse = EncodeGetUserBalanceResponse(encoder)
+		encodeError    = EncodeGetUserBalanceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetUserBalance")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountWithdrawHandler configures the mux to serve the "balance" service
+// "Withdraw" endpoint.
+func MountWithdrawHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/user/balance/withdraw", f)
+}
+
+// NewWithdrawHandler creates a HTTP handler which loads the HTTP request and
+// calls the "balance" service "Withdraw" endpoint.
+func NewWithdrawHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeWithdrawRequest(mux, decoder)
+		encodeResponse = EncodeWithdrawResponse(encoder)
+		encodeError    = EncodeWithdrawError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Withdraw")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListWithdrawalsHandler configures the mux to serve the "balance"
+// service "ListWithdrawals" endpoint.
+func MountListWithdrawalsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/user/withdrawals", f)
+}
+
+// NewListWithdrawalsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "balance" service "ListWithdrawals" endpoint.
+func NewListWithdrawalsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWithdrawalsRequest(mux, decoder)
+		encodeResponse = EncodeListWithdrawalsResponse(encoder)
+		encodeError    = EncodeListWithdrawalsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListWithdrawals")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -9,18 +9,122 @@ package server
 
 import (
 	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// WithdrawRequestBody is the type of the "balance" service "Withdraw" endpoint
+// HTTP request body.
+type WithdrawRequestBody struct {
+	// The number of the order paid by the points
+	Order *string `form:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Sum *money.Amount `form:"sum,omitempty" json:"sum,omitempty" xml:"sum,omitempty"`
+}
+
+// UploadUserOrdersResponseBody is the type of the "balance" service
+// "UploadUserOrders" endpoint HTTP response body.
+type UploadUserOrdersResponseBody []*OrderUploadResult
+
 // ListUserOrderResponseBody is the type of the "balance" service
 // "ListUserOrder" endpoint HTTP response body.
 type ListUserOrderResponseBody []*Order
 
+// GetUserOrderResponseBody is the type of the "balance" service "GetUserOrder"
+// endpoint HTTP response body.
+type GetUserOrderResponseBody struct {
+	Number string `form:"number" json:"number" xml:"number"`
+	Status string `form:"status" json:"status" xml:"status"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	UploadedAt string        `form:"uploaded_at" json:"uploaded_at" xml:"uploaded_at"`
+	// The time the order has reached a final status
+	ProcessedAt *string `form:"processed_at,omitempty" json:"processed_at,omitempty" xml:"processed_at,omitempty"`
+	// The transitions of the order status in their order
+	History []*OrderStatusChangeResponseBody `form:"history" json:"history" xml:"history"`
+}
+
+// StreamUserOrderEventsResponseBody is the type of the "balance" service
+// "StreamUserOrderEvents" endpoint HTTP response body.
+type StreamUserOrderEventsResponseBody struct {
+	// The ID to resume the stream after the event. It's empty for the heartbeats
+	ID   string `json:"-"`
+	Type string `json:"-"`
+	// The order number
+	Number *string `form:"number,omitempty" json:"number,omitempty" xml:"number,omitempty"`
+	// The previous status. It's absent for the first status of the order
+	From   *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	At      *string       `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// GetUserBalanceResponseBody is the type of the "balance" service
+// "GetUserBalance" endpoint HTTP response body.
+type GetUserBalanceResponseBody struct {
+	// The amount of the points with at most 2 fractional digits
+	Current money.Amount `form:"current" json:"current" xml:"current"`
+	// The amount of the points with at most 2 fractional digits
+	Withdrawn money.Amount `form:"withdrawn" json:"withdrawn" xml:"withdrawn"`
+}
+
+// ListWithdrawalsResponseBody is the type of the "balance" service
+// "ListWithdrawals" endpoint HTTP response body.
+type ListWithdrawalsResponseBody []*Withdrawal
+
+// OrderUploadResult is used to define fields on response body types.
+type OrderUploadResult struct {
+	Number string `form:"number" json:"number" xml:"number"`
+	// accepted: stored for processing, duplicate: uploaded by the user before,
+	// conflict: uploaded by another user, invalid: not a valid order number
+	Result string `form:"result" json:"result" xml:"result"`
+}
+
 // Order is used to define fields on response body types.
 type Order struct {
-	Number     string `form:"number" json:"number" xml:"number"`
-	Status     string `form:"status" json:"status" xml:"status"`
-	Accrual    *uint  `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
-	UploadedAt string `form:"uploaded_at" json:"uploaded_at" xml:"uploaded_at"`
+	Number string `form:"number" json:"number" xml:"number"`
+	Status string `form:"status" json:"status" xml:"status"`
+	// The amount of the points with at most 2 fractional digits
+	Accrual    *money.Amount `form:"accrual,omitempty" json:"accrual,omitempty" xml:"accrual,omitempty"`
+	UploadedAt string        `form:"uploaded_at" json:"uploaded_at" xml:"uploaded_at"`
+}
+
+// OrderStatusChangeResponseBody is used to define fields on response body
+// types.
+type OrderStatusChangeResponseBody struct {
+	// The previous status. It's absent for the first status of the order
+	From   *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	Status string  `form:"status" json:"status" xml:"status"`
+	// upload: the user uploaded the order, accrual: the accrual system processed
+	// the order, import: the order was imported in bulk, migration: the status was
+	// recorded before the history was kept, admin: the admin reprocessed the order
+	Source string `form:"source" json:"source" xml:"source"`
+	At     string `form:"at" json:"at" xml:"at"`
+}
+
+// Withdrawal is used to define fields on response body types.
+type Withdrawal struct {
+	// The number of the order paid by the points
+	Order string `form:"order" json:"order" xml:"order"`
+	// The amount of the points with at most 2 fractional digits
+	Sum         money.Amount `form:"sum" json:"sum" xml:"sum"`
+	ProcessedAt string       `form:"processed_at" json:"processed_at" xml:"processed_at"`
+}
+
+// NewUploadUserOrdersResponseBody builds the HTTP response body from the
+// result of the "UploadUserOrders" endpoint of the "balance" service.
+func NewUploadUserOrdersResponseBody(res *balance.UploadUserOrdersResult) UploadUserOrdersResponseBody {
+	body := make([]*OrderUploadResult, len(res.Orders))
+	for i, val := range res.Orders {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalBalanceOrderUploadResultToOrderUploadResult(val)
+	}
+	return body
 }
 
 // NewListUserOrderResponseBody builds the HTTP response body from the result
@@ -37,6 +141,71 @@ func NewListUserOrderResponseBody(res *balance.ListUserOrderResult) ListUserOrde
 	return body
 }
 
+// NewGetUserOrderResponseBody builds the HTTP response body from the result of
+// the "GetUserOrder" endpoint of the "balance" service.
+func NewGetUserOrderResponseBody(res *balance.OrderTimeline) *GetUserOrderResponseBody {
+	body := &GetUserOrderResponseBody{
+		Number:      res.Number,
+		Status:      res.Status,
+		Accrual:     res.Accrual,
+		UploadedAt:  res.UploadedAt,
+		ProcessedAt: res.ProcessedAt,
+	}
+	if res.History != nil {
+		body.History = make([]*OrderStatusChangeResponseBody, len(res.History))
+		for i, val := range res.History {
+			if val == nil {
+				body.History[i] = nil
+				continue
+			}
+			body.History[i] = marshalBalanceOrderStatusChangeToOrderStatusChangeResponseBody(val)
+		}
+	} else {
+		body.History = []*OrderStatusChangeResponseBody{}
+	}
+	return body
+}
+
+// NewStreamUserOrderEventsResponseBody builds the HTTP response body from the
+// result of the "StreamUserOrderEvents" endpoint of the "balance" service.
+func NewStreamUserOrderEventsResponseBody(res *balance.OrderEvent) *StreamUserOrderEventsResponseBody {
+	body := &StreamUserOrderEventsResponseBody{
+		ID:      res.ID,
+		Type:    res.Type,
+		Number:  res.Number,
+		From:    res.From,
+		Status:  res.Status,
+		Source:  res.Source,
+		Accrual: res.Accrual,
+		At:      res.At,
+	}
+	return body
+}
+
+// NewGetUserBalanceResponseBody builds the HTTP response body from the result
+// of the "GetUserBalance" endpoint of the "balance" service.
+func NewGetUserBalanceResponseBody(res *balance.UserBalance) *GetUserBalanceResponseBody {
+	body := &GetUserBalanceResponseBody{
+		Current:   res.Current,
+		Withdrawn: res.Withdrawn,
+	}
+	return body
+}
+
+// NewListWithdrawalsResponseBody builds the HTTP response body from the result
+// of the "ListWithdrawals" endpoint of the "balance" service.
+func NewListWithdrawalsResponseBody(res *balance.ListWithdrawalsResult) ListWithdrawalsResponseBody {
+	body := make([]*Withdrawal, len(res.Withdrawals))
+	for i, val := range res.Withdrawals {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalBalanceWithdrawalToWithdrawal(val)
+	}
+	return body
+}
+
 // NewUploadUserOrderPayload builds a balance service UploadUserOrder endpoint
 // payload.
 func NewUploadUserOrderPayload(body string, authorization string) *balance.UploadUserOrderPayload {
@@ -49,11 +218,91 @@ func NewUploadUserOrderPayload(body string, authorization string) *balance.Uploa
 	return res
 }
 
+// NewUploadUserOrdersPayload builds a balance service UploadUserOrders
+// endpoint payload.
+func NewUploadUserOrdersPayload(authorization string, contentType *string) *balance.UploadUserOrdersPayload {
+	v := &balance.UploadUserOrdersPayload{}
+	v.Authorization = authorization
+	v.ContentType = contentType
+
+	return v
+}
+
 // NewListUserOrderPayload builds a balance service ListUserOrder endpoint
 // payload.
-func NewListUserOrderPayload(authorization string) *balance.ListUserOrderPayload {
+func NewListUserOrderPayload(limit *int, cursor *string, status []string, from *string, to *string, sort *string, authorization string) *balance.ListUserOrderPayload {
 	v := &balance.ListUserOrderPayload{}
+	v.Limit = limit
+	v.Cursor = cursor
+	v.Status = status
+	v.From = from
+	v.To = to
+	v.Sort = sort
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewGetUserOrderPayload builds a balance service GetUserOrder endpoint
+// payload.
+func NewGetUserOrderPayload(number string, authorization string) *balance.GetUserOrderPayload {
+	v := &balance.GetUserOrderPayload{}
+	v.Number = number
 	v.Authorization = authorization
 
 	return v
 }
+
+// NewStreamUserOrderEventsPayload builds a balance service
+// StreamUserOrderEvents endpoint payload.
+func NewStreamUserOrderEventsPayload(authorization string, lastEventID *string) *balance.StreamUserOrderEventsPayload {
+	v := &balance.StreamUserOrderEventsPayload{}
+	v.Authorization = authorization
+	v.LastEventID = lastEventID
+
+	return v
+}
+
+// NewGetUserBalancePayload builds a balance service GetUserBalance endpoint
+// payload.
+func NewGetUserBalancePayload(authorization string) *balance.GetUserBalancePayload {
+	v := &balance.GetUserBalancePayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewWithdrawPayload builds a balance service Withdraw endpoint payload.
+func NewWithdrawPayload(body *WithdrawRequestBody, authorization string) *balance.WithdrawPayload {
+	v := &balance.WithdrawPayload{
+		Order: *body.Order,
+		Sum:   *body.Sum,
+	}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewListWithdrawalsPayload builds a balance service ListWithdrawals endpoint
+// payload.
+func NewListWithdrawalsPayload(authorization string) *balance.ListWithdrawalsPayload {
+	v := &balance.ListWithdrawalsPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// ValidateWithdrawRequestBody runs the validations defined on
+// WithdrawRequestBody
+func ValidateWithdrawRequestBody(body *WithdrawRequestBody) (err error) {
+	if body.Order == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("order", "body"))
+	}
+	if body.Sum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sum", "body"))
+	}
+	if body.Order != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.order", *body.Order, "[1-9][0-9]*"))
+	}
+	return
+}
@@ -0,0 +1,53 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+)
+
+// BuildRegisterPayload builds the payload for the user register endpoint from
+// CLI flags.
+func BuildRegisterPayload(userRegisterBody string) (*user.LoginPassword, error) {
+	var err error
+	var body RegisterRequestBody
+	{
+		err = json.Unmarshal([]byte(userRegisterBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"login\": \"\\u003clogin\\u003e\",\n      \"password\": \"\\u003cpassword\\u003e\"\n   }'")
+		}
+	}
+	v := &user.LoginPassword{
+		Login:    body.Login,
+		Password: body.Password,
+	}
+
+	return v, nil
+}
+
+// BuildLoginPayload builds the payload for the user login endpoint from CLI
+// flags.
+func BuildLoginPayload(userLoginBody string) (*user.LoginPassword, error) {
+	var err error
+	var body LoginRequestBody
+	{
+		err = json.Unmarshal([]byte(userLoginBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"login\": \"\\u003clogin\\u003e\",\n      \"password\": \"\\u003cpassword\\u003e\"\n   }'")
+		}
+	}
+	v := &user.LoginPassword{
+		Login:    body.Login,
+		Password: body.Password,
+	}
+
+	return v, nil
+}
@@ -0,0 +1,103 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user client HTTP transport
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the user service endpoint HTTP clients.
+type Client struct {
+	// Register Doer is the HTTP client used to make requests to the register
+	// endpoint.
+	RegisterDoer goahttp.Doer
+
+	// Login Doer is the HTTP client used to make requests to the login endpoint.
+	LoginDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the user service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		RegisterDoer:        doer,
+		LoginDoer:           doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Register returns an endpoint that makes HTTP requests to the user service
+// register server.
+func (c *Client) Register() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRegisterRequest(c.encoder)
+		decodeResponse = DecodeRegisterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRegisterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RegisterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "register", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Login returns an endpoint that makes HTTP requests to the user service login
+// server.
+func (c *Client) Login() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeLoginRequest(c.encoder)
+		decodeResponse = DecodeLoginResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLoginRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LoginDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "login", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,184 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildRegisterRequest instantiates a HTTP request object with method and path
+// set to call the "user" service "register" endpoint
+func (c *Client) BuildRegisterRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RegisterUserPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "register", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRegisterRequest returns an encoder for requests sent to the user
+// register server.
+func EncodeRegisterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.LoginPassword)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "register", "*user.LoginPassword", v)
+		}
+		body := NewRegisterRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "register", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRegisterResponse returns a decoder for responses returned by the user
+// register endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeRegisterResponse may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Login is taken already" (type *service.GophermartError): http.StatusConflict
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRegisterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				authToken string
+				err       error
+			)
+			authTokenRaw := resp.Header.Get("Authorization")
+			if authTokenRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("authToken", "header"))
+			}
+			authToken = authTokenRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "register", err)
+			}
+			res := NewRegisterJWTTokenOK(authToken)
+			return res, nil
+		case http.StatusBadRequest:
+			return nil, NewRegisterInvalidInputParameter()
+		case http.StatusConflict:
+			return nil, NewRegisterLoginIsTakenAlready()
+		case http.StatusInternalServerError:
+			return nil, NewRegisterInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "register", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLoginRequest instantiates a HTTP request object with method and path
+// set to call the "user" service "login" endpoint
+func (c *Client) BuildLoginRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LoginUserPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "login", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeLoginRequest returns an encoder for requests sent to the user login
+// server.
+func EncodeLoginRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.LoginPassword)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "login", "*user.LoginPassword", v)
+		}
+		body := NewLoginRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "login", err)
+		}
+		return nil
+	}
+}
+
+// DecodeLoginResponse returns a decoder for responses returned by the user
+// login endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeLoginResponse may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeLoginResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				authToken string
+				err       error
+			)
+			authTokenRaw := resp.Header.Get("Authorization")
+			if authTokenRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("authToken", "header"))
+			}
+			authToken = authTokenRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "login", err)
+			}
+			res := NewLoginJWTTokenOK(authToken)
+			return res, nil
+		case http.StatusBadRequest:
+			return nil, NewLoginInvalidInputParameter()
+		case http.StatusUnauthorized:
+			return nil, NewLoginUserIsNotAuthenticated()
+		case http.StatusInternalServerError:
+			return nil, NewLoginInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "login", resp.StatusCode, string(body))
+		}
+	}
+}
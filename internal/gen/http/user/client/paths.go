@@ -0,0 +1,18 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// HTTP request path constructors for the user service.
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+// RegisterUserPath returns the URL path to the user service register HTTP endpoint.
+func RegisterUserPath() string {
+	return "/api/user/register"
+}
+
+// LoginUserPath returns the URL path to the user service login HTTP endpoint.
+func LoginUserPath() string {
+	return "/api/user/login"
+}
@@ -0,0 +1,113 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// user HTTP client types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+)
+
+// RegisterRequestBody is the type of the "user" service "register" endpoint
+// HTTP request body.
+type RegisterRequestBody struct {
+	Login    string `form:"login" json:"login" xml:"login"`
+	Password string `form:"password" json:"password" xml:"password"`
+}
+
+// LoginRequestBody is the type of the "user" service "login" endpoint HTTP
+// request body.
+type LoginRequestBody struct {
+	Login    string `form:"login" json:"login" xml:"login"`
+	Password string `form:"password" json:"password" xml:"password"`
+}
+
+// NewRegisterRequestBody builds the HTTP request body from the payload of the
+// "register" endpoint of the "user" service.
+func NewRegisterRequestBody(p *user.LoginPassword) *RegisterRequestBody {
+	body := &RegisterRequestBody{
+		Login:    p.Login,
+		Password: p.Password,
+	}
+	return body
+}
+
+// NewLoginRequestBody builds the HTTP request body from the payload of the
+// "login" endpoint of the "user" service.
+func NewLoginRequestBody(p *user.LoginPassword) *LoginRequestBody {
+	body := &LoginRequestBody{
+		Login:    p.Login,
+		Password: p.Password,
+	}
+	return body
+}
+
+// NewRegisterJWTTokenOK builds a "user" service "register" endpoint result
+// from a HTTP "OK" response.
+func NewRegisterJWTTokenOK(authToken string) *service.JWTToken {
+	v := &service.JWTToken{}
+	v.AuthToken = authToken
+
+	return v
+}
+
+// NewRegisterInvalidInputParameter builds a user service register endpoint
+// Invalid input parameter error.
+func NewRegisterInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewRegisterLoginIsTakenAlready builds a user service register endpoint Login
+// is taken already error.
+func NewRegisterLoginIsTakenAlready() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewRegisterInternalServiceError builds a user service register endpoint
+// Internal service error error.
+func NewRegisterInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewLoginJWTTokenOK builds a "user" service "login" endpoint result from a
+// HTTP "OK" response.
+func NewLoginJWTTokenOK(authToken string) *service.JWTToken {
+	v := &service.JWTToken{}
+	v.AuthToken = authToken
+
+	return v
+}
+
+// NewLoginInvalidInputParameter builds a user service login endpoint Invalid
+// input parameter error.
+func NewLoginInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewLoginUserIsNotAuthenticated builds a user service login endpoint User is
+// not authenticated error.
+func NewLoginUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewLoginInternalServiceError builds a user service login endpoint Internal
+// service error error.
+func NewLoginInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
@@ -0,0 +1,276 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	webhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateWebhookPayload builds the payload for the webhook CreateWebhook
+// endpoint from CLI flags.
+func BuildCreateWebhookPayload(webhookCreateWebhookBody string, webhookCreateWebhookAuthorization string) (*webhook.CreateWebhookPayload, error) {
+	var err error
+	var body CreateWebhookRequestBody
+	{
+		err = json.Unmarshal([]byte(webhookCreateWebhookBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"active\": true,\n      \"statuses\": [\n         \"PROCESSED\",\n         \"PROCESSED\",\n         \"INVALID\"\n      ],\n      \"url\": \"https://partner.example/gophermart/events\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", body.URL, goa.FormatURI))
+		if utf8.RuneCountInString(body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", body.URL, utf8.RuneCountInString(body.URL), 2048, false))
+		}
+		for _, e := range body.Statuses {
+			if !(e == "PROCESSED" || e == "INVALID") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = webhookCreateWebhookAuthorization
+	}
+	v := &webhook.CreateWebhookPayload{
+		URL:    body.URL,
+		Active: body.Active,
+	}
+	if body.Statuses != nil {
+		v.Statuses = make([]string, len(body.Statuses))
+		for i, val := range body.Statuses {
+			v.Statuses[i] = val
+		}
+	}
+	if body.Statuses == nil {
+		v.Statuses = []string{"PROCESSED", "INVALID"}
+	}
+	{
+		var zero bool
+		if v.Active == zero {
+			v.Active = true
+		}
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListWebhooksPayload builds the payload for the webhook ListWebhooks
+// endpoint from CLI flags.
+func BuildListWebhooksPayload(webhookListWebhooksAuthorization string) (*webhook.ListWebhooksPayload, error) {
+	var authorization string
+	{
+		authorization = webhookListWebhooksAuthorization
+	}
+	v := &webhook.ListWebhooksPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetWebhookPayload builds the payload for the webhook GetWebhook
+// endpoint from CLI flags.
+func BuildGetWebhookPayload(webhookGetWebhookID string, webhookGetWebhookAuthorization string) (*webhook.GetWebhookPayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookGetWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = webhookGetWebhookAuthorization
+	}
+	v := &webhook.GetWebhookPayload{}
+	v.ID = id
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildUpdateWebhookPayload builds the payload for the webhook UpdateWebhook
+// endpoint from CLI flags.
+func BuildUpdateWebhookPayload(webhookUpdateWebhookBody string, webhookUpdateWebhookID string, webhookUpdateWebhookAuthorization string) (*webhook.UpdateWebhookPayload, error) {
+	var err error
+	var body UpdateWebhookRequestBody
+	{
+		err = json.Unmarshal([]byte(webhookUpdateWebhookBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"active\": false,\n      \"statuses\": [\n         \"INVALID\",\n         \"PROCESSED\",\n         \"INVALID\",\n         \"INVALID\"\n      ],\n      \"url\": \"https://partner.example/gophermart/events\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", body.URL, goa.FormatURI))
+		if utf8.RuneCountInString(body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", body.URL, utf8.RuneCountInString(body.URL), 2048, false))
+		}
+		for _, e := range body.Statuses {
+			if !(e == "PROCESSED" || e == "INVALID") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = webhookUpdateWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = webhookUpdateWebhookAuthorization
+	}
+	v := &webhook.UpdateWebhookPayload{
+		URL:    body.URL,
+		Active: body.Active,
+	}
+	if body.Statuses != nil {
+		v.Statuses = make([]string, len(body.Statuses))
+		for i, val := range body.Statuses {
+			v.Statuses[i] = val
+		}
+	}
+	if body.Statuses == nil {
+		v.Statuses = []string{"PROCESSED", "INVALID"}
+	}
+	{
+		var zero bool
+		if v.Active == zero {
+			v.Active = true
+		}
+	}
+	v.ID = id
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildDeleteWebhookPayload builds the payload for the webhook DeleteWebhook
+// endpoint from CLI flags.
+func BuildDeleteWebhookPayload(webhookDeleteWebhookID string, webhookDeleteWebhookAuthorization string) (*webhook.DeleteWebhookPayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookDeleteWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = webhookDeleteWebhookAuthorization
+	}
+	v := &webhook.DeleteWebhookPayload{}
+	v.ID = id
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildListWebhookDeliveriesPayload builds the payload for the webhook
+// ListWebhookDeliveries endpoint from CLI flags.
+func BuildListWebhookDeliveriesPayload(webhookListWebhookDeliveriesID string, webhookListWebhookDeliveriesState string, webhookListWebhookDeliveriesLimit string, webhookListWebhookDeliveriesAuthorization string) (*webhook.ListWebhookDeliveriesPayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookListWebhookDeliveriesID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var state *string
+	{
+		if webhookListWebhookDeliveriesState != "" {
+			state = &webhookListWebhookDeliveriesState
+			if !(*state == "pending" || *state == "delivered" || *state == "dead") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("state", *state, []any{"pending", "delivered", "dead"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if webhookListWebhookDeliveriesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(webhookListWebhookDeliveriesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = webhookListWebhookDeliveriesAuthorization
+	}
+	v := &webhook.ListWebhookDeliveriesPayload{}
+	v.ID = id
+	v.State = state
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildRedeliverWebhookPayload builds the payload for the webhook
+// RedeliverWebhook endpoint from CLI flags.
+func BuildRedeliverWebhookPayload(webhookRedeliverWebhookID string, webhookRedeliverWebhookDeliveryID string, webhookRedeliverWebhookAuthorization string) (*webhook.RedeliverWebhookPayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookRedeliverWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var deliveryID int64
+	{
+		deliveryID, err = strconv.ParseInt(webhookRedeliverWebhookDeliveryID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for deliveryID, must be INT64")
+		}
+	}
+	var authorization string
+	{
+		authorization = webhookRedeliverWebhookAuthorization
+	}
+	v := &webhook.RedeliverWebhookPayload{}
+	v.ID = id
+	v.DeliveryID = deliveryID
+	v.Authorization = authorization
+
+	return v, nil
+}
@@ -0,0 +1,249 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook client HTTP transport
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the webhook service endpoint HTTP clients.
+type Client struct {
+	// CreateWebhook Doer is the HTTP client used to make requests to the
+	// CreateWebhook endpoint.
+	CreateWebhookDoer goahttp.Doer
+
+	// ListWebhooks Doer is the HTTP client used to make requests to the
+	// ListWebhooks endpoint.
+	ListWebhooksDoer goahttp.Doer
+
+	// GetWebhook Doer is the HTTP client used to make requests to the GetWebhook
+	// endpoint.
+	GetWebhookDoer goahttp.Doer
+
+	// UpdateWebhook Doer is the HTTP client used to make requests to the
+	// UpdateWebhook endpoint.
+	UpdateWebhookDoer goahttp.Doer
+
+	// DeleteWebhook Doer is the HTTP client used to make requests to the
+	// DeleteWebhook endpoint.
+	DeleteWebhookDoer goahttp.Doer
+
+	// ListWebhookDeliveries Doer is the HTTP client used to make requests to the
+	// ListWebhookDeliveries endpoint.
+	ListWebhookDeliveriesDoer goahttp.Doer
+
+	// RedeliverWebhook Doer is the HTTP client used to make requests to the
+	// RedeliverWebhook endpoint.
+	RedeliverWebhookDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the webhook service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		CreateWebhookDoer:         doer,
+		ListWebhooksDoer:          doer,
+		GetWebhookDoer:            doer,
+		UpdateWebhookDoer:         doer,
+		DeleteWebhookDoer:         doer,
+		ListWebhookDeliveriesDoer: doer,
+		RedeliverWebhookDoer:      doer,
+		RestoreResponseBody:       restoreBody,
+		scheme:                    scheme,
+		host:                      host,
+		decoder:                   dec,
+		encoder:                   enc,
+	}
+}
+
+// CreateWebhook returns an endpoint that makes HTTP requests to the webhook
+// service CreateWebhook server.
+func (c *Client) CreateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWebhookRequest(c.encoder)
+		decodeResponse = DecodeCreateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "CreateWebhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhooks returns an endpoint that makes HTTP requests to the webhook
+// service ListWebhooks server.
+func (c *Client) ListWebhooks() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhooksRequest(c.encoder)
+		decodeResponse = DecodeListWebhooksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhooksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhooksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "ListWebhooks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetWebhook returns an endpoint that makes HTTP requests to the webhook
+// service GetWebhook server.
+func (c *Client) GetWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetWebhookRequest(c.encoder)
+		decodeResponse = DecodeGetWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "GetWebhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateWebhook returns an endpoint that makes HTTP requests to the webhook
+// service UpdateWebhook server.
+func (c *Client) UpdateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateWebhookRequest(c.encoder)
+		decodeResponse = DecodeUpdateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "UpdateWebhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteWebhook returns an endpoint that makes HTTP requests to the webhook
+// service DeleteWebhook server.
+func (c *Client) DeleteWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteWebhookRequest(c.encoder)
+		decodeResponse = DecodeDeleteWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "DeleteWebhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhookDeliveries returns an endpoint that makes HTTP requests to the
+// webhook service ListWebhookDeliveries server.
+func (c *Client) ListWebhookDeliveries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhookDeliveriesRequest(c.encoder)
+		decodeResponse = DecodeListWebhookDeliveriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhookDeliveriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhookDeliveriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "ListWebhookDeliveries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RedeliverWebhook returns an endpoint that makes HTTP requests to the webhook
+// service RedeliverWebhook server.
+func (c *Client) RedeliverWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRedeliverWebhookRequest(c.encoder)
+		decodeResponse = DecodeRedeliverWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRedeliverWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RedeliverWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhook", "RedeliverWebhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,1226 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	webhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "CreateWebhook" endpoint
+func (c *Client) BuildCreateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWebhookWebhookPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "CreateWebhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWebhookRequest returns an encoder for requests sent to the
+// webhook CreateWebhook server.
+func EncodeCreateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.CreateWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "CreateWebhook", "*webhook.CreateWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("webhook", "CreateWebhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWebhookResponse returns a decoder for responses returned by the
+// webhook CreateWebhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateWebhookResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Webhook not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "CreateWebhook", err)
+			}
+			err = ValidateCreateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "CreateWebhook", err)
+			}
+			res := NewCreateWebhookWebhookCreated(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "CreateWebhook", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "CreateWebhook", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "CreateWebhook", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhook", "CreateWebhook", err)
+				}
+				return nil, NewCreateWebhookMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewCreateWebhookUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhook", "CreateWebhook", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewCreateWebhookInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewCreateWebhookWebhookNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewCreateWebhookInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "CreateWebhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhooksRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "ListWebhooks" endpoint
+func (c *Client) BuildListWebhooksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhooksWebhookPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "ListWebhooks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhooksRequest returns an encoder for requests sent to the
+// webhook ListWebhooks server.
+func EncodeListWebhooksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.ListWebhooksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "ListWebhooks", "*webhook.ListWebhooksPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListWebhooksResponse returns a decoder for responses returned by the
+// webhook ListWebhooks endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListWebhooksResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Webhook not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListWebhooksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhooksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "ListWebhooks", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "ListWebhooks", err)
+			}
+			res := NewListWebhooksWebhookOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "ListWebhooks", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "ListWebhooks", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "ListWebhooks", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhook", "ListWebhooks", err)
+				}
+				return nil, NewListWebhooksMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewListWebhooksUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhook", "ListWebhooks", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewListWebhooksInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewListWebhooksWebhookNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewListWebhooksInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "ListWebhooks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "GetWebhook" endpoint
+func (c *Client) BuildGetWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*webhook.GetWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "GetWebhook", "*webhook.GetWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWebhookWebhookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "GetWebhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetWebhookRequest returns an encoder for requests sent to the webhook
+// GetWebhook server.
+func EncodeGetWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.GetWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "GetWebhook", "*webhook.GetWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetWebhookResponse returns a decoder for responses returned by the
+// webhook GetWebhook endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetWebhookResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Webhook not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "GetWebhook", err)
+			}
+			err = ValidateGetWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "GetWebhook", err)
+			}
+			res := NewGetWebhookWebhookOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "GetWebhook", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "GetWebhook", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "GetWebhook", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhook", "GetWebhook", err)
+				}
+				return nil, NewGetWebhookMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewGetWebhookUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhook", "GetWebhook", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewGetWebhookInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewGetWebhookWebhookNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewGetWebhookInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "GetWebhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "UpdateWebhook" endpoint
+func (c *Client) BuildUpdateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*webhook.UpdateWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "UpdateWebhook", "*webhook.UpdateWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateWebhookWebhookPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "UpdateWebhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateWebhookRequest returns an encoder for requests sent to the
+// webhook UpdateWebhook server.
+func EncodeUpdateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.UpdateWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "UpdateWebhook", "*webhook.UpdateWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("webhook", "UpdateWebhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateWebhookResponse returns a decoder for responses returned by the
+// webhook UpdateWebhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUpdateWebhookResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Webhook not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUpdateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "UpdateWebhook", err)
+			}
+			err = ValidateUpdateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "UpdateWebhook", err)
+			}
+			res := NewUpdateWebhookWebhookOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "UpdateWebhook", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "UpdateWebhook", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "UpdateWebhook", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhook", "UpdateWebhook", err)
+				}
+				return nil, NewUpdateWebhookMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewUpdateWebhookUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhook", "UpdateWebhook", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewUpdateWebhookInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewUpdateWebhookWebhookNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewUpdateWebhookInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "UpdateWebhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "DeleteWebhook" endpoint
+func (c *Client) BuildDeleteWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*webhook.DeleteWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "DeleteWebhook", "*webhook.DeleteWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookWebhookPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "DeleteWebhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteWebhookRequest returns an encoder for requests sent to the
+// webhook DeleteWebhook server.
+func EncodeDeleteWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.DeleteWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "DeleteWebhook", "*webhook.DeleteWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteWebhookResponse returns a decoder for responses returned by the
+// webhook DeleteWebhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeleteWebhookResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Webhook not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "DeleteWebhook", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "DeleteWebhook", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "DeleteWebhook", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhook", "DeleteWebhook", err)
+				}
+				return nil, NewDeleteWebhookMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewDeleteWebhookUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhook", "DeleteWebhook", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewDeleteWebhookInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewDeleteWebhookWebhookNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewDeleteWebhookInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "DeleteWebhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhookDeliveriesRequest instantiates a HTTP request object with
+// method and path set to call the "webhook" service "ListWebhookDeliveries"
+// endpoint
+func (c *Client) BuildListWebhookDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*webhook.ListWebhookDeliveriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "ListWebhookDeliveries", "*webhook.ListWebhookDeliveriesPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookDeliveriesWebhookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "ListWebhookDeliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhookDeliveriesRequest returns an encoder for requests sent to
+// the webhook ListWebhookDeliveries server.
+func EncodeListWebhookDeliveriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.ListWebhookDeliveriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "ListWebhookDeliveries", "*webhook.ListWebhookDeliveriesPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.State != nil {
+			values.Add("state", *p.State)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListWebhookDeliveriesResponse returns a decoder for responses returned
+// by the webhook ListWebhookDeliveries endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListWebhookDeliveriesResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Webhook not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListWebhookDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhookDeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "ListWebhookDeliveries", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookDeliveryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "ListWebhookDeliveries", err)
+			}
+			res := NewListWebhookDeliveriesWebhookDeliveryOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "ListWebhookDeliveries", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "ListWebhookDeliveries", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "ListWebhookDeliveries", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhook", "ListWebhookDeliveries", err)
+				}
+				return nil, NewListWebhookDeliveriesMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewListWebhookDeliveriesUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhook", "ListWebhookDeliveries", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewListWebhookDeliveriesInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewListWebhookDeliveriesWebhookNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewListWebhookDeliveriesInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "ListWebhookDeliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRedeliverWebhookRequest instantiates a HTTP request object with method
+// and path set to call the "webhook" service "RedeliverWebhook" endpoint
+func (c *Client) BuildRedeliverWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id         string
+		deliveryID int64
+	)
+	{
+		p, ok := v.(*webhook.RedeliverWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "RedeliverWebhook", "*webhook.RedeliverWebhookPayload", v)
+		}
+		id = p.ID
+		deliveryID = p.DeliveryID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RedeliverWebhookWebhookPath(id, deliveryID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "RedeliverWebhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRedeliverWebhookRequest returns an encoder for requests sent to the
+// webhook RedeliverWebhook server.
+func EncodeRedeliverWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.RedeliverWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "RedeliverWebhook", "*webhook.RedeliverWebhookPayload", v)
+		}
+		{
+			head := p.Authorization
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRedeliverWebhookResponse returns a decoder for responses returned by
+// the webhook RedeliverWebhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRedeliverWebhookResponse may return the following errors:
+//   - "missing_field" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "User is not authenticated" (type *service.GophermartError): http.StatusUnauthorized
+//   - "Invalid input parameter" (type *service.GophermartError): http.StatusBadRequest
+//   - "Webhook not found" (type *service.GophermartError): http.StatusNotFound
+//   - "Internal service error" (type *service.GophermartError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRedeliverWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusUnauthorized:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "missing_field":
+				var (
+					name      string
+					id        string
+					message   string
+					temporary bool
+					timeout   bool
+					fault     bool
+					err       error
+				)
+				nameRaw := resp.Header.Get("Goa-Attribute-Name")
+				if nameRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("name", "header"))
+				}
+				name = nameRaw
+				idRaw := resp.Header.Get("Goa-Attribute-Id")
+				if idRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("id", "header"))
+				}
+				id = idRaw
+				messageRaw := resp.Header.Get("Goa-Attribute-Message")
+				if messageRaw == "" {
+					err = goa.MergeErrors(err, goa.MissingFieldError("message", "header"))
+				}
+				message = messageRaw
+				{
+					temporaryRaw := resp.Header.Get("Goa-Attribute-Temporary")
+					if temporaryRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "RedeliverWebhook", goa.MissingFieldError("temporary", "header"))
+					}
+					v, err2 := strconv.ParseBool(temporaryRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("temporary", temporaryRaw, "boolean"))
+					}
+					temporary = v
+				}
+				{
+					timeoutRaw := resp.Header.Get("Goa-Attribute-Timeout")
+					if timeoutRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "RedeliverWebhook", goa.MissingFieldError("timeout", "header"))
+					}
+					v, err2 := strconv.ParseBool(timeoutRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("timeout", timeoutRaw, "boolean"))
+					}
+					timeout = v
+				}
+				{
+					faultRaw := resp.Header.Get("Goa-Attribute-Fault")
+					if faultRaw == "" {
+						return nil, goahttp.ErrValidationError("webhook", "RedeliverWebhook", goa.MissingFieldError("fault", "header"))
+					}
+					v, err2 := strconv.ParseBool(faultRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("fault", faultRaw, "boolean"))
+					}
+					fault = v
+				}
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhook", "RedeliverWebhook", err)
+				}
+				return nil, NewRedeliverWebhookMissingField(name, id, message, temporary, timeout, fault)
+			case "User is not authenticated":
+				return nil, NewRedeliverWebhookUserIsNotAuthenticated()
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhook", "RedeliverWebhook", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			return nil, NewRedeliverWebhookInvalidInputParameter()
+		case http.StatusNotFound:
+			return nil, NewRedeliverWebhookWebhookNotFound()
+		case http.StatusInternalServerError:
+			return nil, NewRedeliverWebhookInternalServiceError()
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "RedeliverWebhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalWebhookResponseToWebhookWebhook builds a value of type
+// *webhook.Webhook from a value of type *WebhookResponse.
+func unmarshalWebhookResponseToWebhookWebhook(v *WebhookResponse) *webhook.Webhook {
+	res := &webhook.Webhook{
+		ID:        *v.ID,
+		Secret:    v.Secret,
+		CreatedAt: *v.CreatedAt,
+		UpdatedAt: *v.UpdatedAt,
+		URL:       *v.URL,
+		Active:    *v.Active,
+	}
+	res.Statuses = make([]string, len(v.Statuses))
+	for i, val := range v.Statuses {
+		res.Statuses[i] = val
+	}
+
+	return res
+}
+
+// unmarshalWebhookDeliveryResponseToWebhookWebhookDelivery builds a value of
+// type *webhook.WebhookDelivery from a value of type *WebhookDeliveryResponse.
+func unmarshalWebhookDeliveryResponseToWebhookWebhookDelivery(v *WebhookDeliveryResponse) *webhook.WebhookDelivery {
+	res := &webhook.WebhookDelivery{
+		ID:            *v.ID,
+		Event:         *v.Event,
+		Number:        *v.Number,
+		State:         *v.State,
+		Attempts:      *v.Attempts,
+		NextAttemptAt: v.NextAttemptAt,
+		LastError:     v.LastError,
+		CreatedAt:     *v.CreatedAt,
+		DeliveredAt:   v.DeliveredAt,
+	}
+
+	return res
+}
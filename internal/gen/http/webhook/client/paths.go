@@ -0,0 +1,47 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// HTTP request path constructors for the webhook service.
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"fmt"
+)
+
+// CreateWebhookWebhookPath returns the URL path to the webhook service CreateWebhook HTTP endpoint.
+func CreateWebhookWebhookPath() string {
+	return "/api/user/webhooks"
+}
+
+// ListWebhooksWebhookPath returns the URL path to the webhook service ListWebhooks HTTP endpoint.
+func ListWebhooksWebhookPath() string {
+	return "/api/user/webhooks"
+}
+
+// GetWebhookWebhookPath returns the URL path to the webhook service GetWebhook HTTP endpoint.
+func GetWebhookWebhookPath(id string) string {
+	return fmt.Sprintf("/api/user/webhooks/%v", id)
+}
+
+// UpdateWebhookWebhookPath returns the URL path to the webhook service UpdateWebhook HTTP endpoint.
+func UpdateWebhookWebhookPath(id string) string {
+	return fmt.Sprintf("/api/user/webhooks/%v", id)
+}
+
+// DeleteWebhookWebhookPath returns the URL path to the webhook service DeleteWebhook HTTP endpoint.
+func DeleteWebhookWebhookPath(id string) string {
+	return fmt.Sprintf("/api/user/webhooks/%v", id)
+}
+
+// ListWebhookDeliveriesWebhookPath returns the URL path to the webhook service ListWebhookDeliveries HTTP endpoint.
+func ListWebhookDeliveriesWebhookPath(id string) string {
+	return fmt.Sprintf("/api/user/webhooks/%v/deliveries", id)
+}
+
+// RedeliverWebhookWebhookPath returns the URL path to the webhook service RedeliverWebhook HTTP endpoint.
+func RedeliverWebhookWebhookPath(id string, deliveryID int64) string {
+	return fmt.Sprintf("/api/user/webhooks/%v/deliveries/%v/redeliver", id, deliveryID)
+}
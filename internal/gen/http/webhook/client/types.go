@@ -0,0 +1,819 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook HTTP client types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package client
+
+import (
+	"unicode/utf8"
+
+	service "github.com/oleshko-g/oggophermart/internal/gen/service"
+	webhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateWebhookRequestBody is the type of the "webhook" service
+// "CreateWebhook" endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// The http(s) URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses" json:"statuses" xml:"statuses"`
+	// The events aren't delivered to the inactive webhook
+	Active bool `form:"active" json:"active" xml:"active"`
+}
+
+// UpdateWebhookRequestBody is the type of the "webhook" service
+// "UpdateWebhook" endpoint HTTP request body.
+type UpdateWebhookRequestBody struct {
+	// The http(s) URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses" json:"statuses" xml:"statuses"`
+	// The events aren't delivered to the inactive webhook
+	Active bool `form:"active" json:"active" xml:"active"`
+}
+
+// CreateWebhookResponseBody is the type of the "webhook" service
+// "CreateWebhook" endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The http(s) URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The events aren't delivered to the inactive webhook
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+}
+
+// ListWebhooksResponseBody is the type of the "webhook" service "ListWebhooks"
+// endpoint HTTP response body.
+type ListWebhooksResponseBody []*WebhookResponse
+
+// GetWebhookResponseBody is the type of the "webhook" service "GetWebhook"
+// endpoint HTTP response body.
+type GetWebhookResponseBody struct {
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The http(s) URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The events aren't delivered to the inactive webhook
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+}
+
+// UpdateWebhookResponseBody is the type of the "webhook" service
+// "UpdateWebhook" endpoint HTTP response body.
+type UpdateWebhookResponseBody struct {
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The http(s) URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The events aren't delivered to the inactive webhook
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+}
+
+// ListWebhookDeliveriesResponseBody is the type of the "webhook" service
+// "ListWebhookDeliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody []*WebhookDeliveryResponse
+
+// WebhookResponse is used to define fields on response body types.
+type WebhookResponse struct {
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The http(s) URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The events aren't delivered to the inactive webhook
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+}
+
+// WebhookDeliveryResponse is used to define fields on response body types.
+type WebhookDeliveryResponse struct {
+	// The ID of the delivery. It's the X-Gophermart-Delivery header of the request
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The type of the event
+	Event *string `form:"event,omitempty" json:"event,omitempty" xml:"event,omitempty"`
+	// The order number
+	Number *string `form:"number,omitempty" json:"number,omitempty" xml:"number,omitempty"`
+	// pending: the delivery is attempted or retried, delivered: the partner
+	// responded with 2xx, dead: the attempts are exhausted
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// The number of the failed attempts
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// The time of the next attempt of the pending delivery
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// The failure of the last attempt
+	LastError   *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	DeliveredAt *string `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// NewCreateWebhookRequestBody builds the HTTP request body from the payload of
+// the "CreateWebhook" endpoint of the "webhook" service.
+func NewCreateWebhookRequestBody(p *webhook.CreateWebhookPayload) *CreateWebhookRequestBody {
+	body := &CreateWebhookRequestBody{
+		URL:    p.URL,
+		Active: p.Active,
+	}
+	if p.Statuses != nil {
+		body.Statuses = make([]string, len(p.Statuses))
+		for i, val := range p.Statuses {
+			body.Statuses[i] = val
+		}
+	}
+	if p.Statuses == nil {
+		body.Statuses = []string{"PROCESSED", "INVALID"}
+	}
+	{
+		var zero bool
+		if body.Active == zero {
+			body.Active = true
+		}
+	}
+	return body
+}
+
+// NewUpdateWebhookRequestBody builds the HTTP request body from the payload of
+// the "UpdateWebhook" endpoint of the "webhook" service.
+func NewUpdateWebhookRequestBody(p *webhook.UpdateWebhookPayload) *UpdateWebhookRequestBody {
+	body := &UpdateWebhookRequestBody{
+		URL:    p.URL,
+		Active: p.Active,
+	}
+	if p.Statuses != nil {
+		body.Statuses = make([]string, len(p.Statuses))
+		for i, val := range p.Statuses {
+			body.Statuses[i] = val
+		}
+	}
+	if p.Statuses == nil {
+		body.Statuses = []string{"PROCESSED", "INVALID"}
+	}
+	{
+		var zero bool
+		if body.Active == zero {
+			body.Active = true
+		}
+	}
+	return body
+}
+
+// NewCreateWebhookWebhookCreated builds a "webhook" service "CreateWebhook"
+// endpoint result from a HTTP "Created" response.
+func NewCreateWebhookWebhookCreated(body *CreateWebhookResponseBody) *webhook.Webhook {
+	v := &webhook.Webhook{
+		ID:        *body.ID,
+		Secret:    body.Secret,
+		CreatedAt: *body.CreatedAt,
+		UpdatedAt: *body.UpdatedAt,
+		URL:       *body.URL,
+		Active:    *body.Active,
+	}
+	v.Statuses = make([]string, len(body.Statuses))
+	for i, val := range body.Statuses {
+		v.Statuses[i] = val
+	}
+
+	return v
+}
+
+// NewCreateWebhookMissingField builds a webhook service CreateWebhook endpoint
+// missing_field error.
+func NewCreateWebhookMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewCreateWebhookUserIsNotAuthenticated builds a webhook service
+// CreateWebhook endpoint User is not authenticated error.
+func NewCreateWebhookUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewCreateWebhookInvalidInputParameter builds a webhook service CreateWebhook
+// endpoint Invalid input parameter error.
+func NewCreateWebhookInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewCreateWebhookWebhookNotFound builds a webhook service CreateWebhook
+// endpoint Webhook not found error.
+func NewCreateWebhookWebhookNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewCreateWebhookInternalServiceError builds a webhook service CreateWebhook
+// endpoint Internal service error error.
+func NewCreateWebhookInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhooksWebhookOK builds a "webhook" service "ListWebhooks" endpoint
+// result from a HTTP "OK" response.
+func NewListWebhooksWebhookOK(body []*WebhookResponse) []*webhook.Webhook {
+	v := make([]*webhook.Webhook, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalWebhookResponseToWebhookWebhook(val)
+	}
+
+	return v
+}
+
+// NewListWebhooksMissingField builds a webhook service ListWebhooks endpoint
+// missing_field error.
+func NewListWebhooksMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewListWebhooksUserIsNotAuthenticated builds a webhook service ListWebhooks
+// endpoint User is not authenticated error.
+func NewListWebhooksUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhooksInvalidInputParameter builds a webhook service ListWebhooks
+// endpoint Invalid input parameter error.
+func NewListWebhooksInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhooksWebhookNotFound builds a webhook service ListWebhooks
+// endpoint Webhook not found error.
+func NewListWebhooksWebhookNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhooksInternalServiceError builds a webhook service ListWebhooks
+// endpoint Internal service error error.
+func NewListWebhooksInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetWebhookWebhookOK builds a "webhook" service "GetWebhook" endpoint
+// result from a HTTP "OK" response.
+func NewGetWebhookWebhookOK(body *GetWebhookResponseBody) *webhook.Webhook {
+	v := &webhook.Webhook{
+		ID:        *body.ID,
+		Secret:    body.Secret,
+		CreatedAt: *body.CreatedAt,
+		UpdatedAt: *body.UpdatedAt,
+		URL:       *body.URL,
+		Active:    *body.Active,
+	}
+	v.Statuses = make([]string, len(body.Statuses))
+	for i, val := range body.Statuses {
+		v.Statuses[i] = val
+	}
+
+	return v
+}
+
+// NewGetWebhookMissingField builds a webhook service GetWebhook endpoint
+// missing_field error.
+func NewGetWebhookMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewGetWebhookUserIsNotAuthenticated builds a webhook service GetWebhook
+// endpoint User is not authenticated error.
+func NewGetWebhookUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetWebhookInvalidInputParameter builds a webhook service GetWebhook
+// endpoint Invalid input parameter error.
+func NewGetWebhookInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetWebhookWebhookNotFound builds a webhook service GetWebhook endpoint
+// Webhook not found error.
+func NewGetWebhookWebhookNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewGetWebhookInternalServiceError builds a webhook service GetWebhook
+// endpoint Internal service error error.
+func NewGetWebhookInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUpdateWebhookWebhookOK builds a "webhook" service "UpdateWebhook"
+// endpoint result from a HTTP "OK" response.
+func NewUpdateWebhookWebhookOK(body *UpdateWebhookResponseBody) *webhook.Webhook {
+	v := &webhook.Webhook{
+		ID:        *body.ID,
+		Secret:    body.Secret,
+		CreatedAt: *body.CreatedAt,
+		UpdatedAt: *body.UpdatedAt,
+		URL:       *body.URL,
+		Active:    *body.Active,
+	}
+	v.Statuses = make([]string, len(body.Statuses))
+	for i, val := range body.Statuses {
+		v.Statuses[i] = val
+	}
+
+	return v
+}
+
+// NewUpdateWebhookMissingField builds a webhook service UpdateWebhook endpoint
+// missing_field error.
+func NewUpdateWebhookMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewUpdateWebhookUserIsNotAuthenticated builds a webhook service
+// UpdateWebhook endpoint User is not authenticated error.
+func NewUpdateWebhookUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUpdateWebhookInvalidInputParameter builds a webhook service UpdateWebhook
+// endpoint Invalid input parameter error.
+func NewUpdateWebhookInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUpdateWebhookWebhookNotFound builds a webhook service UpdateWebhook
+// endpoint Webhook not found error.
+func NewUpdateWebhookWebhookNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewUpdateWebhookInternalServiceError builds a webhook service UpdateWebhook
+// endpoint Internal service error error.
+func NewUpdateWebhookInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewDeleteWebhookMissingField builds a webhook service DeleteWebhook endpoint
+// missing_field error.
+func NewDeleteWebhookMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewDeleteWebhookUserIsNotAuthenticated builds a webhook service
+// DeleteWebhook endpoint User is not authenticated error.
+func NewDeleteWebhookUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewDeleteWebhookInvalidInputParameter builds a webhook service DeleteWebhook
+// endpoint Invalid input parameter error.
+func NewDeleteWebhookInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewDeleteWebhookWebhookNotFound builds a webhook service DeleteWebhook
+// endpoint Webhook not found error.
+func NewDeleteWebhookWebhookNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewDeleteWebhookInternalServiceError builds a webhook service DeleteWebhook
+// endpoint Internal service error error.
+func NewDeleteWebhookInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhookDeliveriesWebhookDeliveryOK builds a "webhook" service
+// "ListWebhookDeliveries" endpoint result from a HTTP "OK" response.
+func NewListWebhookDeliveriesWebhookDeliveryOK(body []*WebhookDeliveryResponse) []*webhook.WebhookDelivery {
+	v := make([]*webhook.WebhookDelivery, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalWebhookDeliveryResponseToWebhookWebhookDelivery(val)
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesMissingField builds a webhook service
+// ListWebhookDeliveries endpoint missing_field error.
+func NewListWebhookDeliveriesMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewListWebhookDeliveriesUserIsNotAuthenticated builds a webhook service
+// ListWebhookDeliveries endpoint User is not authenticated error.
+func NewListWebhookDeliveriesUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhookDeliveriesInvalidInputParameter builds a webhook service
+// ListWebhookDeliveries endpoint Invalid input parameter error.
+func NewListWebhookDeliveriesInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhookDeliveriesWebhookNotFound builds a webhook service
+// ListWebhookDeliveries endpoint Webhook not found error.
+func NewListWebhookDeliveriesWebhookNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewListWebhookDeliveriesInternalServiceError builds a webhook service
+// ListWebhookDeliveries endpoint Internal service error error.
+func NewListWebhookDeliveriesInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewRedeliverWebhookMissingField builds a webhook service RedeliverWebhook
+// endpoint missing_field error.
+func NewRedeliverWebhookMissingField(name string, id string, message string, temporary bool, timeout bool, fault bool) *goa.ServiceError {
+	v := &goa.ServiceError{}
+	v.Name = name
+	v.ID = id
+	v.Message = message
+	v.Temporary = temporary
+	v.Timeout = timeout
+	v.Fault = fault
+
+	return v
+}
+
+// NewRedeliverWebhookUserIsNotAuthenticated builds a webhook service
+// RedeliverWebhook endpoint User is not authenticated error.
+func NewRedeliverWebhookUserIsNotAuthenticated() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewRedeliverWebhookInvalidInputParameter builds a webhook service
+// RedeliverWebhook endpoint Invalid input parameter error.
+func NewRedeliverWebhookInvalidInputParameter() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewRedeliverWebhookWebhookNotFound builds a webhook service RedeliverWebhook
+// endpoint Webhook not found error.
+func NewRedeliverWebhookWebhookNotFound() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// NewRedeliverWebhookInternalServiceError builds a webhook service
+// RedeliverWebhook endpoint Internal service error error.
+func NewRedeliverWebhookInternalServiceError() *service.GophermartError {
+	v := &service.GophermartError{}
+
+	return v
+}
+
+// ValidateCreateWebhookResponseBody runs the validations defined on
+// CreateWebhookResponseBody
+func ValidateCreateWebhookResponseBody(body *CreateWebhookResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Statuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if body.URL != nil {
+		if utf8.RuneCountInString(*body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+		}
+	}
+	for _, e := range body.Statuses {
+		if !(e == "PROCESSED" || e == "INVALID") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+		}
+	}
+	return
+}
+
+// ValidateGetWebhookResponseBody runs the validations defined on
+// GetWebhookResponseBody
+func ValidateGetWebhookResponseBody(body *GetWebhookResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Statuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if body.URL != nil {
+		if utf8.RuneCountInString(*body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+		}
+	}
+	for _, e := range body.Statuses {
+		if !(e == "PROCESSED" || e == "INVALID") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateWebhookResponseBody runs the validations defined on
+// UpdateWebhookResponseBody
+func ValidateUpdateWebhookResponseBody(body *UpdateWebhookResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Statuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if body.URL != nil {
+		if utf8.RuneCountInString(*body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+		}
+	}
+	for _, e := range body.Statuses {
+		if !(e == "PROCESSED" || e == "INVALID") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+		}
+	}
+	return
+}
+
+// ValidateWebhookResponse runs the validations defined on WebhookResponse
+func ValidateWebhookResponse(body *WebhookResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Statuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statuses", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if body.URL != nil {
+		if utf8.RuneCountInString(*body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+		}
+	}
+	for _, e := range body.Statuses {
+		if !(e == "PROCESSED" || e == "INVALID") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+		}
+	}
+	return
+}
+
+// ValidateWebhookDeliveryResponse runs the validations defined on
+// WebhookDeliveryResponse
+func ValidateWebhookDeliveryResponse(body *WebhookDeliveryResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Event == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event", "body"))
+	}
+	if body.Number == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("number", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Event != nil {
+		if !(*body.Event == "order.processed" || *body.Event == "order.invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event", *body.Event, []any{"order.processed", "order.invalid"}))
+		}
+	}
+	if body.State != nil {
+		if !(*body.State == "pending" || *body.State == "delivered" || *body.State == "dead") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"pending", "delivered", "dead"}))
+		}
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_attempt_at", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.DeliveredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.delivered_at", *body.DeliveredAt, goa.FormatDateTime))
+	}
+	return
+}
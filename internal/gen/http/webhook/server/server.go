@@ -0,0 +1,478 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook HTTP server
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	webhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Server lists the webhook service endpoint HTTP handlers.
+type Server struct {
+	Mounts                []*MountPoint
+	CreateWebhook         http.Handler
+	ListWebhooks          http.Handler
+	GetWebhook            http.Handler
+	UpdateWebhook         http.Handler
+	DeleteWebhook         http.Handler
+	ListWebhookDeliveries http.Handler
+	RedeliverWebhook      http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the webhook service endpoints using
+// the provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *webhook.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"CreateWebhook", "POST", "/api/user/webhooks"},
+			{"ListWebhooks", "GET", "/api/user/webhooks"},
+			{"GetWebhook", "GET", "/api/user/webhooks/{id}"},
+			{"UpdateWebhook", "PUT", "/api/user/webhooks/{id}"},
+			{"DeleteWebhook", "DELETE", "/api/user/webhooks/{id}"},
+			{"ListWebhookDeliveries", "GET", "/api/user/webhooks/{id}/deliveries"},
+			{"RedeliverWebhook", "POST", "/api/user/webhooks/{id}/deliveries/{delivery_id}/redeliver"},
+		},
+		CreateWebhook:         NewCreateWebhookHandler(e.CreateWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhooks:          NewListWebhooksHandler(e.ListWebhooks, mux, decoder, encoder, errhandler, formatter),
+		GetWebhook:            NewGetWebhookHandler(e.GetWebhook, mux, decoder, encoder, errhandler, formatter),
+		UpdateWebhook:         NewUpdateWebhookHandler(e.UpdateWebhook, mux, decoder, encoder, errhandler, formatter),
+		DeleteWebhook:         NewDeleteWebhookHandler(e.DeleteWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookDeliveries: NewListWebhookDeliveriesHandler(e.ListWebhookDeliveries, mux, decoder, encoder, errhandler, formatter),
+		RedeliverWebhook:      NewRedeliverWebhookHandler(e.RedeliverWebhook, mux, decoder, encoder, errhandler, formatter),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "webhook" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.CreateWebhook = m(s.CreateWebhook)
+	s.ListWebhooks = m(s.ListWebhooks)
+	s.GetWebhook = m(s.GetWebhook)
+	s.UpdateWebhook = m(s.UpdateWebhook)
+	s.DeleteWebhook = m(s.DeleteWebhook)
+	s.ListWebhookDeliveries = m(s.ListWebhookDeliveries)
+	s.RedeliverWebhook = m(s.RedeliverWebhook)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return webhook.MethodNames[:] }
+
+// Mount configures the mux to serve the webhook endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountCreateWebhookHandler(mux, h.CreateWebhook)
+	MountListWebhooksHandler(mux, h.ListWebhooks)
+	MountGetWebhookHandler(mux, h.GetWebhook)
+	MountUpdateWebhookHandler(mux, h.UpdateWebhook)
+	MountDeleteWebhookHandler(mux, h.DeleteWebhook)
+	MountListWebhookDeliveriesHandler(mux, h.ListWebhookDeliveries)
+	MountRedeliverWebhookHandler(mux, h.RedeliverWebhook)
+}
+
+// Mount configures the mux to serve the webhook endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountCreateWebhookHandler configures the mux to serve the "webhook" service
+// "CreateWebhook" endpoint.
+func MountCreateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/user/webhooks", f)
+}
+
+// NewCreateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhook" service "CreateWebhook" endpoint.
+func NewCreateWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeCreateWebhookResponse(encoder)
+		encodeError    = EncodeCreateWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CreateWebhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListWebhooksHandler configures the mux to serve the "webhook" service
+// "ListWebhooks" endpoint.
+func MountListWebhooksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/user/webhooks", f)
+}
+
+// NewListWebhooksHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhook" service "ListWebhooks" endpoint.
+func NewListWebhooksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhooksRequest(mux, decoder)
+		encodeResponse = EncodeListWebhooksResponse(encoder)
+		encodeError    = EncodeListWebhooksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListWebhooks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetWebhookHandler configures the mux to serve the "webhook" service
+// "GetWebhook" endpoint.
+func MountGetWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/user/webhooks/{id}", f)
+}
+
+// NewGetWebhookHandler creates a HTTP handler which loads the HTTP request and
+// calls the "webhook" service "GetWebhook" endpoint.
+func NewGetWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetWebhookRequest(mux, decoder)
+		encodeResponse = EncodeGetWebhookResponse(encoder)
+		encodeError    = EncodeGetWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetWebhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateWebhookHandler configures the mux to serve the "webhook" service
+// "UpdateWebhook" endpoint.
+func MountUpdateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/api/user/webhooks/{id}", f)
+}
+
+// NewUpdateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhook" service "UpdateWebhook" endpoint.
+func NewUpdateWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeUpdateWebhookResponse(encoder)
+		encodeError    = EncodeUpdateWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "UpdateWebhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteWebhookHandler configures the mux to serve the "webhook" service
+// "DeleteWebhook" endpoint.
+func MountDeleteWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/user/webhooks/{id}", f)
+}
+
+// NewDeleteWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhook" service "DeleteWebhook" endpoint.
+func NewDeleteWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteWebhookRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWebhookResponse(encoder)
+		encodeError    = EncodeDeleteWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteWebhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListWebhookDeliveriesHandler configures the mux to serve the "webhook"
+// service "ListWebhookDeliveries" endpoint.
+func MountListWebhookDeliveriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/user/webhooks/{id}/deliveries", f)
+}
+
+// NewListWebhookDeliveriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "webhook" service "ListWebhookDeliveries" endpoint.
+func NewListWebhookDeliveriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhookDeliveriesRequest(mux, decoder)
+		encodeResponse = EncodeListWebhookDeliveriesResponse(encoder)
+		encodeError    = EncodeListWebhookDeliveriesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListWebhookDeliveries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRedeliverWebhookHandler configures the mux to serve the "webhook"
+// service "RedeliverWebhook" endpoint.
+func MountRedeliverWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/user/webhooks/{id}/deliveries/{delivery_id}/redeliver", f)
+}
+
+// NewRedeliverWebhookHandler creates a HTTP handler which loads the HTTP
+// request and calls the "webhook" service "RedeliverWebhook" endpoint.
+func NewRedeliverWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRedeliverWebhookRequest(mux, decoder)
+		encodeResponse = EncodeRedeliverWebhookResponse(encoder)
+		encodeError    = EncodeRedeliverWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "RedeliverWebhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,375 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook HTTP server types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package server
+
+import (
+	"unicode/utf8"
+
+	webhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateWebhookRequestBody is the type of the "webhook" service
+// "CreateWebhook" endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// The http(s) URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The events aren't delivered to the inactive webhook
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+}
+
+// UpdateWebhookRequestBody is the type of the "webhook" service
+// "UpdateWebhook" endpoint HTTP request body.
+type UpdateWebhookRequestBody struct {
+	// The http(s) URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses,omitempty" json:"statuses,omitempty" xml:"statuses,omitempty"`
+	// The events aren't delivered to the inactive webhook
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+}
+
+// CreateWebhookResponseBody is the type of the "webhook" service
+// "CreateWebhook" endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	ID string `form:"id" json:"id" xml:"id"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt string  `form:"created_at" json:"created_at" xml:"created_at"`
+	UpdatedAt string  `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// The http(s) URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses" json:"statuses" xml:"statuses"`
+	// The events aren't delivered to the inactive webhook
+	Active bool `form:"active" json:"active" xml:"active"`
+}
+
+// ListWebhooksResponseBody is the type of the "webhook" service "ListWebhooks"
+// endpoint HTTP response body.
+type ListWebhooksResponseBody []*WebhookResponse
+
+// GetWebhookResponseBody is the type of the "webhook" service "GetWebhook"
+// endpoint HTTP response body.
+type GetWebhookResponseBody struct {
+	ID string `form:"id" json:"id" xml:"id"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt string  `form:"created_at" json:"created_at" xml:"created_at"`
+	UpdatedAt string  `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// The http(s) URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses" json:"statuses" xml:"statuses"`
+	// The events aren't delivered to the inactive webhook
+	Active bool `form:"active" json:"active" xml:"active"`
+}
+
+// UpdateWebhookResponseBody is the type of the "webhook" service
+// "UpdateWebhook" endpoint HTTP response body.
+type UpdateWebhookResponseBody struct {
+	ID string `form:"id" json:"id" xml:"id"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt string  `form:"created_at" json:"created_at" xml:"created_at"`
+	UpdatedAt string  `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// The http(s) URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses" json:"statuses" xml:"statuses"`
+	// The events aren't delivered to the inactive webhook
+	Active bool `form:"active" json:"active" xml:"active"`
+}
+
+// ListWebhookDeliveriesResponseBody is the type of the "webhook" service
+// "ListWebhookDeliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody []*WebhookDeliveryResponse
+
+// WebhookResponse is used to define fields on response body types.
+type WebhookResponse struct {
+	ID string `form:"id" json:"id" xml:"id"`
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	CreatedAt string  `form:"created_at" json:"created_at" xml:"created_at"`
+	UpdatedAt string  `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// The http(s) URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string `form:"statuses" json:"statuses" xml:"statuses"`
+	// The events aren't delivered to the inactive webhook
+	Active bool `form:"active" json:"active" xml:"active"`
+}
+
+// WebhookDeliveryResponse is used to define fields on response body types.
+type WebhookDeliveryResponse struct {
+	// The ID of the delivery. It's the X-Gophermart-Delivery header of the request
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// The type of the event
+	Event string `form:"event" json:"event" xml:"event"`
+	// The order number
+	Number string `form:"number" json:"number" xml:"number"`
+	// pending: the delivery is attempted or retried, delivered: the partner
+	// responded with 2xx, dead: the attempts are exhausted
+	State string `form:"state" json:"state" xml:"state"`
+	// The number of the failed attempts
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// The time of the next attempt of the pending delivery
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// The failure of the last attempt
+	LastError   *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	DeliveredAt *string `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// NewCreateWebhookResponseBody builds the HTTP response body from the result
+// of the "CreateWebhook" endpoint of the "webhook" service.
+func NewCreateWebhookResponseBody(res *webhook.Webhook) *CreateWebhookResponseBody {
+	body := &CreateWebhookResponseBody{
+		ID:        res.ID,
+		Secret:    res.Secret,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+		URL:       res.URL,
+		Active:    res.Active,
+	}
+	if res.Statuses != nil {
+		body.Statuses = make([]string, len(res.Statuses))
+		for i, val := range res.Statuses {
+			body.Statuses[i] = val
+		}
+	} else {
+		body.Statuses = []string{}
+	}
+	return body
+}
+
+// NewListWebhooksResponseBody builds the HTTP response body from the result of
+// the "ListWebhooks" endpoint of the "webhook" service.
+func NewListWebhooksResponseBody(res []*webhook.Webhook) ListWebhooksResponseBody {
+	body := make([]*WebhookResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWebhookWebhookToWebhookResponse(val)
+	}
+	return body
+}
+
+// NewGetWebhookResponseBody builds the HTTP response body from the result of
+// the "GetWebhook" endpoint of the "webhook" service.
+func NewGetWebhookResponseBody(res *webhook.Webhook) *GetWebhookResponseBody {
+	body := &GetWebhookResponseBody{
+		ID:        res.ID,
+		Secret:    res.Secret,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+		URL:       res.URL,
+		Active:    res.Active,
+	}
+	if res.Statuses != nil {
+		body.Statuses = make([]string, len(res.Statuses))
+		for i, val := range res.Statuses {
+			body.Statuses[i] = val
+		}
+	} else {
+		body.Statuses = []string{}
+	}
+	return body
+}
+
+// NewUpdateWebhookResponseBody builds the HTTP response body from the result
+// of the "UpdateWebhook" endpoint of the "webhook" service.
+func NewUpdateWebhookResponseBody(res *webhook.Webhook) *UpdateWebhookResponseBody {
+	body := &UpdateWebhookResponseBody{
+		ID:        res.ID,
+		Secret:    res.Secret,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+		URL:       res.URL,
+		Active:    res.Active,
+	}
+	if res.Statuses != nil {
+		body.Statuses = make([]string, len(res.Statuses))
+		for i, val := range res.Statuses {
+			body.Statuses[i] = val
+		}
+	} else {
+		body.Statuses = []string{}
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesResponseBody builds the HTTP response body from the
+// result of the "ListWebhookDeliveries" endpoint of the "webhook" service.
+func NewListWebhookDeliveriesResponseBody(res []*webhook.WebhookDelivery) ListWebhookDeliveriesResponseBody {
+	body := make([]*WebhookDeliveryResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWebhookWebhookDeliveryToWebhookDeliveryResponse(val)
+	}
+	return body
+}
+
+// NewCreateWebhookPayload builds a webhook service CreateWebhook endpoint
+// payload.
+func NewCreateWebhookPayload(body *CreateWebhookRequestBody, authorization string) *webhook.CreateWebhookPayload {
+	v := &webhook.CreateWebhookPayload{
+		URL: *body.URL,
+	}
+	if body.Active != nil {
+		v.Active = *body.Active
+	}
+	if body.Statuses != nil {
+		v.Statuses = make([]string, len(body.Statuses))
+		for i, val := range body.Statuses {
+			v.Statuses[i] = val
+		}
+	}
+	if body.Statuses == nil {
+		v.Statuses = []string{"PROCESSED", "INVALID"}
+	}
+	if body.Active == nil {
+		v.Active = true
+	}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewListWebhooksPayload builds a webhook service ListWebhooks endpoint
+// payload.
+func NewListWebhooksPayload(authorization string) *webhook.ListWebhooksPayload {
+	v := &webhook.ListWebhooksPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewGetWebhookPayload builds a webhook service GetWebhook endpoint payload.
+func NewGetWebhookPayload(id string, authorization string) *webhook.GetWebhookPayload {
+	v := &webhook.GetWebhookPayload{}
+	v.ID = id
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewUpdateWebhookPayload builds a webhook service UpdateWebhook endpoint
+// payload.
+func NewUpdateWebhookPayload(body *UpdateWebhookRequestBody, id string, authorization string) *webhook.UpdateWebhookPayload {
+	v := &webhook.UpdateWebhookPayload{
+		URL: *body.URL,
+	}
+	if body.Active != nil {
+		v.Active = *body.Active
+	}
+	if body.Statuses != nil {
+		v.Statuses = make([]string, len(body.Statuses))
+		for i, val := range body.Statuses {
+			v.Statuses[i] = val
+		}
+	}
+	if body.Statuses == nil {
+		v.Statuses = []string{"PROCESSED", "INVALID"}
+	}
+	if body.Active == nil {
+		v.Active = true
+	}
+	v.ID = id
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewDeleteWebhookPayload builds a webhook service DeleteWebhook endpoint
+// payload.
+func NewDeleteWebhookPayload(id string, authorization string) *webhook.DeleteWebhookPayload {
+	v := &webhook.DeleteWebhookPayload{}
+	v.ID = id
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewListWebhookDeliveriesPayload builds a webhook service
+// ListWebhookDeliveries endpoint payload.
+func NewListWebhookDeliveriesPayload(id string, state *string, limit int, authorization string) *webhook.ListWebhookDeliveriesPayload {
+	v := &webhook.ListWebhookDeliveriesPayload{}
+	v.ID = id
+	v.State = state
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewRedeliverWebhookPayload builds a webhook service RedeliverWebhook
+// endpoint payload.
+func NewRedeliverWebhookPayload(id string, deliveryID int64, authorization string) *webhook.RedeliverWebhookPayload {
+	v := &webhook.RedeliverWebhookPayload{}
+	v.ID = id
+	v.DeliveryID = deliveryID
+	v.Authorization = authorization
+
+	return v
+}
+
+// ValidateCreateWebhookRequestBody runs the validations defined on
+// CreateWebhookRequestBody
+func ValidateCreateWebhookRequestBody(body *CreateWebhookRequestBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if body.URL != nil {
+		if utf8.RuneCountInString(*body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+		}
+	}
+	for _, e := range body.Statuses {
+		if !(e == "PROCESSED" || e == "INVALID") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateWebhookRequestBody runs the validations defined on
+// UpdateWebhookRequestBody
+func ValidateUpdateWebhookRequestBody(body *UpdateWebhookRequestBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if body.URL != nil {
+		if utf8.RuneCountInString(*body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+		}
+	}
+	for _, e := range body.Statuses {
+		if !(e == "PROCESSED" || e == "INVALID") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.statuses[*]", e, []any{"PROCESSED", "INVALID"}))
+		}
+	}
+	return
+}
@@ -8,7 +8,7 @@
 package service
 
 type GophermartError struct {
-	// identifier to map an error to HTTP status codes
+	// identifier to map an error to HTTP status codes and gRPC codes
 	Name string `json:"-"`
 }
 
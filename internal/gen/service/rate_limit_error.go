@@ -0,0 +1,32 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// User types
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package service
+
+type RateLimitError struct {
+	// identifier to map an error to HTTP status codes
+	Name string `json:"-"`
+	// Seconds to wait before the next request
+	RetryAfter int
+}
+
+// Error returns an error description.
+func (e *RateLimitError) Error() string {
+	return ""
+}
+
+// ErrorName returns "RateLimitError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *RateLimitError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "RateLimitError".
+func (e *RateLimitError) GoaErrorName() string {
+	return e.Name
+}
@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: batch.go
+
+package pgx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrBatchAlreadyClosed = errors.New("batch already closed")
+)
+
+const insertOutboxEvents = `-- name: InsertOutboxEvents :batchexec
+WITH
+  author AS (
+    SELECT
+      users.id
+    FROM
+      users
+    WHERE
+      users.id = $4
+    FOR NO KEY UPDATE
+  )
+INSERT INTO
+  outbox_events (user_id, type, data, created_at)
+SELECT
+  author.id,
+  $1,
+  $2,
+  $3
+FROM
+  author
+`
+
+type InsertOutboxEventsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type InsertOutboxEventsParams struct {
+	Type      string
+	Data      []byte
+	CreatedAt time.Time
+	UserID    uuid.UUID
+}
+
+// the batched InsertOutboxEvent. The user is locked until the transaction ends
+func (q *Queries) InsertOutboxEvents(ctx context.Context, arg []InsertOutboxEventsParams) *InsertOutboxEventsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.Type,
+			a.Data,
+			a.CreatedAt,
+			a.UserID,
+		}
+		batch.Queue(insertOutboxEvents, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &InsertOutboxEventsBatchResults{br, len(arg), false}
+}
+
+func (b *InsertOutboxEventsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *InsertOutboxEventsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
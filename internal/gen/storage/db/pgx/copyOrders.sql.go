@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: copyOrders.sql
+
+package pgx
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+type CopyOrdersParams struct {
+	ID        uuid.UUID
+	Number    string
+	UserID    uuid.UUID
+	Status    order.Status
+	CreatedAt time.Time
+}
@@ -0,0 +1,46 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: copyfrom.go
+
+package pgx
+
+import (
+	"context"
+)
+
+// iteratorForCopyOrders implements pgx.CopyFromSource.
+type iteratorForCopyOrders struct {
+	rows                 []CopyOrdersParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyOrders) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyOrders) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].Number,
+		r.rows[0].UserID,
+		r.rows[0].Status,
+		r.rows[0].CreatedAt,
+	}, nil
+}
+
+func (r iteratorForCopyOrders) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyOrders(ctx context.Context, arg []CopyOrdersParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"orders"}, []string{"id", "number", "user_id", "status", "created_at"}, &iteratorForCopyOrders{rows: arg})
+}
@@ -0,0 +1,23 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteIdempotencyKeysCreatedBefore.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const deleteIdempotencyKeysCreatedBefore = `-- name: DeleteIdempotencyKeysCreatedBefore :execresult
+DELETE FROM idempotency_keys
+WHERE
+  created_at < $1
+`
+
+func (q *Queries) DeleteIdempotencyKeysCreatedBefore(ctx context.Context, createdAt time.Time) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteIdempotencyKeysCreatedBefore, createdAt)
+}
@@ -0,0 +1,23 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteOutboxEventsPublishedBefore.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const deleteOutboxEventsPublishedBefore = `-- name: DeleteOutboxEventsPublishedBefore :execresult
+DELETE FROM outbox_events
+WHERE
+  published_at < $1
+`
+
+func (q *Queries) DeleteOutboxEventsPublishedBefore(ctx context.Context, publishedBefore *time.Time) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteOutboxEventsPublishedBefore, publishedBefore)
+}
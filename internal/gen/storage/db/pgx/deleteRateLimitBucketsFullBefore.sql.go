@@ -0,0 +1,23 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteRateLimitBucketsFullBefore.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const deleteRateLimitBucketsFullBefore = `-- name: DeleteRateLimitBucketsFullBefore :execresult
+DELETE FROM rate_limit_buckets
+WHERE
+  full_at < $1
+`
+
+func (q *Queries) DeleteRateLimitBucketsFullBefore(ctx context.Context, fullAt time.Time) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, deleteRateLimitBucketsFullBefore, fullAt)
+}
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertIdempotencyKey.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const insertIdempotencyKey = `-- name: InsertIdempotencyKey :execresult
+INSERT INTO
+  idempotency_keys (user_id, key, fingerprint, created_at)
+VALUES
+  ($1, $2, $3, $4)
+ON CONFLICT (user_id, key) DO NOTHING
+`
+
+type InsertIdempotencyKeyParams struct {
+	UserID      uuid.UUID
+	Key         string
+	Fingerprint string
+	CreatedAt   time.Time
+}
+
+func (q *Queries) InsertIdempotencyKey(ctx context.Context, arg InsertIdempotencyKeyParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, insertIdempotencyKey,
+		arg.UserID,
+		arg.Key,
+		arg.Fingerprint,
+		arg.CreatedAt,
+	)
+}
@@ -0,0 +1,65 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertImportedOrders.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const insertImportedOrders = `-- name: InsertImportedOrders :execrows
+WITH
+  inserted AS (
+    INSERT INTO
+      orders (id, number, user_id, status, created_at)
+    SELECT
+      unnest($2::uuid[]),
+      unnest($3::text[]),
+      unnest($4::uuid[]),
+      unnest($5::text[]),
+      unnest($6::timestamptz[])
+    RETURNING
+      id,
+      status,
+      created_at
+  )
+INSERT INTO
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  id,
+  status,
+  $1::text,
+  created_at
+FROM
+  inserted
+`
+
+type InsertImportedOrdersParams struct {
+	Source     string
+	Ids        []uuid.UUID
+	Numbers    []string
+	UserIds    []uuid.UUID
+	Statuses   []string
+	CreatedAts []time.Time
+}
+
+// the orders and their statuses are recorded at once. It fails if any number is stored already
+func (q *Queries) InsertImportedOrders(ctx context.Context, arg InsertImportedOrdersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertImportedOrders,
+		arg.Source,
+		arg.Ids,
+		arg.Numbers,
+		arg.UserIds,
+		arg.Statuses,
+		arg.CreatedAts,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
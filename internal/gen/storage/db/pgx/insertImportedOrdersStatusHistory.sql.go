@@ -0,0 +1,40 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertImportedOrdersStatusHistory.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const insertImportedOrdersStatusHistory = `-- name: InsertImportedOrdersStatusHistory :exec
+INSERT INTO
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  id,
+  status,
+  $1::text,
+  created_at
+FROM
+  orders
+WHERE
+  id = ANY ($2::uuid[])
+ORDER BY
+  created_at,
+  id
+`
+
+type InsertImportedOrdersStatusHistoryParams struct {
+	Source string
+	Ids    []uuid.UUID
+}
+
+// the statuses of the orders copied by CopyOrders are recorded as their first statuses
+func (q *Queries) InsertImportedOrdersStatusHistory(ctx context.Context, arg InsertImportedOrdersStatusHistoryParams) error {
+	_, err := q.db.Exec(ctx, insertImportedOrdersStatusHistory, arg.Source, arg.Ids)
+	return err
+}
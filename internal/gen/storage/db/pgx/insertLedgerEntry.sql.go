@@ -0,0 +1,49 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertLedgerEntry.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oleshko-g/oggophermart/internal/money"
+)
+
+const insertLedgerEntry = `-- name: InsertLedgerEntry :one
+INSERT INTO
+  ledger_entries (user_id, kind, amount, order_number, reason, actor_id, created_at)
+VALUES
+  ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+  id
+`
+
+type InsertLedgerEntryParams struct {
+	UserID      uuid.UUID
+	Kind        string
+	Amount      money.Amount
+	OrderNumber pgtype.Text
+	Reason      pgtype.Text
+	ActorID     uuid.NullUUID
+	CreatedAt   time.Time
+}
+
+func (q *Queries) InsertLedgerEntry(ctx context.Context, arg InsertLedgerEntryParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertLedgerEntry,
+		arg.UserID,
+		arg.Kind,
+		arg.Amount,
+		arg.OrderNumber,
+		arg.Reason,
+		arg.ActorID,
+		arg.CreatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
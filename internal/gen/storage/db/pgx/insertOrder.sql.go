@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertOrder.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const insertOrder = `-- name: InsertOrder :execresult
+WITH
+  inserted AS (
+    INSERT INTO
+      orders (id, number, user_id, status, created_at)
+    VALUES
+      ($2, $3, $4, $5, $6)
+    ON CONFLICT (number) DO NOTHING
+    RETURNING
+      id,
+      status,
+      created_at
+  )
+INSERT INTO
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  id,
+  status,
+  $1,
+  created_at
+FROM
+  inserted
+`
+
+type InsertOrderParams struct {
+	Source    order.Source
+	ID        uuid.UUID
+	Number    string
+	UserID    uuid.UUID
+	Status    order.Status
+	CreatedAt time.Time
+}
+
+// the order and its first status are recorded at once. Nothing is inserted on conflict
+func (q *Queries) InsertOrder(ctx context.Context, arg InsertOrderParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, insertOrder,
+		arg.Source,
+		arg.ID,
+		arg.Number,
+		arg.UserID,
+		arg.Status,
+		arg.CreatedAt,
+	)
+}
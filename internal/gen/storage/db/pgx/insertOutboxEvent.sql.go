@@ -0,0 +1,53 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertOutboxEvent.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+WITH
+  author AS (
+    SELECT
+      users.id
+    FROM
+      users
+    WHERE
+      users.id = $4
+    FOR NO KEY UPDATE
+  )
+INSERT INTO
+  outbox_events (user_id, type, data, created_at)
+SELECT
+  author.id,
+  $1,
+  $2,
+  $3
+FROM
+  author
+`
+
+type InsertOutboxEventParams struct {
+	Type      string
+	Data      []byte
+	CreatedAt time.Time
+	UserID    uuid.UUID
+}
+
+// the user is locked until the transaction ends so the IDs of the events of the user follow the order of the commits
+func (q *Queries) InsertOutboxEvent(ctx context.Context, arg InsertOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, insertOutboxEvent,
+		arg.Type,
+		arg.Data,
+		arg.CreatedAt,
+		arg.UserID,
+	)
+	return err
+}
@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertWebhook.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const insertWebhook = `-- name: InsertWebhook :exec
+INSERT INTO
+  webhooks (id, user_id, url, secret, statuses, active, created_at, updated_at)
+VALUES
+  ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertWebhookParams struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Url       string
+	Secret    string
+	Statuses  string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) InsertWebhook(ctx context.Context, arg InsertWebhookParams) error {
+	_, err := q.db.Exec(ctx, insertWebhook,
+		arg.ID,
+		arg.UserID,
+		arg.Url,
+		arg.Secret,
+		arg.Statuses,
+		arg.Active,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,116 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+
+package pgx
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/role"
+)
+
+type IdempotencyKey struct {
+	UserID         uuid.UUID
+	Key            string
+	Fingerprint    string
+	StatusCode     int32
+	ResponseHeader []byte
+	ResponseBody   []byte
+	CreatedAt      time.Time
+}
+
+type LedgerEntry struct {
+	ID          int64
+	UserID      uuid.UUID
+	Kind        string
+	Amount      money.Amount
+	OrderNumber pgtype.Text
+	Reason      pgtype.Text
+	ActorID     uuid.NullUUID
+	CreatedAt   time.Time
+}
+
+type Order struct {
+	ID        uuid.UUID
+	Number    string
+	UserID    uuid.UUID
+	Status    order.Status
+	CreatedAt time.Time
+	// hundredths of a point
+	Accrual     *money.Amount
+	ProcessedAt *time.Time
+}
+
+type OrderStatusHistory struct {
+	ID         int64
+	OrderID    uuid.UUID
+	FromStatus *order.Status
+	ToStatus   order.Status
+	Source     order.Source
+	CreatedAt  time.Time
+	UserSeq    int64
+}
+
+type OutboxEvent struct {
+	ID            int64
+	UserID        uuid.UUID
+	Type          string
+	Data          []byte
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+	Attempts      int32
+	NextAttemptAt *time.Time
+	DeadAt        *time.Time
+}
+
+type OutboxRelayLease struct {
+	ID         int32
+	Owner      string
+	LeaseUntil time.Time
+}
+
+type RateLimitBucket struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt time.Time
+	FullAt    time.Time
+}
+
+type User struct {
+	ID             uuid.UUID
+	Login          string
+	HashedPassword string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+	Role           role.Role
+	OrderEventsSeq int64
+}
+
+type Webhook struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Url       string
+	Secret    string
+	Statuses  string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     uuid.UUID
+	HistoryID     int64
+	State         string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     pgtype.Text
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
@@ -0,0 +1,81 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrderEventsByUserID.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectOrderEventsByUserID = `-- name: SelectOrderEventsByUserID :many
+SELECT
+  order_status_history.user_seq AS id,
+  orders.number,
+  order_status_history.from_status,
+  order_status_history.to_status,
+  order_status_history.source,
+  order_status_history.created_at,
+  orders.accrual
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  orders.user_id = $1
+  AND order_status_history.user_seq > $2
+ORDER BY
+  order_status_history.user_seq ASC
+LIMIT
+  $3
+`
+
+type SelectOrderEventsByUserIDParams struct {
+	UserID    uuid.UUID
+	AfterID   int64
+	MaxEvents int32
+}
+
+type SelectOrderEventsByUserIDRow struct {
+	ID         int64
+	Number     string
+	FromStatus *order.Status
+	ToStatus   order.Status
+	Source     order.Source
+	CreatedAt  time.Time
+	Accrual    *money.Amount
+}
+
+// the events are identified by their numbers among the events of the user which follow the order of the commits
+func (q *Queries) SelectOrderEventsByUserID(ctx context.Context, arg SelectOrderEventsByUserIDParams) ([]SelectOrderEventsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, selectOrderEventsByUserID, arg.UserID, arg.AfterID, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectOrderEventsByUserIDRow
+	for rows.Next() {
+		var i SelectOrderEventsByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Number,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Source,
+			&i.CreatedAt,
+			&i.Accrual,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,46 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrderStatusForUpdate.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectOrderStatusForUpdate = `-- name: SelectOrderStatusForUpdate :one
+SELECT
+  id,
+  user_id,
+  status,
+  accrual
+FROM
+  orders
+WHERE
+  number = $1
+FOR UPDATE
+`
+
+type SelectOrderStatusForUpdateRow struct {
+	ID      uuid.UUID
+	UserID  uuid.UUID
+	Status  order.Status
+	Accrual *money.Amount
+}
+
+func (q *Queries) SelectOrderStatusForUpdate(ctx context.Context, number string) (SelectOrderStatusForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, selectOrderStatusForUpdate, number)
+	var i SelectOrderStatusForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.Accrual,
+	)
+	return i, err
+}
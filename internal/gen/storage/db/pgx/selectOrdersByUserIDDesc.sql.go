@@ -0,0 +1,101 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrdersByUserIDDesc.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectOrdersByUserIDDesc = `-- name: SelectOrdersByUserIDDesc :many
+SELECT
+  id,
+  number,
+  status,
+  accrual,
+  created_at
+FROM
+  orders
+WHERE
+  user_id = $1
+  AND (
+    coalesce(cardinality($2::text[]), 0) = 0
+    OR status = ANY($2::text[])
+  )
+  AND (
+    $3::timestamptz IS NULL
+    OR created_at >= $3::timestamptz
+  )
+  AND (
+    $4::timestamptz IS NULL
+    OR created_at < $4::timestamptz
+  )
+  AND (
+    $5::timestamptz IS NULL
+    OR (created_at, id) < ($5::timestamptz, $6::uuid)
+  )
+ORDER BY
+  created_at DESC,
+  id DESC
+LIMIT
+  $7
+`
+
+type SelectOrdersByUserIDDescParams struct {
+	UserID         uuid.UUID
+	Statuses       []string
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	AfterCreatedAt *time.Time
+	AfterID        uuid.UUID
+	MaxRows        int32
+}
+
+type SelectOrdersByUserIDDescRow struct {
+	ID        uuid.UUID
+	Number    string
+	Status    order.Status
+	Accrual   *money.Amount
+	CreatedAt time.Time
+}
+
+func (q *Queries) SelectOrdersByUserIDDesc(ctx context.Context, arg SelectOrdersByUserIDDescParams) ([]SelectOrdersByUserIDDescRow, error) {
+	rows, err := q.db.Query(ctx, selectOrdersByUserIDDesc,
+		arg.UserID,
+		arg.Statuses,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectOrdersByUserIDDescRow
+	for rows.Next() {
+		var i SelectOrdersByUserIDDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Number,
+			&i.Status,
+			&i.Accrual,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
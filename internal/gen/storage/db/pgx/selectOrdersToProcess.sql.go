@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrdersToProcess.sql
+
+package pgx
+
+import (
+	"context"
+)
+
+const selectOrdersToProcess = `-- name: SelectOrdersToProcess :many
+SELECT
+  number
+FROM
+  orders
+WHERE
+  status IN ('NEW', 'PROCESSING')
+ORDER BY
+  created_at ASC
+LIMIT
+  $1
+`
+
+func (q *Queries) SelectOrdersToProcess(ctx context.Context, limit int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, selectOrdersToProcess, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var number string
+		if err := rows.Scan(&number); err != nil {
+			return nil, err
+		}
+		items = append(items, number)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectRateLimitBucketForUpdate.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+)
+
+const selectRateLimitBucketForUpdate = `-- name: SelectRateLimitBucketForUpdate :one
+SELECT
+  tokens,
+  updated_at
+FROM
+  rate_limit_buckets
+WHERE
+  key = $1
+FOR UPDATE
+`
+
+type SelectRateLimitBucketForUpdateRow struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+func (q *Queries) SelectRateLimitBucketForUpdate(ctx context.Context, key string) (SelectRateLimitBucketForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, selectRateLimitBucketForUpdate, key)
+	var i SelectRateLimitBucketForUpdateRow
+	err := row.Scan(&i.Tokens, &i.UpdatedAt)
+	return i, err
+}
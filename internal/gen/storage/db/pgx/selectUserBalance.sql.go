@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserBalance.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectUserBalance = `-- name: SelectUserBalance :one
+SELECT
+  CAST(COALESCE(SUM(amount), 0) AS BIGINT) AS balance,
+  CAST(COALESCE(SUM(-amount) FILTER (WHERE kind = 'withdrawal'), 0) AS BIGINT) AS withdrawn
+FROM
+  ledger_entries
+WHERE
+  user_id = $1
+`
+
+type SelectUserBalanceRow struct {
+	Balance   int64
+	Withdrawn int64
+}
+
+func (q *Queries) SelectUserBalance(ctx context.Context, userID uuid.UUID) (SelectUserBalanceRow, error) {
+	row := q.db.QueryRow(ctx, selectUserBalance, userID)
+	var i SelectUserBalanceRow
+	err := row.Scan(&i.Balance, &i.Withdrawn)
+	return i, err
+}
@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserForUpdate.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectUserForUpdate = `-- name: SelectUserForUpdate :one
+SELECT
+  id
+FROM
+  users
+WHERE
+  id = $1
+FOR NO KEY UPDATE
+`
+
+// the user is locked until the transaction ends so the changes of the balance are serialized
+func (q *Queries) SelectUserForUpdate(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, selectUserForUpdate, id)
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,26 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserHashedPasswordByLogin.sql
+
+package pgx
+
+import (
+	"context"
+)
+
+const selectUserHashedPasswordByLogin = `-- name: SelectUserHashedPasswordByLogin :one
+SELECT
+  hashed_password
+FROM
+  users
+WHERE
+  login = $1
+`
+
+func (q *Queries) SelectUserHashedPasswordByLogin(ctx context.Context, login string) (string, error) {
+	row := q.db.QueryRow(ctx, selectUserHashedPasswordByLogin, login)
+	var hashed_password string
+	err := row.Scan(&hashed_password)
+	return hashed_password, err
+}
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserIDByLogin.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectUserIDByLogin = `-- name: SelectUserIDByLogin :one
+SELECT
+  id
+FROM
+  users
+WHERE
+  login = $1
+`
+
+func (q *Queries) SelectUserIDByLogin(ctx context.Context, login string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, selectUserIDByLogin, login)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserIDByOrderNumber.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectUserIDByOrderNumber = `-- name: SelectUserIDByOrderNumber :one
+SELECT
+  user_id
+FROM
+  orders
+WHERE
+  number = $1
+`
+
+func (q *Queries) SelectUserIDByOrderNumber(ctx context.Context, number string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, selectUserIDByOrderNumber, number)
+	var user_id uuid.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
+}
@@ -0,0 +1,58 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserOrderByNumber.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectUserOrderByNumber = `-- name: SelectUserOrderByNumber :one
+SELECT
+  id,
+  number,
+  status,
+  accrual,
+  created_at,
+  processed_at
+FROM
+  orders
+WHERE
+  number = $1
+  AND user_id = $2
+`
+
+type SelectUserOrderByNumberParams struct {
+	Number string
+	UserID uuid.UUID
+}
+
+type SelectUserOrderByNumberRow struct {
+	ID          uuid.UUID
+	Number      string
+	Status      order.Status
+	Accrual     *money.Amount
+	CreatedAt   time.Time
+	ProcessedAt *time.Time
+}
+
+func (q *Queries) SelectUserOrderByNumber(ctx context.Context, arg SelectUserOrderByNumberParams) (SelectUserOrderByNumberRow, error) {
+	row := q.db.QueryRow(ctx, selectUserOrderByNumber, arg.Number, arg.UserID)
+	var i SelectUserOrderByNumberRow
+	err := row.Scan(
+		&i.ID,
+		&i.Number,
+		&i.Status,
+		&i.Accrual,
+		&i.CreatedAt,
+		&i.ProcessedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,70 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUsers.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/role"
+)
+
+const selectUsers = `-- name: SelectUsers :many
+SELECT
+  id,
+  login,
+  role,
+  created_at
+FROM
+  users
+WHERE
+  strpos(lower(login), lower($1::TEXT)) > 0
+  AND ($2::TEXT = '' OR role = $2::TEXT)
+ORDER BY
+  login
+LIMIT
+  $3
+`
+
+type SelectUsersParams struct {
+	Login    string
+	Role     string
+	MaxUsers int32
+}
+
+type SelectUsersRow struct {
+	ID        uuid.UUID
+	Login     string
+	Role      role.Role
+	CreatedAt time.Time
+}
+
+// the users whose logins contain the login case insensitively. Any role matches if the role is empty
+func (q *Queries) SelectUsers(ctx context.Context, arg SelectUsersParams) ([]SelectUsersRow, error) {
+	rows, err := q.db.Query(ctx, selectUsers, arg.Login, arg.Role, arg.MaxUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectUsersRow
+	for rows.Next() {
+		var i SelectUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Login,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,96 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectWebhookDeliveries.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectWebhookDeliveries = `-- name: SelectWebhookDeliveries :many
+SELECT
+  webhook_deliveries.id,
+  order_status_history.to_status,
+  orders.number,
+  webhook_deliveries.state,
+  webhook_deliveries.attempts,
+  webhook_deliveries.next_attempt_at,
+  webhook_deliveries.last_error,
+  webhook_deliveries.created_at,
+  webhook_deliveries.delivered_at
+FROM
+  webhook_deliveries
+  JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id
+  JOIN order_status_history ON order_status_history.id = webhook_deliveries.history_id
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  webhooks.id = $1
+  AND webhooks.user_id = $2
+  AND ($3::TEXT = '' OR webhook_deliveries.state = $3::TEXT)
+ORDER BY
+  webhook_deliveries.id DESC
+LIMIT
+  $4
+`
+
+type SelectWebhookDeliveriesParams struct {
+	WebhookID     uuid.UUID
+	UserID        uuid.UUID
+	State         string
+	MaxDeliveries int32
+}
+
+type SelectWebhookDeliveriesRow struct {
+	ID            int64
+	ToStatus      order.Status
+	Number        string
+	State         string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     pgtype.Text
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
+
+// the deliveries of the webhook of the user. Any state matches if the state is empty
+func (q *Queries) SelectWebhookDeliveries(ctx context.Context, arg SelectWebhookDeliveriesParams) ([]SelectWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, selectWebhookDeliveries,
+		arg.WebhookID,
+		arg.UserID,
+		arg.State,
+		arg.MaxDeliveries,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectWebhookDeliveriesRow
+	for rows.Next() {
+		var i SelectWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ToStatus,
+			&i.Number,
+			&i.State,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
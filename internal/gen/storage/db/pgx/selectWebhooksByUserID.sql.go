@@ -0,0 +1,53 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectWebhooksByUserID.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectWebhooksByUserID = `-- name: SelectWebhooksByUserID :many
+SELECT
+  id, user_id, url, secret, statuses, active, created_at, updated_at
+FROM
+  webhooks
+WHERE
+  user_id = $1
+ORDER BY
+  created_at ASC,
+  id ASC
+`
+
+func (q *Queries) SelectWebhooksByUserID(ctx context.Context, userID uuid.UUID) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, selectWebhooksByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Url,
+			&i.Secret,
+			&i.Statuses,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
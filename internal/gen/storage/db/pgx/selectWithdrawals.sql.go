@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectWithdrawals.sql
+
+package pgx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectWithdrawals = `-- name: SelectWithdrawals :many
+SELECT
+  id,
+  user_id,
+  kind,
+  amount,
+  order_number,
+  reason,
+  actor_id,
+  created_at
+FROM
+  ledger_entries
+WHERE
+  user_id = $1
+  AND kind = 'withdrawal'
+ORDER BY
+  id
+`
+
+func (q *Queries) SelectWithdrawals(ctx context.Context, userID uuid.UUID) ([]LedgerEntry, error) {
+	rows, err := q.db.Query(ctx, selectWithdrawals, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LedgerEntry
+	for rows.Next() {
+		var i LedgerEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Amount,
+			&i.OrderNumber,
+			&i.Reason,
+			&i.ActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
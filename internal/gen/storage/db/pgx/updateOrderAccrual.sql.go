@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateOrderAccrual.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const updateOrderAccrual = `-- name: UpdateOrderAccrual :execresult
+UPDATE orders
+SET
+  status = $1,
+  accrual = $2,
+  processed_at = $3
+WHERE
+  id = $4
+  AND status = $5
+`
+
+type UpdateOrderAccrualParams struct {
+	Status      order.Status
+	Accrual     *money.Amount
+	ProcessedAt *time.Time
+	ID          uuid.UUID
+	FromStatus  order.Status
+}
+
+func (q *Queries) UpdateOrderAccrual(ctx context.Context, arg UpdateOrderAccrualParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, updateOrderAccrual,
+		arg.Status,
+		arg.Accrual,
+		arg.ProcessedAt,
+		arg.ID,
+		arg.FromStatus,
+	)
+}
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateOutboxEventAttempt.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+)
+
+const updateOutboxEventAttempt = `-- name: UpdateOutboxEventAttempt :exec
+UPDATE outbox_events
+SET
+  attempts = $1,
+  next_attempt_at = $2,
+  dead_at = $3
+WHERE
+  id = $4
+`
+
+type UpdateOutboxEventAttemptParams struct {
+	Attempts      int32
+	NextAttemptAt *time.Time
+	DeadAt        *time.Time
+	ID            int64
+}
+
+func (q *Queries) UpdateOutboxEventAttempt(ctx context.Context, arg UpdateOutboxEventAttemptParams) error {
+	_, err := q.db.Exec(ctx, updateOutboxEventAttempt,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.DeadAt,
+		arg.ID,
+	)
+	return err
+}
@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateOutboxEventPublished.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+)
+
+const updateOutboxEventPublished = `-- name: UpdateOutboxEventPublished :exec
+UPDATE outbox_events
+SET
+  published_at = $1
+WHERE
+  id = $2
+`
+
+type UpdateOutboxEventPublishedParams struct {
+	PublishedAt *time.Time
+	ID          int64
+}
+
+func (q *Queries) UpdateOutboxEventPublished(ctx context.Context, arg UpdateOutboxEventPublishedParams) error {
+	_, err := q.db.Exec(ctx, updateOutboxEventPublished, arg.PublishedAt, arg.ID)
+	return err
+}
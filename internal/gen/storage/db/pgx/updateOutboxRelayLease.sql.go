@@ -0,0 +1,37 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateOutboxRelayLease.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const updateOutboxRelayLease = `-- name: UpdateOutboxRelayLease :execresult
+UPDATE outbox_relay_lease
+SET
+  owner = $1,
+  lease_until = $2
+WHERE
+  id = 1
+  AND (
+    owner = $1
+    OR lease_until < $3
+  )
+`
+
+type UpdateOutboxRelayLeaseParams struct {
+	Owner      string
+	LeaseUntil time.Time
+	Now        time.Time
+}
+
+// the lease is taken if it's held by the owner already or it has expired
+func (q *Queries) UpdateOutboxRelayLease(ctx context.Context, arg UpdateOutboxRelayLeaseParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, updateOutboxRelayLease, arg.Owner, arg.LeaseUntil, arg.Now)
+}
@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateUserRole.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/oleshko-g/oggophermart/internal/role"
+)
+
+const updateUserRole = `-- name: UpdateUserRole :execresult
+UPDATE users
+SET
+  role = $1,
+  updated_at = $2
+WHERE
+  id = $3
+`
+
+type UpdateUserRoleParams struct {
+	Role      role.Role
+	UpdatedAt time.Time
+	ID        uuid.UUID
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (pgconn.CommandTag, error) {
+	return q.db.Exec(ctx, updateUserRole, arg.Role, arg.UpdatedAt, arg.ID)
+}
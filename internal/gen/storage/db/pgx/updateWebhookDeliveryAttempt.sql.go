@@ -0,0 +1,46 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateWebhookDeliveryAttempt.sql
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const updateWebhookDeliveryAttempt = `-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET
+  state = $1,
+  attempts = $2,
+  next_attempt_at = $3,
+  last_error = $4,
+  delivered_at = $5
+WHERE
+  id = $6
+`
+
+type UpdateWebhookDeliveryAttemptParams struct {
+	State         string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     pgtype.Text
+	DeliveredAt   *time.Time
+	ID            int64
+}
+
+func (q *Queries) UpdateWebhookDeliveryAttempt(ctx context.Context, arg UpdateWebhookDeliveryAttemptParams) error {
+	_, err := q.db.Exec(ctx, updateWebhookDeliveryAttempt,
+		arg.State,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.DeliveredAt,
+		arg.ID,
+	)
+	return err
+}
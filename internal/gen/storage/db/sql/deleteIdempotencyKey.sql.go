@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteIdempotencyKey.sql
+
+package sql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+  user_id = $1
+  AND key = $2
+`
+
+type DeleteIdempotencyKeyParams struct {
+	UserID uuid.UUID
+	Key    string
+}
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error {
+	_, err := q.db.ExecContext(ctx, deleteIdempotencyKey, arg.UserID, arg.Key)
+	return err
+}
@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteIdempotencyKeysCreatedBefore.sql
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const deleteIdempotencyKeysCreatedBefore = `-- name: DeleteIdempotencyKeysCreatedBefore :execresult
+DELETE FROM idempotency_keys
+WHERE
+  created_at < $1
+`
+
+func (q *Queries) DeleteIdempotencyKeysCreatedBefore(ctx context.Context, createdAt time.Time) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteIdempotencyKeysCreatedBefore, createdAt)
+}
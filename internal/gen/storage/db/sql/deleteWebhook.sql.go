@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteWebhook.sql
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const deleteWebhook = `-- name: DeleteWebhook :execresult
+DELETE FROM webhooks
+WHERE
+  id = $1
+  AND user_id = $2
+`
+
+type DeleteWebhookParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteWebhook, arg.ID, arg.UserID)
+}
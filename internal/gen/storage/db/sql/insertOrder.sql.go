@@ -11,25 +11,46 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
 )
 
 const insertOrder = `-- name: InsertOrder :execresult
+WITH
+  inserted AS (
+    INSERT INTO
+      orders (id, number, user_id, status, created_at)
+    VALUES
+      ($2, $3, $4, $5, $6)
+    ON CONFLICT (number) DO NOTHING
+    RETURNING
+      id,
+      status,
+      created_at
+  )
 INSERT INTO
-  orders (id, number, user_id, status, created_at)
-VALUES
-  ($1, $2, $3, $4, $5)
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  id,
+  status,
+  $1,
+  created_at
+FROM
+  inserted
 `
 
 type InsertOrderParams struct {
+	Source    order.Source
 	ID        uuid.UUID
 	Number    string
 	UserID    uuid.UUID
-	Status    string
+	Status    order.Status
 	CreatedAt time.Time
 }
 
+// the order and its first status are recorded at once. Nothing is inserted on conflict
 func (q *Queries) InsertOrder(ctx context.Context, arg InsertOrderParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, insertOrder,
+		arg.Source,
 		arg.ID,
 		arg.Number,
 		arg.UserID,
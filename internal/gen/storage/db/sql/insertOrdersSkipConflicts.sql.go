@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertOrdersSkipConflicts.sql
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const insertOrdersSkipConflicts = `-- name: InsertOrdersSkipConflicts :many
+WITH
+  inserted AS (
+    INSERT INTO
+      orders (id, number, user_id, status, created_at)
+    SELECT
+      unnest($1::uuid[]),
+      unnest($2::text[]),
+      $3::uuid,
+      $4::text,
+      $5::timestamptz
+    ON CONFLICT (number) DO NOTHING
+    RETURNING
+      id,
+      number,
+      status,
+      created_at
+  ),
+  history AS (
+    INSERT INTO
+      order_status_history (order_id, to_status, source, created_at)
+    SELECT
+      id,
+      status,
+      $6::text,
+      created_at
+    FROM
+      inserted
+  )
+SELECT
+  number
+FROM
+  inserted
+`
+
+type InsertOrdersSkipConflictsParams struct {
+	Ids       []uuid.UUID
+	Numbers   []string
+	UserID    uuid.UUID
+	Status    string
+	CreatedAt time.Time
+	Source    string
+}
+
+func (q *Queries) InsertOrdersSkipConflicts(ctx context.Context, arg InsertOrdersSkipConflictsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, insertOrdersSkipConflicts,
+		pq.Array(arg.Ids),
+		pq.Array(arg.Numbers),
+		arg.UserID,
+		arg.Status,
+		arg.CreatedAt,
+		arg.Source,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var number string
+		if err := rows.Scan(&number); err != nil {
+			return nil, err
+		}
+		items = append(items, number)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,54 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertOutboxEvent.sql
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+WITH
+  author AS (
+    SELECT
+      users.id
+    FROM
+      users
+    WHERE
+      users.id = $4
+    FOR NO KEY UPDATE
+  )
+INSERT INTO
+  outbox_events (user_id, type, data, created_at)
+SELECT
+  author.id,
+  $1,
+  $2,
+  $3
+FROM
+  author
+`
+
+type InsertOutboxEventParams struct {
+	Type      string
+	Data      json.RawMessage
+	CreatedAt time.Time
+	UserID    uuid.UUID
+}
+
+// the user is locked until the transaction ends so the IDs of the events of the user follow the order of the commits
+func (q *Queries) InsertOutboxEvent(ctx context.Context, arg InsertOutboxEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertOutboxEvent,
+		arg.Type,
+		arg.Data,
+		arg.CreatedAt,
+		arg.UserID,
+	)
+	return err
+}
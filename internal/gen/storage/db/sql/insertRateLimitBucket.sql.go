@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertRateLimitBucket.sql
+
+package sql
+
+import (
+	"context"
+	"time"
+)
+
+const insertRateLimitBucket = `-- name: InsertRateLimitBucket :exec
+INSERT INTO
+  rate_limit_buckets (key, tokens, updated_at, full_at)
+VALUES
+  ($1, $2, $3, $4)
+ON CONFLICT (key) DO NOTHING
+`
+
+type InsertRateLimitBucketParams struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt time.Time
+	FullAt    time.Time
+}
+
+func (q *Queries) InsertRateLimitBucket(ctx context.Context, arg InsertRateLimitBucketParams) error {
+	_, err := q.db.ExecContext(ctx, insertRateLimitBucket,
+		arg.Key,
+		arg.Tokens,
+		arg.UpdatedAt,
+		arg.FullAt,
+	)
+	return err
+}
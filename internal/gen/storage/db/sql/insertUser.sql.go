@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/role"
 )
 
 const insertUser = `-- name: InsertUser :execresult
@@ -19,17 +20,20 @@ INSERT INTO
     id,
     login,
     hashed_password,
+    role,
     created_at,
     updated_at
   )
 VALUES
-  ($1, $2, $3, $4, $5)
+  ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (login) DO NOTHING
 `
 
 type InsertUserParams struct {
 	ID             uuid.UUID
 	Login          string
 	HashedPassword string
+	Role           role.Role
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -39,6 +43,7 @@ func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (sql.Res
 		arg.ID,
 		arg.Login,
 		arg.HashedPassword,
+		arg.Role,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
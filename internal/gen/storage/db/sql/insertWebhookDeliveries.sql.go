@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertWebhookDeliveries.sql
+
+package sql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const insertWebhookDeliveries = `-- name: InsertWebhookDeliveries :exec
+INSERT INTO
+  webhook_deliveries (webhook_id, history_id, state, attempts, next_attempt_at, created_at)
+SELECT
+  webhooks.id,
+  order_status_history.id,
+  'pending',
+  0,
+  order_status_history.created_at,
+  order_status_history.created_at
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+  JOIN webhooks ON webhooks.user_id = orders.user_id
+WHERE
+  order_status_history.id = (
+    SELECT
+      MAX(latest.id)
+    FROM
+      order_status_history AS latest
+    WHERE
+      latest.order_id = $1
+  )
+  AND webhooks.active = TRUE
+  AND ',' || webhooks.statuses || ',' LIKE '%,' || order_status_history.to_status || ',%'
+`
+
+// the latest transition of the order is delivered to the active webhooks of its user subscribed to its status
+func (q *Queries) InsertWebhookDeliveries(ctx context.Context, orderID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, insertWebhookDeliveries, orderID)
+	return err
+}
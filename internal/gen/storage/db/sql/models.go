@@ -6,17 +6,80 @@ package sql
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/role"
 )
 
+type IdempotencyKey struct {
+	UserID         uuid.UUID
+	Key            string
+	Fingerprint    string
+	StatusCode     int32
+	ResponseHeader []byte
+	ResponseBody   []byte
+	CreatedAt      time.Time
+}
+
+type LedgerEntry struct {
+	ID          int64
+	UserID      uuid.UUID
+	Kind        string
+	Amount      money.Amount
+	OrderNumber sql.NullString
+	Reason      sql.NullString
+	ActorID     uuid.NullUUID
+	CreatedAt   time.Time
+}
+
 type Order struct {
 	ID        uuid.UUID
 	Number    string
 	UserID    uuid.UUID
-	Status    string
+	Status    order.Status
 	CreatedAt time.Time
+	// hundredths of a point
+	Accrual     *money.Amount
+	ProcessedAt sql.NullTime
+}
+
+type OrderStatusHistory struct {
+	ID         int64
+	OrderID    uuid.UUID
+	FromStatus *order.Status
+	ToStatus   order.Status
+	Source     order.Source
+	CreatedAt  time.Time
+	UserSeq    int64
+}
+
+type OutboxEvent struct {
+	ID            int64
+	UserID        uuid.UUID
+	Type          string
+	Data          json.RawMessage
+	CreatedAt     time.Time
+	PublishedAt   sql.NullTime
+	Attempts      int32
+	NextAttemptAt sql.NullTime
+	DeadAt        sql.NullTime
+}
+
+type OutboxRelayLease struct {
+	ID         int32
+	Owner      string
+	LeaseUntil time.Time
+}
+
+type RateLimitBucket struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt time.Time
+	FullAt    time.Time
 }
 
 type User struct {
@@ -26,4 +89,29 @@ type User struct {
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 	DeletedAt      sql.NullTime
+	Role           role.Role
+	OrderEventsSeq int64
+}
+
+type Webhook struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Url       string
+	Secret    string
+	Statuses  string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     uuid.UUID
+	HistoryID     int64
+	State         string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     sql.NullString
+	CreatedAt     time.Time
+	DeliveredAt   sql.NullTime
 }
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectLastUserOrderEventID.sql
+
+package sql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectLastUserOrderEventID = `-- name: SelectLastUserOrderEventID :one
+SELECT
+  order_events_seq
+FROM
+  users
+WHERE
+  id = $1
+`
+
+func (q *Queries) SelectLastUserOrderEventID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, selectLastUserOrderEventID, userID)
+	var order_events_seq int64
+	err := row.Scan(&order_events_seq)
+	return order_events_seq, err
+}
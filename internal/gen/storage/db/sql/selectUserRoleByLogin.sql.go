@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserRoleByLogin.sql
+
+package sql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/role"
+)
+
+const selectUserRoleByLogin = `-- name: SelectUserRoleByLogin :one
+SELECT
+  id,
+  role
+FROM
+  users
+WHERE
+  login = $1
+`
+
+type SelectUserRoleByLoginRow struct {
+	ID   uuid.UUID
+	Role role.Role
+}
+
+func (q *Queries) SelectUserRoleByLogin(ctx context.Context, login string) (SelectUserRoleByLoginRow, error) {
+	row := q.db.QueryRowContext(ctx, selectUserRoleByLogin, login)
+	var i SelectUserRoleByLoginRow
+	err := row.Scan(&i.ID, &i.Role)
+	return i, err
+}
@@ -0,0 +1,51 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUsersIDsByOrderNumbers.sql
+
+package sql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const selectUsersIDsByOrderNumbers = `-- name: SelectUsersIDsByOrderNumbers :many
+SELECT
+  number,
+  user_id
+FROM
+  orders
+WHERE
+  number = ANY($1::text[])
+`
+
+type SelectUsersIDsByOrderNumbersRow struct {
+	Number string
+	UserID uuid.UUID
+}
+
+func (q *Queries) SelectUsersIDsByOrderNumbers(ctx context.Context, numbers []string) ([]SelectUsersIDsByOrderNumbersRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectUsersIDsByOrderNumbers, pq.Array(numbers))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectUsersIDsByOrderNumbersRow
+	for rows.Next() {
+		var i SelectUsersIDsByOrderNumbersRow
+		if err := rows.Scan(&i.Number, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectWebhook.sql
+
+package sql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectWebhook = `-- name: SelectWebhook :one
+SELECT
+  id, user_id, url, secret, statuses, active, created_at, updated_at
+FROM
+  webhooks
+WHERE
+  id = $1
+  AND user_id = $2
+`
+
+type SelectWebhookParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) SelectWebhook(ctx context.Context, arg SelectWebhookParams) (Webhook, error) {
+	row := q.db.QueryRowContext(ctx, selectWebhook, arg.ID, arg.UserID)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.Statuses,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
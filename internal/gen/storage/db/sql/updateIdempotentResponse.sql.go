@@ -0,0 +1,42 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateIdempotentResponse.sql
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const updateIdempotentResponse = `-- name: UpdateIdempotentResponse :execresult
+UPDATE idempotency_keys
+SET
+  status_code = $1,
+  response_header = $2,
+  response_body = $3
+WHERE
+  user_id = $4
+  AND key = $5
+`
+
+type UpdateIdempotentResponseParams struct {
+	StatusCode     int32
+	ResponseHeader []byte
+	ResponseBody   []byte
+	UserID         uuid.UUID
+	Key            string
+}
+
+func (q *Queries) UpdateIdempotentResponse(ctx context.Context, arg UpdateIdempotentResponseParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateIdempotentResponse,
+		arg.StatusCode,
+		arg.ResponseHeader,
+		arg.ResponseBody,
+		arg.UserID,
+		arg.Key,
+	)
+}
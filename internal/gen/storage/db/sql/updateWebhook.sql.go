@@ -0,0 +1,46 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateWebhook.sql
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const updateWebhook = `-- name: UpdateWebhook :execresult
+UPDATE webhooks
+SET
+  url = $1,
+  statuses = $2,
+  active = $3,
+  updated_at = $4
+WHERE
+  id = $5
+  AND user_id = $6
+`
+
+type UpdateWebhookParams struct {
+	Url       string
+	Statuses  string
+	Active    bool
+	UpdatedAt time.Time
+	ID        uuid.UUID
+	UserID    uuid.UUID
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateWebhook,
+		arg.Url,
+		arg.Statuses,
+		arg.Active,
+		arg.UpdatedAt,
+		arg.ID,
+		arg.UserID,
+	)
+}
@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateWebhookDeliveryNextAttempt.sql
+
+package sql
+
+import (
+	"context"
+	"time"
+)
+
+const updateWebhookDeliveryNextAttempt = `-- name: UpdateWebhookDeliveryNextAttempt :exec
+UPDATE webhook_deliveries
+SET
+  next_attempt_at = $1
+WHERE
+  id = $2
+`
+
+type UpdateWebhookDeliveryNextAttemptParams struct {
+	NextAttemptAt time.Time
+	ID            int64
+}
+
+func (q *Queries) UpdateWebhookDeliveryNextAttempt(ctx context.Context, arg UpdateWebhookDeliveryNextAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, updateWebhookDeliveryNextAttempt, arg.NextAttemptAt, arg.ID)
+	return err
+}
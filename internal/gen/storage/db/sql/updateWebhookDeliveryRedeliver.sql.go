@@ -0,0 +1,51 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateWebhookDeliveryRedeliver.sql
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const updateWebhookDeliveryRedeliver = `-- name: UpdateWebhookDeliveryRedeliver :execresult
+UPDATE webhook_deliveries
+SET
+  state = 'pending',
+  attempts = 0,
+  next_attempt_at = $1,
+  last_error = NULL,
+  delivered_at = NULL
+WHERE
+  webhook_deliveries.id = $2
+  AND webhook_deliveries.webhook_id = (
+    SELECT
+      webhooks.id
+    FROM
+      webhooks
+    WHERE
+      webhooks.id = $3
+      AND webhooks.user_id = $4
+  )
+`
+
+type UpdateWebhookDeliveryRedeliverParams struct {
+	Now       time.Time
+	ID        int64
+	WebhookID uuid.UUID
+	UserID    uuid.UUID
+}
+
+func (q *Queries) UpdateWebhookDeliveryRedeliver(ctx context.Context, arg UpdateWebhookDeliveryRedeliverParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateWebhookDeliveryRedeliver,
+		arg.Now,
+		arg.ID,
+		arg.WebhookID,
+		arg.UserID,
+	)
+}
@@ -0,0 +1,32 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteExpiredIdempotencyKey.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteExpiredIdempotencyKey = `-- name: DeleteExpiredIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+  user_id = ?
+  AND key = ?
+  AND created_at < ?
+`
+
+type DeleteExpiredIdempotencyKeyParams struct {
+	UserID    uuid.UUID
+	Key       string
+	CreatedAt time.Time
+}
+
+func (q *Queries) DeleteExpiredIdempotencyKey(ctx context.Context, arg DeleteExpiredIdempotencyKeyParams) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredIdempotencyKey, arg.UserID, arg.Key, arg.CreatedAt)
+	return err
+}
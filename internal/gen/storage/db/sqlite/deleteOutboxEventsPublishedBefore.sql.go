@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteOutboxEventsPublishedBefore.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+)
+
+const deleteOutboxEventsPublishedBefore = `-- name: DeleteOutboxEventsPublishedBefore :execresult
+DELETE FROM outbox_events
+WHERE
+  published_at < ?1
+`
+
+func (q *Queries) DeleteOutboxEventsPublishedBefore(ctx context.Context, publishedBefore sql.NullTime) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteOutboxEventsPublishedBefore, publishedBefore)
+}
@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: deleteRateLimitBucketsFullBefore.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const deleteRateLimitBucketsFullBefore = `-- name: DeleteRateLimitBucketsFullBefore :execresult
+DELETE FROM rate_limit_buckets
+WHERE
+  full_at < ?
+`
+
+func (q *Queries) DeleteRateLimitBucketsFullBefore(ctx context.Context, fullAt time.Time) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteRateLimitBucketsFullBefore, fullAt)
+}
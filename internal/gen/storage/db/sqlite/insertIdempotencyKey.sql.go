@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertIdempotencyKey.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const insertIdempotencyKey = `-- name: InsertIdempotencyKey :execresult
+INSERT INTO
+  idempotency_keys (user_id, key, fingerprint, created_at)
+VALUES
+  (?, ?, ?, ?)
+ON CONFLICT (user_id, key) DO NOTHING
+`
+
+type InsertIdempotencyKeyParams struct {
+	UserID      uuid.UUID
+	Key         string
+	Fingerprint string
+	CreatedAt   time.Time
+}
+
+func (q *Queries) InsertIdempotencyKey(ctx context.Context, arg InsertIdempotencyKeyParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, insertIdempotencyKey,
+		arg.UserID,
+		arg.Key,
+		arg.Fingerprint,
+		arg.CreatedAt,
+	)
+}
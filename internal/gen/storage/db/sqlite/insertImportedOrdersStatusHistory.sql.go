@@ -0,0 +1,53 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertImportedOrdersStatusHistory.sql
+
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const insertImportedOrdersStatusHistory = `-- name: InsertImportedOrdersStatusHistory :exec
+INSERT INTO
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  imported.id,
+  imported.status,
+  ?1,
+  imported.created_at
+FROM
+  orders AS imported
+WHERE
+  imported.id IN (/*SLICE:ids*/?)
+ORDER BY
+  imported.created_at,
+  imported.id
+`
+
+type InsertImportedOrdersStatusHistoryParams struct {
+	Source order.Source
+	Ids    []uuid.UUID
+}
+
+// the statuses of the imported orders are recorded as their first statuses
+func (q *Queries) InsertImportedOrdersStatusHistory(ctx context.Context, arg InsertImportedOrdersStatusHistoryParams) error {
+	query := insertImportedOrdersStatusHistory
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Source)
+	if len(arg.Ids) > 0 {
+		for _, v := range arg.Ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(arg.Ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
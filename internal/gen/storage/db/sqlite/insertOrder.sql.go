@@ -0,0 +1,41 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertOrder.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const insertOrder = `-- name: InsertOrder :execresult
+INSERT INTO
+  orders (id, number, user_id, status, created_at)
+VALUES
+  (?, ?, ?, ?, ?)
+ON CONFLICT (number) DO NOTHING
+`
+
+type InsertOrderParams struct {
+	ID        uuid.UUID
+	Number    string
+	UserID    uuid.UUID
+	Status    order.Status
+	CreatedAt time.Time
+}
+
+func (q *Queries) InsertOrder(ctx context.Context, arg InsertOrderParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, insertOrder,
+		arg.ID,
+		arg.Number,
+		arg.UserID,
+		arg.Status,
+		arg.CreatedAt,
+	)
+}
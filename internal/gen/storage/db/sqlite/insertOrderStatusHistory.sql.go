@@ -0,0 +1,40 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertOrderStatusHistory.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const insertOrderStatusHistory = `-- name: InsertOrderStatusHistory :exec
+INSERT INTO
+  order_status_history (order_id, from_status, to_status, source, created_at)
+VALUES
+  (?, ?, ?, ?, ?)
+`
+
+type InsertOrderStatusHistoryParams struct {
+	OrderID    uuid.UUID
+	FromStatus *order.Status
+	ToStatus   order.Status
+	Source     order.Source
+	CreatedAt  time.Time
+}
+
+func (q *Queries) InsertOrderStatusHistory(ctx context.Context, arg InsertOrderStatusHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, insertOrderStatusHistory,
+		arg.OrderID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Source,
+		arg.CreatedAt,
+	)
+	return err
+}
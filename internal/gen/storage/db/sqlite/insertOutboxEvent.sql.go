@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertOutboxEvent.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+INSERT INTO
+  outbox_events (user_id, type, data, created_at)
+VALUES
+  (?, ?, ?, ?)
+`
+
+type InsertOutboxEventParams struct {
+	UserID    uuid.UUID
+	Type      string
+	Data      string
+	CreatedAt time.Time
+}
+
+// the writes are serialized so the IDs of the events follow the order of the commits
+func (q *Queries) InsertOutboxEvent(ctx context.Context, arg InsertOutboxEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertOutboxEvent,
+		arg.UserID,
+		arg.Type,
+		arg.Data,
+		arg.CreatedAt,
+	)
+	return err
+}
@@ -0,0 +1,50 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: insertUser.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/role"
+)
+
+const insertUser = `-- name: InsertUser :execresult
+INSERT INTO
+  users (
+    id,
+    login,
+    hashed_password,
+    role,
+    created_at,
+    updated_at
+  )
+VALUES
+  (?, ?, ?, ?, ?, ?)
+ON CONFLICT (login) DO NOTHING
+`
+
+type InsertUserParams struct {
+	ID             uuid.UUID
+	Login          string
+	HashedPassword string
+	Role           role.Role
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, insertUser,
+		arg.ID,
+		arg.Login,
+		arg.HashedPassword,
+		arg.Role,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+}
@@ -0,0 +1,113 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/role"
+)
+
+type IdempotencyKey struct {
+	UserID         uuid.UUID
+	Key            string
+	Fingerprint    string
+	StatusCode     int64
+	ResponseHeader []byte
+	ResponseBody   []byte
+	CreatedAt      time.Time
+}
+
+type LedgerEntry struct {
+	ID          int64
+	UserID      uuid.UUID
+	Kind        string
+	Amount      money.Amount
+	OrderNumber sql.NullString
+	Reason      sql.NullString
+	ActorID     uuid.NullUUID
+	CreatedAt   time.Time
+}
+
+type Order struct {
+	ID          uuid.UUID
+	Number      string
+	UserID      uuid.UUID
+	Status      order.Status
+	CreatedAt   time.Time
+	Accrual     *money.Amount
+	ProcessedAt sql.NullTime
+}
+
+type OrderStatusHistory struct {
+	ID         int64
+	OrderID    uuid.UUID
+	FromStatus *order.Status
+	ToStatus   order.Status
+	Source     order.Source
+	CreatedAt  time.Time
+}
+
+type OutboxEvent struct {
+	ID            int64
+	UserID        uuid.UUID
+	Type          string
+	Data          string
+	CreatedAt     time.Time
+	PublishedAt   sql.NullTime
+	Attempts      int64
+	NextAttemptAt sql.NullTime
+	DeadAt        sql.NullTime
+}
+
+type OutboxRelayLease struct {
+	ID         int64
+	Owner      string
+	LeaseUntil time.Time
+}
+
+type RateLimitBucket struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt time.Time
+	FullAt    time.Time
+}
+
+type User struct {
+	ID             uuid.UUID
+	Login          string
+	HashedPassword string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      sql.NullTime
+	Role           role.Role
+}
+
+type Webhook struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Url       string
+	Secret    string
+	Statuses  string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     uuid.UUID
+	HistoryID     int64
+	State         string
+	Attempts      int64
+	NextAttemptAt time.Time
+	LastError     sql.NullString
+	CreatedAt     time.Time
+	DeliveredAt   sql.NullTime
+}
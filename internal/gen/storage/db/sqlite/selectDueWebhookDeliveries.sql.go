@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectDueWebhookDeliveries.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectDueWebhookDeliveries = `-- name: SelectDueWebhookDeliveries :many
+SELECT
+  webhook_deliveries.id,
+  webhook_deliveries.attempts,
+  webhooks.url,
+  webhooks.secret,
+  orders.number,
+  order_status_history.to_status,
+  order_status_history.created_at,
+  orders.accrual
+FROM
+  webhook_deliveries
+  JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id
+  JOIN order_status_history ON order_status_history.id = webhook_deliveries.history_id
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  webhook_deliveries.state = 'pending'
+  AND webhook_deliveries.next_attempt_at <= ?1
+ORDER BY
+  webhook_deliveries.next_attempt_at ASC
+LIMIT
+  ?2
+`
+
+type SelectDueWebhookDeliveriesParams struct {
+	Now           time.Time
+	MaxDeliveries int64
+}
+
+type SelectDueWebhookDeliveriesRow struct {
+	ID        int64
+	Attempts  int64
+	Url       string
+	Secret    string
+	Number    string
+	ToStatus  order.Status
+	CreatedAt time.Time
+	Accrual   *money.Amount
+}
+
+func (q *Queries) SelectDueWebhookDeliveries(ctx context.Context, arg SelectDueWebhookDeliveriesParams) ([]SelectDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectDueWebhookDeliveries, arg.Now, arg.MaxDeliveries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i SelectDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+			&i.Number,
+			&i.ToStatus,
+			&i.CreatedAt,
+			&i.Accrual,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,53 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectIdempotencyKey.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const selectIdempotencyKey = `-- name: SelectIdempotencyKey :one
+SELECT
+  fingerprint,
+  status_code,
+  response_header,
+  response_body,
+  created_at
+FROM
+  idempotency_keys
+WHERE
+  user_id = ?
+  AND key = ?
+`
+
+type SelectIdempotencyKeyParams struct {
+	UserID uuid.UUID
+	Key    string
+}
+
+type SelectIdempotencyKeyRow struct {
+	Fingerprint    string
+	StatusCode     int64
+	ResponseHeader []byte
+	ResponseBody   []byte
+	CreatedAt      time.Time
+}
+
+func (q *Queries) SelectIdempotencyKey(ctx context.Context, arg SelectIdempotencyKeyParams) (SelectIdempotencyKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, selectIdempotencyKey, arg.UserID, arg.Key)
+	var i SelectIdempotencyKeyRow
+	err := row.Scan(
+		&i.Fingerprint,
+		&i.StatusCode,
+		&i.ResponseHeader,
+		&i.ResponseBody,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectLastOrderEventID.sql
+
+package sqlite
+
+import (
+	"context"
+)
+
+const selectLastOrderEventID = `-- name: SelectLastOrderEventID :one
+SELECT
+  CAST(COALESCE(MAX(id), 0) AS INTEGER) AS id
+FROM
+  order_status_history
+`
+
+func (q *Queries) SelectLastOrderEventID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, selectLastOrderEventID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
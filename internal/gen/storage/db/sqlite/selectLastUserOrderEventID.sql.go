@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectLastUserOrderEventID.sql
+
+package sqlite
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectLastUserOrderEventID = `-- name: SelectLastUserOrderEventID :one
+SELECT
+  CAST(COALESCE(MAX(order_status_history.id), 0) AS INTEGER) AS id
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  orders.user_id = ?1
+`
+
+func (q *Queries) SelectLastUserOrderEventID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, selectLastUserOrderEventID, userID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectLedgerEntries.sql
+
+package sqlite
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectLedgerEntries = `-- name: SelectLedgerEntries :many
+SELECT
+  id,
+  user_id,
+  kind,
+  amount,
+  order_number,
+  reason,
+  actor_id,
+  created_at
+FROM
+  ledger_entries
+WHERE
+  user_id = ?1
+ORDER BY
+  id DESC
+LIMIT
+  ?2
+`
+
+type SelectLedgerEntriesParams struct {
+	UserID     uuid.UUID
+	MaxEntries int64
+}
+
+func (q *Queries) SelectLedgerEntries(ctx context.Context, arg SelectLedgerEntriesParams) ([]LedgerEntry, error) {
+	rows, err := q.db.QueryContext(ctx, selectLedgerEntries, arg.UserID, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LedgerEntry
+	for rows.Next() {
+		var i LedgerEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Amount,
+			&i.OrderNumber,
+			&i.Reason,
+			&i.ActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
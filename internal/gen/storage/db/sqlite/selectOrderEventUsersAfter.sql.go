@@ -0,0 +1,53 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrderEventUsersAfter.sql
+
+package sqlite
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectOrderEventUsersAfter = `-- name: SelectOrderEventUsersAfter :many
+SELECT
+  orders.user_id,
+  CAST(MAX(order_status_history.id) AS INTEGER) AS last_id
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  order_status_history.id > ?
+GROUP BY
+  orders.user_id
+`
+
+type SelectOrderEventUsersAfterRow struct {
+	UserID uuid.UUID
+	LastID int64
+}
+
+func (q *Queries) SelectOrderEventUsersAfter(ctx context.Context, id int64) ([]SelectOrderEventUsersAfterRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectOrderEventUsersAfter, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectOrderEventUsersAfterRow
+	for rows.Next() {
+		var i SelectOrderEventUsersAfterRow
+		if err := rows.Scan(&i.UserID, &i.LastID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
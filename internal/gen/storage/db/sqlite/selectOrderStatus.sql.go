@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrderStatus.sql
+
+package sqlite
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectOrderStatus = `-- name: SelectOrderStatus :one
+SELECT
+  id,
+  user_id,
+  status,
+  accrual
+FROM
+  orders
+WHERE
+  number = ?
+`
+
+type SelectOrderStatusRow struct {
+	ID      uuid.UUID
+	UserID  uuid.UUID
+	Status  order.Status
+	Accrual *money.Amount
+}
+
+func (q *Queries) SelectOrderStatus(ctx context.Context, number string) (SelectOrderStatusRow, error) {
+	row := q.db.QueryRowContext(ctx, selectOrderStatus, number)
+	var i SelectOrderStatusRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.Accrual,
+	)
+	return i, err
+}
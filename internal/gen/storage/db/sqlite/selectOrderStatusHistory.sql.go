@@ -0,0 +1,63 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrderStatusHistory.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectOrderStatusHistory = `-- name: SelectOrderStatusHistory :many
+SELECT
+  from_status,
+  to_status,
+  source,
+  created_at
+FROM
+  order_status_history
+WHERE
+  order_id = ?
+ORDER BY
+  id ASC
+`
+
+type SelectOrderStatusHistoryRow struct {
+	FromStatus *order.Status
+	ToStatus   order.Status
+	Source     order.Source
+	CreatedAt  time.Time
+}
+
+func (q *Queries) SelectOrderStatusHistory(ctx context.Context, orderID uuid.UUID) ([]SelectOrderStatusHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectOrderStatusHistory, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectOrderStatusHistoryRow
+	for rows.Next() {
+		var i SelectOrderStatusHistoryRow
+		if err := rows.Scan(
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Source,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
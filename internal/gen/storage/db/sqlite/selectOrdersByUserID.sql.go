@@ -0,0 +1,110 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOrdersByUserID.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const selectOrdersByUserID = `-- name: SelectOrdersByUserID :many
+SELECT
+  id,
+  number,
+  status,
+  accrual,
+  created_at
+FROM
+  orders
+WHERE
+  user_id = ?1
+  AND (
+    CAST(?2 AS TEXT) = ''
+    OR instr(?2, ',' || status || ',') > 0
+  )
+  AND (
+    created_at >= ?3
+    OR ?3 IS NULL
+  )
+  AND (
+    created_at < ?4
+    OR ?4 IS NULL
+  )
+  AND (
+    created_at > ?5
+    OR ?5 IS NULL
+    OR (
+      created_at = ?5
+      AND id > ?6
+    )
+  )
+ORDER BY
+  created_at ASC,
+  id ASC
+LIMIT
+  ?7
+`
+
+type SelectOrdersByUserIDParams struct {
+	UserID         uuid.UUID
+	Statuses       string
+	CreatedFrom    sql.NullTime
+	CreatedTo      sql.NullTime
+	AfterCreatedAt sql.NullTime
+	AfterID        uuid.UUID
+	MaxRows        int64
+}
+
+type SelectOrdersByUserIDRow struct {
+	ID        uuid.UUID
+	Number    string
+	Status    order.Status
+	Accrual   *money.Amount
+	CreatedAt time.Time
+}
+
+// statuses is the list of the statuses delimited and surrounded by commas, e.g. ",NEW,PROCESSING,". Any status matches if it is empty
+func (q *Queries) SelectOrdersByUserID(ctx context.Context, arg SelectOrdersByUserIDParams) ([]SelectOrdersByUserIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectOrdersByUserID,
+		arg.UserID,
+		arg.Statuses,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectOrdersByUserIDRow
+	for rows.Next() {
+		var i SelectOrdersByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Number,
+			&i.Status,
+			&i.Accrual,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,87 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectOutboxEvents.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const selectOutboxEvents = `-- name: SelectOutboxEvents :many
+SELECT
+  id,
+  user_id,
+  type,
+  data,
+  created_at,
+  attempts
+FROM
+  outbox_events
+WHERE
+  published_at IS NULL
+  AND dead_at IS NULL
+  AND user_id NOT IN (
+    SELECT
+      held.user_id
+    FROM
+      outbox_events held
+    WHERE
+      held.published_at IS NULL
+      AND held.dead_at IS NULL
+      AND held.next_attempt_at > ?1
+  )
+ORDER BY
+  id ASC
+LIMIT
+  ?2
+`
+
+type SelectOutboxEventsParams struct {
+	Now       sql.NullTime
+	MaxEvents int64
+}
+
+type SelectOutboxEventsRow struct {
+	ID        int64
+	UserID    uuid.UUID
+	Type      string
+	Data      string
+	CreatedAt time.Time
+	Attempts  int64
+}
+
+func (q *Queries) SelectOutboxEvents(ctx context.Context, arg SelectOutboxEventsParams) ([]SelectOutboxEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectOutboxEvents, arg.Now, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectOutboxEventsRow
+	for rows.Next() {
+		var i SelectOutboxEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.Data,
+			&i.CreatedAt,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
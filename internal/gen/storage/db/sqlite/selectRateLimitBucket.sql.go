@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectRateLimitBucket.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+)
+
+const selectRateLimitBucket = `-- name: SelectRateLimitBucket :one
+SELECT
+  tokens,
+  updated_at
+FROM
+  rate_limit_buckets
+WHERE
+  key = ?
+`
+
+type SelectRateLimitBucketRow struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+func (q *Queries) SelectRateLimitBucket(ctx context.Context, key string) (SelectRateLimitBucketRow, error) {
+	row := q.db.QueryRowContext(ctx, selectRateLimitBucket, key)
+	var i SelectRateLimitBucketRow
+	err := row.Scan(&i.Tokens, &i.UpdatedAt)
+	return i, err
+}
@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserBalance.sql
+
+package sqlite
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const selectUserBalance = `-- name: SelectUserBalance :one
+SELECT
+  CAST(COALESCE(SUM(amount), 0) AS INTEGER) AS balance,
+  CAST(COALESCE(SUM(CASE WHEN kind = 'withdrawal' THEN -amount END), 0) AS INTEGER) AS withdrawn
+FROM
+  ledger_entries
+WHERE
+  user_id = ?
+`
+
+type SelectUserBalanceRow struct {
+	Balance   int64
+	Withdrawn int64
+}
+
+func (q *Queries) SelectUserBalance(ctx context.Context, userID uuid.UUID) (SelectUserBalanceRow, error) {
+	row := q.db.QueryRowContext(ctx, selectUserBalance, userID)
+	var i SelectUserBalanceRow
+	err := row.Scan(&i.Balance, &i.Withdrawn)
+	return i, err
+}
@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: selectUserByID.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/role"
+)
+
+const selectUserByID = `-- name: SelectUserByID :one
+SELECT
+  id,
+  login,
+  role,
+  created_at
+FROM
+  users
+WHERE
+  id = ?
+`
+
+type SelectUserByIDRow struct {
+	ID        uuid.UUID
+	Login     string
+	Role      role.Role
+	CreatedAt time.Time
+}
+
+func (q *Queries) SelectUserByID(ctx context.Context, id uuid.UUID) (SelectUserByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, selectUserByID, id)
+	var i SelectUserByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Login,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
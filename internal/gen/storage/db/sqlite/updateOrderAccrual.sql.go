@@ -0,0 +1,44 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateOrderAccrual.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+const updateOrderAccrual = `-- name: UpdateOrderAccrual :execresult
+UPDATE orders
+SET
+  status = ?1,
+  accrual = ?2,
+  processed_at = ?3
+WHERE
+  id = ?4
+  AND status = ?5
+`
+
+type UpdateOrderAccrualParams struct {
+	Status      order.Status
+	Accrual     *money.Amount
+	ProcessedAt sql.NullTime
+	ID          uuid.UUID
+	FromStatus  order.Status
+}
+
+func (q *Queries) UpdateOrderAccrual(ctx context.Context, arg UpdateOrderAccrualParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateOrderAccrual,
+		arg.Status,
+		arg.Accrual,
+		arg.ProcessedAt,
+		arg.ID,
+		arg.FromStatus,
+	)
+}
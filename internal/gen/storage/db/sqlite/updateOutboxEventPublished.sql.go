@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateOutboxEventPublished.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+)
+
+const updateOutboxEventPublished = `-- name: UpdateOutboxEventPublished :exec
+UPDATE outbox_events
+SET
+  published_at = ?1
+WHERE
+  id = ?2
+`
+
+type UpdateOutboxEventPublishedParams struct {
+	PublishedAt sql.NullTime
+	ID          int64
+}
+
+func (q *Queries) UpdateOutboxEventPublished(ctx context.Context, arg UpdateOutboxEventPublishedParams) error {
+	_, err := q.db.ExecContext(ctx, updateOutboxEventPublished, arg.PublishedAt, arg.ID)
+	return err
+}
@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateOutboxRelayLease.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const updateOutboxRelayLease = `-- name: UpdateOutboxRelayLease :execresult
+UPDATE outbox_relay_lease
+SET
+  owner = ?1,
+  lease_until = ?2
+WHERE
+  id = 1
+  AND (
+    owner = ?1
+    OR lease_until < ?3
+  )
+`
+
+type UpdateOutboxRelayLeaseParams struct {
+	Owner      string
+	LeaseUntil time.Time
+	Now        time.Time
+}
+
+// the lease is taken if it's held by the owner already or it has expired
+func (q *Queries) UpdateOutboxRelayLease(ctx context.Context, arg UpdateOutboxRelayLeaseParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateOutboxRelayLease, arg.Owner, arg.LeaseUntil, arg.Now)
+}
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateRateLimitBucket.sql
+
+package sqlite
+
+import (
+	"context"
+	"time"
+)
+
+const updateRateLimitBucket = `-- name: UpdateRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET
+  tokens = ?1,
+  updated_at = ?2,
+  full_at = ?3
+WHERE
+  key = ?4
+`
+
+type UpdateRateLimitBucketParams struct {
+	Tokens    float64
+	UpdatedAt time.Time
+	FullAt    time.Time
+	Key       string
+}
+
+func (q *Queries) UpdateRateLimitBucket(ctx context.Context, arg UpdateRateLimitBucketParams) error {
+	_, err := q.db.ExecContext(ctx, updateRateLimitBucket,
+		arg.Tokens,
+		arg.UpdatedAt,
+		arg.FullAt,
+		arg.Key,
+	)
+	return err
+}
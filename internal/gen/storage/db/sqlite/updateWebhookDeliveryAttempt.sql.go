@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: updateWebhookDeliveryAttempt.sql
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const updateWebhookDeliveryAttempt = `-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET
+  state = ?1,
+  attempts = ?2,
+  next_attempt_at = ?3,
+  last_error = ?4,
+  delivered_at = ?5
+WHERE
+  id = ?6
+`
+
+type UpdateWebhookDeliveryAttemptParams struct {
+	State         string
+	Attempts      int64
+	NextAttemptAt time.Time
+	LastError     sql.NullString
+	DeliveredAt   sql.NullTime
+	ID            int64
+}
+
+func (q *Queries) UpdateWebhookDeliveryAttempt(ctx context.Context, arg UpdateWebhookDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, updateWebhookDeliveryAttempt,
+		arg.State,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.DeliveredAt,
+		arg.ID,
+	)
+	return err
+}
@@ -0,0 +1,151 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook client
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package webhook
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "webhook" service client.
+type Client struct {
+	CreateWebhookEndpoint         goa.Endpoint
+	ListWebhooksEndpoint          goa.Endpoint
+	GetWebhookEndpoint            goa.Endpoint
+	UpdateWebhookEndpoint         goa.Endpoint
+	DeleteWebhookEndpoint         goa.Endpoint
+	ListWebhookDeliveriesEndpoint goa.Endpoint
+	RedeliverWebhookEndpoint      goa.Endpoint
+}
+
+// NewClient initializes a "webhook" service client given the endpoints.
+func NewClient(createWebhook, listWebhooks, getWebhook, updateWebhook, deleteWebhook, listWebhookDeliveries, redeliverWebhook goa.Endpoint) *Client {
+	return &Client{
+		CreateWebhookEndpoint:         createWebhook,
+		ListWebhooksEndpoint:          listWebhooks,
+		GetWebhookEndpoint:            getWebhook,
+		UpdateWebhookEndpoint:         updateWebhook,
+		DeleteWebhookEndpoint:         deleteWebhook,
+		ListWebhookDeliveriesEndpoint: listWebhookDeliveries,
+		RedeliverWebhookEndpoint:      redeliverWebhook,
+	}
+}
+
+// CreateWebhook calls the "CreateWebhook" endpoint of the "webhook" service.
+// CreateWebhook may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Webhook not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CreateWebhook(ctx context.Context, p *CreateWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.CreateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// ListWebhooks calls the "ListWebhooks" endpoint of the "webhook" service.
+// ListWebhooks may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Webhook not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListWebhooks(ctx context.Context, p *ListWebhooksPayload) (res []*Webhook, err error) {
+	var ires any
+	ires, err = c.ListWebhooksEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Webhook), nil
+}
+
+// GetWebhook calls the "GetWebhook" endpoint of the "webhook" service.
+// GetWebhook may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Webhook not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetWebhook(ctx context.Context, p *GetWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.GetWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// UpdateWebhook calls the "UpdateWebhook" endpoint of the "webhook" service.
+// UpdateWebhook may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Webhook not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) UpdateWebhook(ctx context.Context, p *UpdateWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.UpdateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// DeleteWebhook calls the "DeleteWebhook" endpoint of the "webhook" service.
+// DeleteWebhook may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Webhook not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) DeleteWebhook(ctx context.Context, p *DeleteWebhookPayload) (err error) {
+	_, err = c.DeleteWebhookEndpoint(ctx, p)
+	return
+}
+
+// ListWebhookDeliveries calls the "ListWebhookDeliveries" endpoint of the
+// "webhook" service.
+// ListWebhookDeliveries may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Webhook not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListWebhookDeliveries(ctx context.Context, p *ListWebhookDeliveriesPayload) (res []*WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.ListWebhookDeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WebhookDelivery), nil
+}
+
+// RedeliverWebhook calls the "RedeliverWebhook" endpoint of the "webhook"
+// service.
+// RedeliverWebhook may return the following errors:
+//   - "Invalid input parameter" (type *service.GophermartError)
+//   - "User is not authenticated" (type *service.GophermartError)
+//   - "Internal service error" (type *service.GophermartError)
+//   - "Webhook not found" (type *service.GophermartError)
+//   - "missing_field" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) RedeliverWebhook(ctx context.Context, p *RedeliverWebhookPayload) (err error) {
+	_, err = c.RedeliverWebhookEndpoint(ctx, p)
+	return
+}
@@ -0,0 +1,185 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook endpoints
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package webhook
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "webhook" service endpoints.
+type Endpoints struct {
+	CreateWebhook         goa.Endpoint
+	ListWebhooks          goa.Endpoint
+	GetWebhook            goa.Endpoint
+	UpdateWebhook         goa.Endpoint
+	DeleteWebhook         goa.Endpoint
+	ListWebhookDeliveries goa.Endpoint
+	RedeliverWebhook      goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "webhook" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		CreateWebhook:         NewCreateWebhookEndpoint(s, a.JWTAuth),
+		ListWebhooks:          NewListWebhooksEndpoint(s, a.JWTAuth),
+		GetWebhook:            NewGetWebhookEndpoint(s, a.JWTAuth),
+		UpdateWebhook:         NewUpdateWebhookEndpoint(s, a.JWTAuth),
+		DeleteWebhook:         NewDeleteWebhookEndpoint(s, a.JWTAuth),
+		ListWebhookDeliveries: NewListWebhookDeliveriesEndpoint(s, a.JWTAuth),
+		RedeliverWebhook:      NewRedeliverWebhookEndpoint(s, a.JWTAuth),
+	}
+}
+
+// Use applies the given middleware to all the "webhook" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.CreateWebhook = m(e.CreateWebhook)
+	e.ListWebhooks = m(e.ListWebhooks)
+	e.GetWebhook = m(e.GetWebhook)
+	e.UpdateWebhook = m(e.UpdateWebhook)
+	e.DeleteWebhook = m(e.DeleteWebhook)
+	e.ListWebhookDeliveries = m(e.ListWebhookDeliveries)
+	e.RedeliverWebhook = m(e.RedeliverWebhook)
+}
+
+// NewCreateWebhookEndpoint returns an endpoint function that calls the method
+// "CreateWebhook" of service "webhook".
+func NewCreateWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateWebhook(ctx, p)
+	}
+}
+
+// NewListWebhooksEndpoint returns an endpoint function that calls the method
+// "ListWebhooks" of service "webhook".
+func NewListWebhooksEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhooksPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWebhooks(ctx, p)
+	}
+}
+
+// NewGetWebhookEndpoint returns an endpoint function that calls the method
+// "GetWebhook" of service "webhook".
+func NewGetWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetWebhook(ctx, p)
+	}
+}
+
+// NewUpdateWebhookEndpoint returns an endpoint function that calls the method
+// "UpdateWebhook" of service "webhook".
+func NewUpdateWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateWebhook(ctx, p)
+	}
+}
+
+// NewDeleteWebhookEndpoint returns an endpoint function that calls the method
+// "DeleteWebhook" of service "webhook".
+func NewDeleteWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteWebhook(ctx, p)
+	}
+}
+
+// NewListWebhookDeliveriesEndpoint returns an endpoint function that calls the
+// method "ListWebhookDeliveries" of service "webhook".
+func NewListWebhookDeliveriesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhookDeliveriesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWebhookDeliveries(ctx, p)
+	}
+}
+
+// NewRedeliverWebhookEndpoint returns an endpoint function that calls the
+// method "RedeliverWebhook" of service "webhook".
+func NewRedeliverWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RedeliverWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"user", "support", "admin"},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.Authorization, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RedeliverWebhook(ctx, p)
+	}
+}
@@ -0,0 +1,178 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// webhook service
+//
+// Command:
+// $ goa gen github.com/oleshko-g/oggophermart/api/design -o internal/
+
+package webhook
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// The webhooks notify the partner systems of the user when the orders are
+// processed. The X-Gophermart-Signature header of a delivery is sha256=
+// followed by the hex of the HMAC-SHA256 of "<X-Gophermart-Timestamp>.<body>"
+// keyed by the secret of the webhook
+type Service interface {
+	// Subscribe the URL to the statuses of the user orders. The secret to verify
+	// the signatures of the deliveries is returned once
+	CreateWebhook(context.Context, *CreateWebhookPayload) (res *Webhook, err error)
+	// List the webhooks of the user in the order of their creation
+	ListWebhooks(context.Context, *ListWebhooksPayload) (res []*Webhook, err error)
+	// GetWebhook implements GetWebhook.
+	GetWebhook(context.Context, *GetWebhookPayload) (res *Webhook, err error)
+	// Replace the URL, the statuses and the activity of the webhook
+	UpdateWebhook(context.Context, *UpdateWebhookPayload) (res *Webhook, err error)
+	// Delete the webhook with its deliveries
+	DeleteWebhook(context.Context, *DeleteWebhookPayload) (err error)
+	// List the latest deliveries of the webhook
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesPayload) (res []*WebhookDelivery, err error)
+	// Deliver the event again. The delivery is retried from the first attempt,
+	// including the dead one
+	RedeliverWebhook(context.Context, *RedeliverWebhookPayload) (err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gophermart"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.2"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "webhook"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [7]string{"CreateWebhook", "ListWebhooks", "GetWebhook", "UpdateWebhook", "DeleteWebhook", "ListWebhookDeliveries", "RedeliverWebhook"}
+
+// CreateWebhookPayload is the payload type of the webhook service
+// CreateWebhook method.
+type CreateWebhookPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The http(s) URL the events are posted to
+	URL string
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string
+	// The events aren't delivered to the inactive webhook
+	Active bool
+}
+
+// DeleteWebhookPayload is the payload type of the webhook service
+// DeleteWebhook method.
+type DeleteWebhookPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the webhook
+	ID string
+}
+
+// GetWebhookPayload is the payload type of the webhook service GetWebhook
+// method.
+type GetWebhookPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the webhook
+	ID string
+}
+
+// ListWebhookDeliveriesPayload is the payload type of the webhook service
+// ListWebhookDeliveries method.
+type ListWebhookDeliveriesPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the webhook
+	ID string
+	// Only the deliveries in the state are listed
+	State *string
+	// The maximum number of the deliveries
+	Limit int
+}
+
+// ListWebhooksPayload is the payload type of the webhook service ListWebhooks
+// method.
+type ListWebhooksPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+}
+
+// RedeliverWebhookPayload is the payload type of the webhook service
+// RedeliverWebhook method.
+type RedeliverWebhookPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the webhook
+	ID string
+	// The ID of the delivery
+	DeliveryID int64
+}
+
+// UpdateWebhookPayload is the payload type of the webhook service
+// UpdateWebhook method.
+type UpdateWebhookPayload struct {
+	// A JWT token used to authenticate a request
+	Authorization string
+	// The ID of the webhook
+	ID string
+	// The http(s) URL the events are posted to
+	URL string
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string
+	// The events aren't delivered to the inactive webhook
+	Active bool
+}
+
+// Webhook is the result type of the webhook service CreateWebhook method.
+type Webhook struct {
+	ID string
+	// The key of the HMAC-SHA256 signatures of the deliveries. It's returned upon
+	// the creation only
+	Secret    *string
+	CreatedAt string
+	UpdatedAt string
+	// The http(s) URL the events are posted to
+	URL string
+	// The statuses of the orders which are notified. The list mustn't be empty
+	Statuses []string
+	// The events aren't delivered to the inactive webhook
+	Active bool
+}
+
+type WebhookDelivery struct {
+	// The ID of the delivery. It's the X-Gophermart-Delivery header of the request
+	ID int64
+	// The type of the event
+	Event string
+	// The order number
+	Number string
+	// pending: the delivery is attempted or retried, delivered: the partner
+	// responded with 2xx, dead: the attempts are exhausted
+	State string
+	// The number of the failed attempts
+	Attempts int
+	// The time of the next attempt of the pending delivery
+	NextAttemptAt *string
+	// The failure of the last attempt
+	LastError   *string
+	CreatedAt   string
+	DeliveredAt *string
+}
+
+// MakeMissingField builds a goa.ServiceError from an error.
+func MakeMissingField(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "missing_field", false, false, false)
+}
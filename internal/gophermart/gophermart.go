@@ -0,0 +1,768 @@
+// Package gophermart wires the storage, the services and the transports of the gophermart.
+// The gophermart is configured, set up and run in this order
+package gophermart
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	nethttp "net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/joho/godotenv"
+	genAccrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	genAccrualHTTPClient "github.com/oleshko-g/oggophermart/internal/gen/http/accrual/client"
+	"github.com/oleshko-g/oggophermart/internal/ratelimit"
+	"github.com/oleshko-g/oggophermart/internal/service"
+	"github.com/oleshko-g/oggophermart/internal/service/admin"
+	balance "github.com/oleshko-g/oggophermart/internal/service/balance"
+	"github.com/oleshko-g/oggophermart/internal/service/processing"
+	"github.com/oleshko-g/oggophermart/internal/service/relay"
+	user "github.com/oleshko-g/oggophermart/internal/service/user"
+	"github.com/oleshko-g/oggophermart/internal/service/webhook"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"github.com/oleshko-g/oggophermart/internal/storage/db"
+	"github.com/oleshko-g/oggophermart/internal/storage/db/pgx"
+	"github.com/oleshko-g/oggophermart/internal/storage/db/sql"
+	"github.com/oleshko-g/oggophermart/internal/storage/db/sqlite"
+	"github.com/oleshko-g/oggophermart/internal/storage/memory"
+	"github.com/oleshko-g/oggophermart/internal/transport/grpc"
+	"github.com/oleshko-g/oggophermart/internal/transport/http"
+	"goa.design/clue/log"
+	goahttp "goa.design/goa/v3/http"
+	"golang.org/x/sync/errgroup"
+)
+
+// Gophermart is the gophermart loyalty system
+type Gophermart struct {
+	transport struct {
+		http struct {
+			http.Server
+			http.Config
+			certs  *http.Certificates // nil if the server doesn't serve HTTPS
+			client struct {
+				accrual      atomic.Pointer[genAccrualHTTPClient.Client]
+				accrualCerts *http.Certificates // nil if the accrual system isn't called by HTTPS
+			}
+			metrics http.Server // nil if the metrics server is disabled
+		}
+		grpc struct {
+			grpc.Server // nil if the gRPC server is disabled
+			grpc.Config
+		}
+	}
+	service.Service
+	storage.Storage
+	dbCfg         db.Config
+	userCfg       user.Config
+	processingCfg processing.Config
+	balanceCfg    balance.Config
+	webhookCfg    webhook.Config
+	relayCfg      relay.Config
+	rateLimitCfg  ratelimit.Config
+	worker        *processing.Worker
+	orderEvents   *balance.OrderEvents
+	dispatcher    *webhook.Dispatcher
+	relay         *relay.Relay
+	idempotency   *http.Idempotency
+	rateLimiter   *http.RateLimiter
+	logLevel      logLevel
+	loggingCtx    context.Context
+	flags         *flag.FlagSet
+	processEnv    map[string]string // env vars set before loading the env file
+	configured    bool
+	readyToRun    bool
+}
+
+// New returns the [Gophermart] which logs by the logger of loggingCtx and serves the OpenAPI spec
+func New(loggingCtx context.Context, spec http.OpenAPISpec) *Gophermart {
+	g := &Gophermart{
+		loggingCtx: loggingCtx,
+		dbCfg:      db.NewConfig(),
+		flags:      flag.NewFlagSet("gophermart", flag.ContinueOnError),
+	}
+	g.transport.http.Docs().Spec = spec
+	return g
+}
+
+// logLevel is the minimal severity of the log entries to output
+type logLevel string
+
+// Supported log levels
+const (
+	logLevelDebug logLevel = "debug"
+	logLevelInfo  logLevel = "info"
+)
+
+var errUnsupportedLogLevel = errors.New("unsupported log level")
+
+func (l logLevel) String() string {
+	return string(l)
+}
+
+// Set validates a value of log level and sets it or returns an error
+func (l *logLevel) Set(s string) error {
+	switch logLevel(s) {
+	case logLevelDebug, logLevelInfo:
+		*l = logLevel(s)
+		return nil
+	}
+	return fmt.Errorf("%w: %s", errUnsupportedLogLevel, s)
+}
+
+// apply switches the logger in ctx to the log level.
+// The logger is shared by all the contexts derived from ctx so it takes effect immediately.
+func (l logLevel) apply(ctx context.Context) {
+	if l == logLevelDebug {
+		log.Context(ctx, log.WithDebug())
+		return
+	}
+	log.Context(ctx, log.WithNoDebug())
+}
+
+// Configure sets the gophermart config parameters in the following priority:
+//  1. command line flags parsed from args
+//  2. env vars
+//  3. default values
+//
+// If successful Configure sets the configured flag
+func (g *Gophermart) Configure(args []string) (err error) {
+
+	err = g.loadEnvVarsFromFile()
+	if err != nil {
+		return err
+	}
+
+	// HTTP host address
+	aF := g.transport.http.Address()
+	err = aF.Set("localhost:8080") // default
+	if err != nil {
+		return err
+	}
+
+	g.flags.Var(aF, "a", "The host address of the gophermart")
+
+	v, ok := os.LookupEnv("RUN_ADDRESS")
+	if ok {
+		err = aF.Set(v) // override the default
+		if err != nil {
+			return err
+		}
+	}
+
+	// gRPC host address. The gRPC server is disabled by default
+	gF := g.transport.grpc.Address()
+	g.flags.Var(gF, "g", "The host address of the gophermart gRPC server. The server is disabled if it's empty")
+
+	if v, ok := os.LookupEnv("GRPC_ADDRESS"); ok {
+		err = gF.Set(v)
+		if err != nil {
+			return fmt.Errorf("GRPC_ADDRESS: %w", err)
+		}
+	}
+
+	// Metrics host address. The metrics server is disabled by default
+	mF := g.transport.http.Metrics().Address()
+	g.flags.Var(mF, "m", "The loopback host address of the gophermart metrics server. The server is disabled if it's empty")
+
+	if v, ok := os.LookupEnv("METRICS_ADDRESS"); ok {
+		err = mF.Set(v)
+		if err != nil {
+			return fmt.Errorf("METRICS_ADDRESS: %w", err)
+		}
+	}
+
+	// DB
+	dF := g.dbCfg.DSN()
+	g.flags.Var(dF, "d", "Database connection address. The postgres:// scheme uses lib/pq, the pgx:// scheme uses pgx/v5, sqlite://path is a database file")
+
+	v, ok = os.LookupEnv("DATABASE_URI")
+	if ok {
+		err = dF.Set(v) // override the default
+		if err != nil {
+			return err
+		}
+	}
+
+	// DB connection pool, timeouts and transactions
+	for envVar, v := range map[string]flag.Value{
+		"DATABASE_MAX_OPEN_CONNS":    g.dbCfg.MaxOpenConns(),
+		"DATABASE_MAX_IDLE_CONNS":    g.dbCfg.MaxIdleConns(),
+		"DATABASE_CONN_MAX_LIFETIME": g.dbCfg.ConnMaxLifetime(),
+		"DATABASE_QUERY_TIMEOUT":     g.dbCfg.QueryTimeout(),
+		"DATABASE_CONNECT_TIMEOUT":   g.dbCfg.ConnectTimeout(),
+		"DATABASE_CONNECT_RETRIES":   g.dbCfg.ConnectRetries(),
+		"DATABASE_TX_ISOLATION":      g.dbCfg.TxIsolation(),
+		"DATABASE_TX_RETRIES":        g.dbCfg.TxRetries(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// The accrual system host address
+	rF := g.transport.http.AccrualAddress()
+	err = rF.Set(defaultAccrualAddress)
+	if err != nil {
+		return err
+	}
+
+	g.flags.Var(rF, "r", "Address of the accrual system")
+
+	if v, ok := os.LookupEnv("ACCRUAL_SYSTEM_ADDRESS"); ok {
+		err = rF.Set(v) // override the default
+		if err != nil {
+			return err
+		}
+	}
+
+	// Polling of the accrual system
+	for envVar, v := range map[string]flag.Value{
+		"ACCRUAL_POLL_INTERVAL": g.processingCfg.PollInterval(),
+		"ACCRUAL_WORKERS":       g.processingCfg.Workers(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// Streams of the order events
+	if v, ok := os.LookupEnv("ORDER_EVENTS_HEARTBEAT"); ok {
+		if err = g.balanceCfg.Heartbeat().Set(v); err != nil {
+			return fmt.Errorf("ORDER_EVENTS_HEARTBEAT: %w", err)
+		}
+	}
+
+	// Deliveries of the webhooks
+	for envVar, v := range map[string]flag.Value{
+		"WEBHOOK_POLL_INTERVAL": g.webhookCfg.PollInterval(),
+		"WEBHOOK_TIMEOUT":       g.webhookCfg.Timeout(),
+		"WEBHOOK_MAX_ATTEMPTS":  g.webhookCfg.MaxAttempts(),
+		"WEBHOOK_BACKOFF":       g.webhookCfg.Backoff(),
+		"WEBHOOK_MAX_BACKOFF":   g.webhookCfg.MaxBackoff(),
+		"WEBHOOK_DEV_MODE":      g.webhookCfg.DevMode(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// Relay of the outbox events
+	for envVar, v := range map[string]flag.Value{
+		"OUTBOX_SINKS":         g.relayCfg.Sinks(),
+		"OUTBOX_POLL_INTERVAL": g.relayCfg.PollInterval(),
+		"OUTBOX_TIMEOUT":       g.relayCfg.Timeout(),
+		"OUTBOX_RETENTION":     g.relayCfg.Retention(),
+		"OUTBOX_MAX_ATTEMPTS":  g.relayCfg.MaxAttempts(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// Server timeouts and request limits
+	for envVar, v := range map[string]flag.Value{
+		"HTTP_READ_HEADER_TIMEOUT": g.transport.http.ReadHeaderTimeout(),
+		"HTTP_READ_TIMEOUT":        g.transport.http.ReadTimeout(),
+		"HTTP_WRITE_TIMEOUT":       g.transport.http.WriteTimeout(),
+		"HTTP_IDLE_TIMEOUT":        g.transport.http.IdleTimeout(),
+		"HTTP_MAX_HEADER_BYTES":    g.transport.http.MaxHeaderBytes(),
+		"MAX_BODY_SIZE":            g.transport.http.BodyLimit().MaxSize(),
+		"MAX_BODY_SIZE_ROUTES":     g.transport.http.BodyLimit().Routes(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// Compression of the requests and the responses
+	for envVar, v := range map[string]flag.Value{
+		"COMPRESSION_MIN_SIZE":       g.transport.http.Compression().MinSize(),
+		"MAX_DECOMPRESSED_BODY_SIZE": g.transport.http.Compression().MaxDecompressedSize(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// API docs
+	for envVar, v := range map[string]flag.Value{
+		"API_DOCS_UI":         g.transport.http.Docs().UI(),
+		"API_DOCS_ASSETS_URL": g.transport.http.Docs().AssetsURL(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// Rate limits
+	for envVar, v := range map[string]flag.Value{
+		"RATE_LIMIT":        g.rateLimitCfg.Limit(),
+		"RATE_LIMIT_ROUTES": g.rateLimitCfg.Routes(),
+		"RATE_LIMIT_STORE":  g.rateLimitCfg.Store(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+
+	// TLS of the server and of the accrual system client
+	for envVar, v := range map[string]flag.Value{
+		"TLS_CERT_FILE":           g.transport.http.TLS().CertFile(),
+		"TLS_KEY_FILE":            g.transport.http.TLS().KeyFile(),
+		"TLS_CLIENT_CA_FILE":      g.transport.http.TLS().CAFile(),
+		"TLS_CLIENT_AUTH":         g.transport.http.TLS().ClientAuth(),
+		"TLS_MIN_VERSION":         g.transport.http.TLS().MinVersion(),
+		"TLS_CIPHER_POLICY":       g.transport.http.TLS().CipherPolicy(),
+		"TLS_RELOAD_INTERVAL":     g.transport.http.TLS().ReloadInterval(),
+		"ACCRUAL_TLS_CA_FILE":     g.transport.http.AccrualTLS().CAFile(),
+		"ACCRUAL_TLS_CERT_FILE":   g.transport.http.AccrualTLS().CertFile(),
+		"ACCRUAL_TLS_KEY_FILE":    g.transport.http.AccrualTLS().KeyFile(),
+		"ACCRUAL_TLS_MIN_VERSION": g.transport.http.AccrualTLS().MinVersion(),
+	} {
+		if v2, ok := os.LookupEnv(envVar); ok {
+			if err = v.Set(v2); err != nil {
+				return fmt.Errorf("%s: %w", envVar, err)
+			}
+		}
+	}
+	// the files of the client are watched as often as the files of the server
+	*g.transport.http.AccrualTLS().ReloadInterval() = *g.transport.http.TLS().ReloadInterval()
+
+	secretKey := g.userCfg.SecretAuthKey()
+	if v, ok := os.LookupEnv("JWT_SECRET"); ok {
+		err = secretKey.Set(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	previousSecretKeys := g.userCfg.PreviousSecretAuthKeys()
+	if v, ok := os.LookupEnv("JWT_PREVIOUS_SECRETS"); ok {
+		err = previousSecretKeys.Set(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if v, ok := os.LookupEnv("ADMIN_LOGIN"); ok {
+		err = g.userCfg.AdminLogin().Set(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Log level
+	lF := &g.logLevel
+	err = lF.Set(logLevelDebug.String()) // default
+	if err != nil {
+		return err
+	}
+
+	g.flags.Var(lF, "l", "Log level: debug or info")
+
+	if v, ok := os.LookupEnv("LOG_LEVEL"); ok {
+		err = lF.Set(v) // override the default
+		if err != nil {
+			return err
+		}
+	}
+
+	// if any of the flags are set they override the defaults or env vars
+	if err = g.flags.Parse(args); err != nil {
+		return err
+	}
+	g.logLevel.apply(g.loggingCtx)
+	log.Printf(g.loggingCtx, "gophermart host address is set to %s from the %s", aF.String(), aF.Source)
+	log.Printf(g.loggingCtx, "gophermart database connection is set to %s from the %s", dF.DriverName.String(), dF.Source)
+	log.Printf(g.loggingCtx, "gophermart database pool is set to %s open, %s idle connections, %s lifetime; query timeout %s",
+		g.dbCfg.MaxOpenConns(), g.dbCfg.MaxIdleConns(), g.dbCfg.ConnMaxLifetime(), g.dbCfg.QueryTimeout())
+	accrualAddress := rF.Load()
+	log.Printf(g.loggingCtx, "gophermart address of the accrual system is set to %s://%s from the %s", accrualAddress.Scheme, accrualAddress.String(), accrualAddress.Source)
+	if tlsCfg := g.transport.http.TLS(); tlsCfg.HasCertificate() {
+		log.Printf(g.loggingCtx, "gophermart serves HTTPS with TLS %s and the %s cipher policy; client certificates: %s",
+			tlsCfg.MinVersion(), tlsCfg.CipherPolicy(), clientCertificates(tlsCfg))
+	}
+	log.Printf(g.loggingCtx, "gophermart rate limit is set to %s, routes %s; the buckets are stored in the %s",
+		g.rateLimitCfg.Limit(), g.rateLimitCfg.Routes(), g.rateLimitCfg.Store())
+	g.configured = true
+	return nil
+}
+
+// defaultAccrualAddress is the address of the accrual system unless it's set
+const defaultAccrualAddress = "localhost:8081"
+
+const (
+	envFileName                    = ".env"
+	envFilePermissions os.FileMode = 0o644
+)
+
+// loadEnvVarsFromFile opens or if not exists creates the env file and loads env vars from it.
+func (g *Gophermart) loadEnvVarsFromFile() (err error) {
+
+	_, err = os.OpenFile(envFileName, os.O_RDONLY|os.O_CREATE, envFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	// remembers the env vars which take priority over the env file upon reloads
+	g.processEnv = make(map[string]string)
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			g.processEnv[k] = v
+		}
+	}
+
+	err = godotenv.Load(envFileName)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// bootstrapAdmin promotes the registered user of the admin login to the first admin
+func (g *Gophermart) bootstrapAdmin() error {
+	login := g.userCfg.AdminLogin().String()
+	if login == "" {
+		return nil
+	}
+	promoted, err := admin.Bootstrap(g.loggingCtx, g.Storage.Admin, g.Storage.User, login)
+	switch {
+	case errors.Is(err, admin.ErrUserNotFound):
+		log.Warnf(g.loggingCtx, "the admin login %s isn't registered. It's promoted to the admin at the startup after its registration", login)
+	case err != nil:
+		return fmt.Errorf("failed to promote the admin login: %w", err)
+	case promoted:
+		log.Infof(g.loggingCtx, "promoted the user %s to the admin", login)
+	default:
+		log.Infof(g.loggingCtx, "the admin login %s is ignored since there is an admin already", login)
+	}
+	return nil
+}
+
+// Setup readies the gopheramart to run.
+// It does the following:
+//  1. Sets the storage for each service
+//  2. Intanciates services with the set storage
+//  3. Instanciates the HTTP server
+//  4. Instanicates the Accrual system HTTP client
+//
+// If successful it sets readyToRun flag
+func (g *Gophermart) Setup() (err error) {
+	if !g.configured {
+		return errSetupGophermartNotConfigured
+	}
+	var dbStorage interface {
+		storage.User
+		storage.Balance
+		storage.Idempotency
+		storage.RateLimit
+		storage.OrderEvents
+		storage.Webhooks
+		storage.Outbox
+		storage.Admin
+	}
+	// the driver is chosen by the scheme of the DSN
+	switch g.dbCfg.DSN().DriverName {
+	case db.DriverNamePgx:
+		dbStorage, err = pgx.New(g.loggingCtx, &g.dbCfg)
+	case db.DriverNameSQLite:
+		dbStorage, err = sqlite.New(g.loggingCtx, &g.dbCfg)
+	default:
+		dbStorage, err = sql.New(g.loggingCtx, &g.dbCfg)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof(g.loggingCtx, "Connected the storage")
+
+	// 1. Sets the storage for each service
+	// wrap concrete type [*sql.Storage], [*pgx.Storage] or [*sqlite.Storage] struct with interfaces
+	g.Storage.User = dbStorage
+	log.Infof(g.loggingCtx, "set User service storage")
+	g.Storage.Balance = dbStorage
+	log.Infof(g.loggingCtx, "set Balance service storage")
+	g.Storage.Idempotency = dbStorage
+	g.Storage.OrderEvents = dbStorage
+	g.Storage.Webhooks = dbStorage
+	g.Storage.Outbox = dbStorage
+	g.Storage.Admin = dbStorage
+	g.Storage.RateLimit = memory.New()
+	if g.rateLimitCfg.Store().String() == ratelimit.StoreDatabase {
+		g.Storage.RateLimit = dbStorage // shared by the replicas
+	}
+
+	// 2. Intanciates services with the set storage
+	userSvc := user.New(&g.userCfg, g.Storage.User)
+	g.orderEvents = balance.NewOrderEvents(g.Storage.OrderEvents, &g.balanceCfg)
+	g.Service = service.Service{
+		User:    userSvc,
+		Balance: balance.New(g.Storage.Balance, g.orderEvents, userSvc),
+		Webhook: webhook.New(g.Storage.Webhooks, userSvc, &g.webhookCfg),
+		Admin:   admin.New(g.Storage.Admin, g.Storage.Balance, g.Storage.User, userSvc),
+	}
+	if err = g.bootstrapAdmin(); err != nil {
+		return err
+	}
+
+	// 3. Instanciates the HTTP server
+	if err = g.loadCertificates(); err != nil {
+		return err
+	}
+	g.rateLimiter = http.NewRateLimiter(g.Storage.RateLimit, userSvc, &g.rateLimitCfg)
+	g.idempotency = http.NewIdempotency(g.Storage.Idempotency, userSvc)
+	g.transport.http.Server, err = http.NewServer(g.loggingCtx, &g.transport.http.Config, g.Service, g.transport.http.certs,
+		http.Authentication(userSvc),                     // the token is authenticated once per request
+		g.rateLimiter.Handler,                            // rejects the requests before they are stored
+		http.Compression(g.transport.http.Compression()), // the idempotent responses are stored uncompressed
+		http.BodyLimit(g.transport.http.BodyLimit()),     // limits the decompressed bodies
+		g.idempotency.Handler,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the gRPC server serves TLS by the certificates of the HTTP server
+	if g.transport.grpc.Enabled() {
+		var tlsConfig *tls.Config
+		if g.transport.http.certs != nil {
+			tlsConfig = g.transport.http.certs.ServerConfig()
+		}
+		g.transport.grpc.Server = grpc.NewServer(g.loggingCtx, g.transport.grpc.Config, g.Service, tlsConfig,
+			int(g.transport.http.BodyLimit().MaxSize().Bytes(http.DefaultMaxBodySize)), // the messages are limited as the request bodies
+			grpc.Authentication(userSvc), // the token is authenticated once per call
+			grpc.RateLimit(g.rateLimiter),
+			grpc.Idempotency(g.Storage.Idempotency, userSvc, http.IdempotencyKeyTTL),
+		)
+	}
+
+	// the metrics aren't served by the API server
+	if g.transport.http.Metrics().Enabled() {
+		http.Metrics.Set("config_reloads", reloadMetrics)
+		g.transport.http.metrics = http.NewMetricsServer(g.transport.http.Metrics())
+	}
+
+	// 4. Instanicates the Accrual system HTTP client
+	// err = genAccrual.NewGetOrderEndpoint(a)
+	accrualAddress := g.transport.http.AccrualAddress().Load()
+	g.transport.http.client.accrual.Store(newAccrualClient(accrualAddress.Scheme, accrualAddress.String(), g.transport.http.client.accrualCerts))
+
+	// 5. Instanciates the worker which polls the accrual system
+	g.worker = processing.New(g.Storage.Balance, accrualSystem{&g.transport.http.client.accrual}, &g.processingCfg)
+
+	// 6. Instanciates the dispatcher of the webhook deliveries
+	g.dispatcher = webhook.NewDispatcher(g.Storage.Webhooks, webhook.NewClient(&g.webhookCfg), &g.webhookCfg)
+
+	// 7. Instanciates the relay of the outbox events
+	g.relay, err = relay.New(g.Storage.Outbox, http.NewClient(nil), &g.relayCfg)
+	if err != nil {
+		return err
+	}
+
+	g.readyToRun = true
+	return nil
+}
+
+// loadCertificates loads the TLS certificates of the server if it serves HTTPS
+// and of the accrual system client if the accrual system is called by HTTPS
+func (g *Gophermart) loadCertificates() (err error) {
+	tlsCfg := g.transport.http.TLS()
+	if (tlsCfg.CertFile().String() == "") != (tlsCfg.KeyFile().String() == "") {
+		return errTLSCertificateWithoutKey
+	}
+	if tlsCfg.CAFile().String() != "" && !tlsCfg.HasCertificate() {
+		return errTLSClientCAWithoutCertificate
+	}
+	if tlsCfg.HasCertificate() {
+		if g.transport.http.certs, err = http.NewCertificates(tlsCfg); err != nil {
+			return err
+		}
+	}
+
+	accrualTLSCfg := g.transport.http.AccrualTLS()
+	if (accrualTLSCfg.CertFile().String() == "") != (accrualTLSCfg.KeyFile().String() == "") {
+		return fmt.Errorf("accrual system client: %w", errTLSCertificateWithoutKey)
+	}
+	if g.transport.http.AccrualAddress().Load().Scheme == "https" {
+		if g.transport.http.client.accrualCerts, err = http.NewCertificates(accrualTLSCfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clientCertificates describes the verification of the client certificates by the server
+func clientCertificates(tlsCfg *http.TLSConfig) string {
+	if tlsCfg.CAFile().String() == "" {
+		return "not verified"
+	}
+	return tlsCfg.ClientAuth().String() + " by " + tlsCfg.CAFile().String()
+}
+
+var (
+	errTLSCertificateWithoutKey      = errors.New("the TLS certificate and its key must be set together")
+	errTLSClientCAWithoutCertificate = errors.New("the TLS client CA requires the TLS certificate of the server")
+)
+
+// accrualSystem calls the accrual system by the client which is current at the time of the call
+type accrualSystem struct {
+	client *atomic.Pointer[genAccrualHTTPClient.Client]
+}
+
+func (a accrualSystem) GetOrder(ctx context.Context, p *genAccrual.GetOrderPayload) (*genAccrual.GetOrderResult, error) {
+	return genAccrual.NewClient(a.client.Load().GetOrder()).GetOrder(ctx, p)
+}
+
+// newAccrualClient returns the HTTP client of the accrual system at the address.
+// The HTTPS connections are established by the TLS config of the certs
+func newAccrualClient(scheme, address string, certs *http.Certificates) *genAccrualHTTPClient.Client {
+	return genAccrualHTTPClient.NewClient(
+		scheme,
+		address,
+		http.NewClient(certs),
+		goahttp.RequestEncoder,
+		goahttp.ResponseDecoder,
+		true,
+	)
+}
+
+// Run launches the gophermart until ctx is done. Then the servers finish the current requests within the [ShutdownTimeout].
+// The HTTP server serves the listener if it isn't nil, it listens on the configured address otherwise
+func (g *Gophermart) Run(ctx context.Context, listener net.Listener) (err error) {
+	if !g.readyToRun {
+		return errSetupGophermartNotReadyToRun
+	}
+	if listener == nil {
+		if listener, err = net.Listen("tcp", g.transport.http.Address().String()); err != nil {
+			return err
+		}
+	}
+	errGroup, ctx := errgroup.WithContext(ctx)
+
+	errGroup.Go(func() error {
+		log.Infof(g.loggingCtx, "in HTTP server")
+		if err := g.transport.http.Server.Serve(listener); !errors.Is(err, nethttp.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+
+	if g.transport.grpc.Server != nil {
+		errGroup.Go(func() error {
+			log.Infof(g.loggingCtx, "in gRPC server")
+			return g.transport.grpc.Server.ListenAndServe()
+		})
+	}
+
+	if g.transport.http.metrics != nil {
+		errGroup.Go(func() error {
+			if err := g.transport.http.metrics.ListenAndServe(); !errors.Is(err, nethttp.ErrServerClosed) {
+				return err
+			}
+			return nil
+		})
+	}
+
+	errGroup.Go(func() error {
+		<-ctx.Done()
+		return g.shutdown()
+	})
+
+	errGroup.Go(func() error {
+		g.reloadOnSIGHUP(ctx)
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		return g.worker.Run(ctx)
+	})
+
+	errGroup.Go(func() error {
+		return g.orderEvents.Run(ctx)
+	})
+
+	errGroup.Go(func() error {
+		return g.dispatcher.Run(ctx)
+	})
+
+	errGroup.Go(func() error {
+		return g.relay.Run(ctx)
+	})
+
+	errGroup.Go(func() error {
+		return g.idempotency.Run(ctx)
+	})
+
+	errGroup.Go(func() error {
+		return g.rateLimiter.Run(ctx)
+	})
+
+	for _, certs := range []*http.Certificates{g.transport.http.certs, g.transport.http.client.accrualCerts} {
+		if certs != nil {
+			errGroup.Go(func() error {
+				return certs.Run(ctx)
+			})
+		}
+	}
+
+	scheme := "http"
+	if g.transport.http.certs != nil {
+		scheme = "https"
+	}
+	log.Printf(g.loggingCtx, "gophermart HTTP server is listening on %s://%s", scheme, listener.Addr())
+	if g.transport.grpc.Server != nil {
+		log.Printf(g.loggingCtx, "gophermart gRPC server is listening on %s", g.transport.grpc.Address().String())
+	}
+	if g.transport.http.metrics != nil {
+		log.Printf(g.loggingCtx, "gophermart metrics server is listening on http://%s%s", g.transport.http.Metrics().Address().String(), http.MetricsPath)
+	}
+	return errGroup.Wait()
+}
+
+// ShutdownTimeout is the time the servers are given to finish the current requests upon the shutdown
+const ShutdownTimeout = 10 * time.Second
+
+// shutdown stops the servers. The connections which are still active after the [ShutdownTimeout], like the event streams, are closed
+func (g *Gophermart) shutdown() error {
+	log.Printf(g.loggingCtx, "gophermart is shutting down")
+	ctx, cancel := context.WithTimeout(g.loggingCtx, ShutdownTimeout)
+	defer cancel()
+
+	if g.transport.grpc.Server != nil {
+		g.transport.grpc.Server.Shutdown(ctx)
+	}
+	if g.transport.http.metrics != nil {
+		_ = g.transport.http.metrics.Close() // the metrics are read at once
+	}
+	if err := g.transport.http.Server.Shutdown(ctx); errors.Is(err, context.DeadlineExceeded) {
+		return g.transport.http.Server.Close()
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+var errSetupGophermartNotConfigured = errors.New("can't setup. gophermart isn't configured")
+var errSetupGophermartNotReadyToRun = errors.New("can't run. gophermart isn't set up")
@@ -0,0 +1,355 @@
+package gophermart
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/oleshko-g/oggophermart/internal/ratelimit"
+	balance "github.com/oleshko-g/oggophermart/internal/service/balance"
+	"github.com/oleshko-g/oggophermart/internal/service/processing"
+	"github.com/oleshko-g/oggophermart/internal/service/relay"
+	"github.com/oleshko-g/oggophermart/internal/service/webhook"
+	"github.com/oleshko-g/oggophermart/internal/transport/http"
+	"goa.design/clue/log"
+)
+
+// reloadMetrics is served by the metrics server at the [http.MetricsPath]
+var reloadMetrics = new(expvar.Map)
+
+// Names of [reloadMetrics]
+const (
+	reloadMetricSucceeded     = "succeeded"
+	reloadMetricFailed        = "failed"
+	reloadMetricChanged       = "changed_settings"
+	reloadMetricRestartNeeded = "restart_required_settings"
+	reloadMetricLastReloadAt  = "last_reload_unix"
+)
+
+// setting is a configuration parameter which is set from an env var or a command line flag
+type setting struct {
+	envVar string
+	flag   string
+	secret bool // the value isn't logged
+	// value returns the current value. If it's nil then the value of the env var is current
+	value func() string
+	// apply sets the value and takes effect on the running gophermart.
+	// If it's nil then the setting requires a restart to change
+	apply func(v string) error
+	// defaultValue is applied if the env var is removed
+	defaultValue string
+	// noDefault means the value is kept if the env var is removed
+	noDefault bool
+}
+
+// settings returns the gophermart configuration parameters watched upon a reload
+func (g *Gophermart) settings() []setting {
+	return []setting{
+		{
+			envVar: "RUN_ADDRESS",
+			flag:   "a",
+			value:  g.transport.http.Address().String,
+		},
+		{
+			envVar: "GRPC_ADDRESS",
+			flag:   "g",
+			value:  g.transport.grpc.Address().String,
+		},
+		{
+			envVar: "METRICS_ADDRESS",
+			flag:   "m",
+			value:  g.transport.http.Metrics().Address().String,
+		},
+		{
+			envVar: "DATABASE_URI",
+			flag:   "d",
+			secret: true,
+		},
+		{envVar: "DATABASE_MAX_OPEN_CONNS", value: g.dbCfg.MaxOpenConns().String},
+		{envVar: "DATABASE_MAX_IDLE_CONNS", value: g.dbCfg.MaxIdleConns().String},
+		{envVar: "DATABASE_CONN_MAX_LIFETIME", value: g.dbCfg.ConnMaxLifetime().String},
+		{envVar: "DATABASE_QUERY_TIMEOUT", value: g.dbCfg.QueryTimeout().String},
+		{envVar: "DATABASE_CONNECT_TIMEOUT", value: g.dbCfg.ConnectTimeout().String},
+		{envVar: "DATABASE_CONNECT_RETRIES", value: g.dbCfg.ConnectRetries().String},
+		{envVar: "DATABASE_TX_ISOLATION", value: g.dbCfg.TxIsolation().String},
+		{envVar: "DATABASE_TX_RETRIES", value: g.dbCfg.TxRetries().String},
+		{
+			envVar:       "LOG_LEVEL",
+			flag:         "l",
+			value:        g.logLevel.String,
+			defaultValue: logLevelDebug.String(),
+			apply: func(v string) error {
+				var l logLevel
+				if err := l.Set(v); err != nil {
+					return err
+				}
+				g.logLevel = l
+				l.apply(g.loggingCtx)
+				return nil
+			},
+		},
+		{
+			envVar:       "ACCRUAL_SYSTEM_ADDRESS",
+			flag:         "r",
+			value:        g.transport.http.AccrualAddress().String,
+			defaultValue: defaultAccrualAddress,
+			apply: func(v string) error {
+				// the running accrual client keeps its address until the new one is stored
+				a := new(http.Config).AccrualAddress()
+				if err := a.Set(v); err != nil {
+					return err
+				}
+				next := a.Load()
+				certs := g.transport.http.client.accrualCerts
+				if next.Scheme == "https" && certs == nil {
+					return errAccrualHTTPSRequiresRestart
+				}
+				g.transport.http.client.accrual.Store(newAccrualClient(next.Scheme, next.String(), certs))
+				return g.transport.http.AccrualAddress().Set(v)
+			},
+		},
+		{
+			envVar:       "ACCRUAL_POLL_INTERVAL",
+			value:        g.processingCfg.PollInterval().String,
+			apply:        g.processingCfg.PollInterval().Set,
+			defaultValue: processing.DefaultPollInterval.String(),
+		},
+		{
+			envVar:       "ACCRUAL_WORKERS",
+			value:        g.processingCfg.Workers().String,
+			apply:        g.processingCfg.Workers().Set,
+			defaultValue: strconv.Itoa(processing.DefaultWorkers),
+		},
+		{
+			envVar:       "ORDER_EVENTS_HEARTBEAT",
+			value:        g.balanceCfg.Heartbeat().String,
+			apply:        g.balanceCfg.Heartbeat().Set,
+			defaultValue: balance.DefaultHeartbeat.String(),
+		},
+		{
+			envVar:       "WEBHOOK_POLL_INTERVAL",
+			value:        g.webhookCfg.PollInterval().String,
+			apply:        g.webhookCfg.PollInterval().Set,
+			defaultValue: webhook.DefaultPollInterval.String(),
+		},
+		{
+			envVar:       "WEBHOOK_TIMEOUT",
+			value:        g.webhookCfg.Timeout().String,
+			apply:        g.webhookCfg.Timeout().Set,
+			defaultValue: webhook.DefaultTimeout.String(),
+		},
+		{
+			envVar:       "WEBHOOK_MAX_ATTEMPTS",
+			value:        g.webhookCfg.MaxAttempts().String,
+			apply:        g.webhookCfg.MaxAttempts().Set,
+			defaultValue: strconv.Itoa(webhook.DefaultMaxAttempts),
+		},
+		{
+			envVar:       "WEBHOOK_BACKOFF",
+			value:        g.webhookCfg.Backoff().String,
+			apply:        g.webhookCfg.Backoff().Set,
+			defaultValue: webhook.DefaultBackoff.String(),
+		},
+		{
+			envVar:       "WEBHOOK_MAX_BACKOFF",
+			value:        g.webhookCfg.MaxBackoff().String,
+			apply:        g.webhookCfg.MaxBackoff().Set,
+			defaultValue: webhook.DefaultMaxBackoff.String(),
+		},
+		{envVar: "OUTBOX_SINKS", value: g.relayCfg.Sinks().String},
+		{
+			envVar:       "OUTBOX_POLL_INTERVAL",
+			value:        g.relayCfg.PollInterval().String,
+			apply:        g.relayCfg.PollInterval().Set,
+			defaultValue: relay.DefaultPollInterval.String(),
+		},
+		{
+			envVar:       "OUTBOX_TIMEOUT",
+			value:        g.relayCfg.Timeout().String,
+			apply:        g.relayCfg.Timeout().Set,
+			defaultValue: relay.DefaultTimeout.String(),
+		},
+		{
+			envVar:       "OUTBOX_RETENTION",
+			value:        g.relayCfg.Retention().String,
+			apply:        g.relayCfg.Retention().Set,
+			defaultValue: relay.DefaultRetention.String(),
+		},
+		{
+			envVar:       "OUTBOX_MAX_ATTEMPTS",
+			value:        g.relayCfg.MaxAttempts().String,
+			apply:        g.relayCfg.MaxAttempts().Set,
+			defaultValue: strconv.Itoa(relay.DefaultMaxAttempts),
+		},
+		{
+			envVar:       "RATE_LIMIT",
+			value:        g.rateLimitCfg.Limit().String,
+			apply:        g.rateLimitCfg.Limit().Set,
+			defaultValue: ratelimit.DefaultLimit.String(),
+		},
+		{
+			envVar:       "RATE_LIMIT_ROUTES",
+			value:        g.rateLimitCfg.Routes().String,
+			apply:        g.rateLimitCfg.Routes().Set,
+			defaultValue: new(ratelimit.Config).Routes().String(),
+		},
+		{envVar: "HTTP_READ_HEADER_TIMEOUT", value: g.transport.http.ReadHeaderTimeout().String},
+		{envVar: "HTTP_READ_TIMEOUT", value: g.transport.http.ReadTimeout().String},
+		{envVar: "HTTP_WRITE_TIMEOUT", value: g.transport.http.WriteTimeout().String},
+		{envVar: "HTTP_IDLE_TIMEOUT", value: g.transport.http.IdleTimeout().String},
+		{envVar: "HTTP_MAX_HEADER_BYTES", value: g.transport.http.MaxHeaderBytes().String},
+		{envVar: "MAX_BODY_SIZE", value: g.transport.http.BodyLimit().MaxSize().String},
+		{envVar: "MAX_BODY_SIZE_ROUTES", value: g.transport.http.BodyLimit().Routes().String},
+		{envVar: "COMPRESSION_MIN_SIZE", value: g.transport.http.Compression().MinSize().String},
+		{envVar: "MAX_DECOMPRESSED_BODY_SIZE", value: g.transport.http.Compression().MaxDecompressedSize().String},
+		{envVar: "RATE_LIMIT_STORE", value: g.rateLimitCfg.Store().String},
+		{envVar: "API_DOCS_UI", value: g.transport.http.Docs().UI().String},
+		{envVar: "API_DOCS_ASSETS_URL", value: g.transport.http.Docs().AssetsURL().String},
+		// the protections of the internal network aren't lifted at runtime
+		{envVar: "WEBHOOK_DEV_MODE", value: g.webhookCfg.DevMode().String},
+		// the certificates are reloaded when their files change
+		{envVar: "TLS_CERT_FILE", value: g.transport.http.TLS().CertFile().String},
+		{envVar: "TLS_KEY_FILE", value: g.transport.http.TLS().KeyFile().String},
+		{envVar: "TLS_CLIENT_CA_FILE", value: g.transport.http.TLS().CAFile().String},
+		{envVar: "TLS_CLIENT_AUTH", value: g.transport.http.TLS().ClientAuth().String},
+		{envVar: "TLS_MIN_VERSION", value: g.transport.http.TLS().MinVersion().String},
+		{envVar: "TLS_CIPHER_POLICY", value: g.transport.http.TLS().CipherPolicy().String},
+		{envVar: "TLS_RELOAD_INTERVAL", value: g.transport.http.TLS().ReloadInterval().String},
+		{envVar: "ACCRUAL_TLS_CA_FILE", value: g.transport.http.AccrualTLS().CAFile().String},
+		{envVar: "ACCRUAL_TLS_CERT_FILE", value: g.transport.http.AccrualTLS().CertFile().String},
+		{envVar: "ACCRUAL_TLS_KEY_FILE", value: g.transport.http.AccrualTLS().KeyFile().String},
+		{envVar: "ACCRUAL_TLS_MIN_VERSION", value: g.transport.http.AccrualTLS().MinVersion().String},
+		{
+			envVar: "JWT_SECRET",
+			secret: true,
+			apply:  g.userCfg.SecretAuthKey().Set,
+			// the tokens aren't signed by an empty key
+			noDefault: true,
+		},
+		{
+			envVar: "JWT_PREVIOUS_SECRETS",
+			secret: true,
+			apply:  g.userCfg.PreviousSecretAuthKeys().Set,
+		},
+		// the first admin is promoted at the startup only
+		{envVar: "ADMIN_LOGIN", value: g.userCfg.AdminLogin().String},
+	}
+}
+
+// reloadOnSIGHUP reloads the configuration upon every SIGHUP until ctx is done
+func (g *Gophermart) reloadOnSIGHUP(ctx context.Context) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sighup:
+			log.Printf(g.loggingCtx, "got SIGHUP, reloading the configuration")
+			if err := g.reload(); err != nil {
+				reloadMetrics.Add(reloadMetricFailed, 1)
+				log.Errorf(g.loggingCtx, err, "failed to reload the configuration")
+				continue
+			}
+			reloadMetrics.Add(reloadMetricSucceeded, 1)
+		}
+	}
+}
+
+// reload re-reads the env file and applies the settings which are safe to change at runtime.
+// The settings whose env vars are removed are reset to their defaults.
+// The settings which are set by command line flags keep their values
+// and the settings which can't change at runtime are reported as requiring a restart.
+// It logs every change as a diff of the setting values.
+func (g *Gophermart) reload() error {
+	fileEnv, err := godotenv.Read(envFileName)
+	if err != nil {
+		return err
+	}
+
+	setByFlag := make(map[string]bool)
+	g.flags.Visit(func(f *flag.Flag) {
+		setByFlag[f.Name] = true
+	})
+
+	defer reloadMetrics.Set(reloadMetricLastReloadAt, expvarInt(time.Now().Unix()))
+
+	var errs []error
+	for _, s := range g.settings() {
+		newValue, ok := g.processEnv[s.envVar] // process env vars take priority over the env file
+		if !ok {
+			newValue, ok = fileEnv[s.envVar]
+		}
+		oldValue, wasSet := os.LookupEnv(s.envVar)
+		removed := !ok && wasSet
+		if !ok && !removed || ok && newValue == oldValue {
+			continue
+		}
+
+		shownValue := newValue
+		if removed {
+			newValue = s.defaultValue
+			shownValue = "the default " + newValue
+		}
+		if s.value != nil {
+			oldValue = s.value()
+		}
+		diff := oldValue + " -> " + shownValue
+		if s.secret {
+			diff = "<redacted> -> <redacted>"
+		}
+
+		if removed && s.noDefault {
+			log.Warnf(g.loggingCtx, "config reload: %s is removed but it has no default. The value is kept", s.envVar)
+			continue
+		}
+
+		if s.flag != "" && setByFlag[s.flag] {
+			log.Warnf(g.loggingCtx, "config reload: %s %s ignored. It's set by the command line flag -%s", s.envVar, diff, s.flag)
+			continue
+		}
+
+		if s.apply == nil {
+			reloadMetrics.Add(reloadMetricRestartNeeded, 1)
+			log.Warnf(g.loggingCtx, "config reload: %s %s requires a restart", s.envVar, diff)
+			continue
+		}
+
+		if err = s.apply(newValue); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", s.envVar, diff, err))
+			continue
+		}
+		if removed {
+			err = os.Unsetenv(s.envVar)
+		} else {
+			err = os.Setenv(s.envVar, newValue)
+		}
+		if err != nil {
+			return err
+		}
+		reloadMetrics.Add(reloadMetricChanged, 1)
+		log.Printf(g.loggingCtx, "config reload: %s %s", s.envVar, diff)
+	}
+
+	return errors.Join(errs...)
+}
+
+// errAccrualHTTPSRequiresRestart means the accrual system client has no TLS config to switch to HTTPS
+var errAccrualHTTPSRequiresRestart = errors.New("switching the accrual system to https requires a restart")
+
+// expvarInt converts i to [expvar.Var]
+func expvarInt(i int64) expvar.Var {
+	v := new(expvar.Int)
+	v.Set(i)
+	return v
+}
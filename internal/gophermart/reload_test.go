@@ -0,0 +1,205 @@
+package gophermart
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"io"
+	"net"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	genAccrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	"github.com/oleshko-g/oggophermart/internal/service/processing"
+	"github.com/oleshko-g/oggophermart/internal/transport/http"
+	"goa.design/clue/log"
+)
+
+// newTestGophermart returns the gophermart set up by the args and the env file lines
+// with its database and env file in a temporary working directory
+func newTestGophermart(t *testing.T, args []string, envFile ...string) *Gophermart {
+	t.Helper()
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("JWT_SECRET", "test")
+	writeEnvFile(t, envFile...)
+
+	g := New(log.Context(context.Background(), log.WithOutput(io.Discard)), http.OpenAPISpec{})
+	args = append([]string{"-d", "sqlite://" + filepath.Join(dir, "gophermart.db")}, args...)
+	if err := g.Configure(args); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Setup(); err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+// writeEnvFile replaces the env file in the working directory by the lines
+func writeEnvFile(t *testing.T, lines ...string) {
+	t.Helper()
+	if err := os.WriteFile(envFileName, []byte(strings.Join(lines, "\n")), envFilePermissions); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// unsetenv unsets the env var which is reloaded from the env file. It's restored when the test finishes
+func unsetenv(t *testing.T, keys ...string) {
+	t.Helper()
+	for _, k := range keys {
+		t.Setenv(k, "")
+		if err := os.Unsetenv(k); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// reloadMetric returns the value of the reload metric
+func reloadMetric(name string) int64 {
+	if v, ok := reloadMetrics.Get(name).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestReload(t *testing.T) {
+	unsetenv(t, "ACCRUAL_POLL_INTERVAL", "LOG_LEVEL", "HTTP_WRITE_TIMEOUT", "ACCRUAL_WORKERS")
+	t.Setenv("WEBHOOK_TIMEOUT", "3s")
+	g := newTestGophermart(t, []string{"-l", "info"})
+	writeTimeout := g.transport.http.WriteTimeout().String()
+	changed := reloadMetric(reloadMetricChanged)
+	restartNeeded := reloadMetric(reloadMetricRestartNeeded)
+
+	writeEnvFile(t,
+		"ACCRUAL_POLL_INTERVAL=250ms",
+		"LOG_LEVEL=debug",
+		"HTTP_WRITE_TIMEOUT=5s",
+		"WEBHOOK_TIMEOUT=7s",
+	)
+	if err := g.reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		value func() string
+		want  string
+	}{
+		{"the runtime setting is applied", g.processingCfg.PollInterval().String, "250ms"},
+		{"the setting of the flag is kept", g.logLevel.String, "info"},
+		{"the setting requiring a restart is kept", g.transport.http.WriteTimeout().String, writeTimeout},
+		{"the process env var takes priority", g.webhookCfg.Timeout().String, "3s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if got := reloadMetric(reloadMetricChanged) - changed; got != 1 {
+		t.Errorf("got %d changed settings, want 1", got)
+	}
+	if got := reloadMetric(reloadMetricRestartNeeded) - restartNeeded; got != 1 {
+		t.Errorf("got %d settings requiring a restart, want 1", got)
+	}
+
+	t.Run("invalid value", func(t *testing.T) {
+		writeEnvFile(t, "ACCRUAL_WORKERS=-1")
+		if err := g.reload(); err == nil {
+			t.Error("the invalid value is applied")
+		}
+	})
+
+	t.Run("removed setting", func(t *testing.T) {
+		writeEnvFile(t, "HTTP_WRITE_TIMEOUT=5s")
+		if err := g.reload(); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := g.processingCfg.PollInterval().String(), processing.DefaultPollInterval.String(); got != want {
+			t.Errorf("got %s, want the default %s", got, want)
+		}
+		if _, ok := os.LookupEnv("ACCRUAL_POLL_INTERVAL"); ok {
+			t.Error("the env var of the removed setting is still set")
+		}
+	})
+}
+
+func TestReloadAccrualAddress(t *testing.T) {
+	unsetenv(t, "ACCRUAL_SYSTEM_ADDRESS")
+	// accrualServer returns the address of the accrual system which counts its requests
+	accrualServer := func(requests *atomic.Int32) string {
+		srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+			requests.Add(1)
+			w.WriteHeader(nethttp.StatusNoContent)
+		}))
+		t.Cleanup(srv.Close)
+		return fmt.Sprintf("localhost:%d", srv.Listener.Addr().(*net.TCPAddr).Port) // the addresses are host names
+	}
+	var oldRequests, newRequests atomic.Int32
+	oldAddress, newAddress := accrualServer(&oldRequests), accrualServer(&newRequests)
+
+	g := newTestGophermart(t, nil, "ACCRUAL_SYSTEM_ADDRESS="+oldAddress)
+	writeEnvFile(t, "ACCRUAL_SYSTEM_ADDRESS="+newAddress)
+	if err := g.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got := g.transport.http.AccrualAddress().String(); got != newAddress {
+		t.Errorf("got the accrual address %s, want %s", got, newAddress)
+	}
+
+	// the worker calls the accrual system by the client of the new address
+	_, _ = accrualSystem{&g.transport.http.client.accrual}.GetOrder(context.Background(), &genAccrual.GetOrderPayload{Number: "12345678903"})
+	if oldRequests.Load() != 0 || newRequests.Load() != 1 {
+		t.Errorf("got %d requests to the old address and %d to the new one, want the new one only", oldRequests.Load(), newRequests.Load())
+	}
+}
+
+func TestReloadAccrualAddressDuringRequests(t *testing.T) {
+	unsetenv(t, "ACCRUAL_SYSTEM_ADDRESS")
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+	addresses := []string{fmt.Sprintf("localhost:%d", port), fmt.Sprintf("http://127.0.0.1:%d", port)}
+
+	g := newTestGophermart(t, nil, "ACCRUAL_SYSTEM_ADDRESS="+addresses[0])
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() { // the worker keeps calling the accrual system while the address is reloaded
+		defer wg.Done()
+		for ctx.Err() == nil {
+			_, _ = accrualSystem{&g.transport.http.client.accrual}.GetOrder(ctx, &genAccrual.GetOrderPayload{Number: "12345678903"})
+			_ = g.transport.http.AccrualAddress().String()
+		}
+	}()
+	for i := range 20 {
+		writeEnvFile(t, "ACCRUAL_SYSTEM_ADDRESS="+addresses[(i+1)%len(addresses)])
+		if err := g.reload(); err != nil {
+			t.Error(err)
+		}
+	}
+	cancel()
+	wg.Wait()
+
+	if got, want := g.transport.http.AccrualAddress().String(), addresses[0]; got != want {
+		t.Errorf("got the accrual address %s, want %s", got, want)
+	}
+
+	writeEnvFile(t)
+	if err := g.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got := g.transport.http.AccrualAddress().String(); got != defaultAccrualAddress {
+		t.Errorf("got the accrual address %s after it's removed, want the default %s", got, defaultAccrualAddress)
+	}
+}
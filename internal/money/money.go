@@ -0,0 +1,146 @@
+// Package money is the fixed-point amount of the loyalty points.
+//
+// An [Amount] is an integer number of the minor units which are hundredths of a point.
+// It's encoded to JSON as an exact decimal number, e.g. 729.98, and stored as an integer of the minor units
+// so the amounts never drift through float rounding.
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount is the number of the minor units of the points
+type Amount int64
+
+// Scale is the number of the minor units in a point
+const Scale = 100
+
+// scaleDigits is the number of the fractional digits of an [Amount]
+const scaleDigits = 2
+
+var (
+	// ErrInvalidAmount is wrapped by the errors of parsing an [Amount]
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrOverflow means the amount doesn't fit into an [Amount]
+	ErrOverflow = fmt.Errorf("%w: overflow", ErrInvalidAmount)
+)
+
+// Points returns the amount of the whole points
+func Points(n int64) Amount {
+	return Amount(n * Scale)
+}
+
+// Parse parses the decimal number with at most 2 fractional digits, e.g. "729.98", "-5" or "0.5".
+// An exponent or more fractional digits are an error since the amount can't be exact
+func Parse(s string) (Amount, error) {
+	digits := s
+	negative := strings.HasPrefix(digits, "-")
+	if negative {
+		digits = digits[1:]
+	}
+	whole, frac, hasFrac := strings.Cut(digits, ".")
+	if whole == "" || (hasFrac && frac == "") || len(frac) > scaleDigits || !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	frac += strings.Repeat("0", scaleDigits-len(frac))
+
+	w, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || w > math.MaxInt64/Scale {
+		return 0, fmt.Errorf("%w: %q", ErrOverflow, s)
+	}
+	f, _ := strconv.ParseInt(frac, 10, 64) // it's checked to be digits
+	minor := w*Scale + f
+	if minor < 0 {
+		return 0, fmt.Errorf("%w: %q", ErrOverflow, s)
+	}
+	if negative {
+		minor = -minor
+	}
+	return Amount(minor), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the decimal number without the trailing fractional zeros, e.g. "729.98", "729.9" or "729"
+func (a Amount) String() string {
+	minor := int64(a)
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+	}
+	// the absolute value is taken by the unsigned conversion so the minimal amount doesn't overflow
+	abs := uint64(minor)
+	if minor < 0 {
+		abs = -abs
+	}
+	whole, frac := abs/Scale, abs%Scale
+	if frac == 0 {
+		return sign + strconv.FormatUint(whole, 10)
+	}
+	fracDigits := fmt.Sprintf("%0*d", scaleDigits, frac)
+	return sign + strconv.FormatUint(whole, 10) + "." + strings.TrimRight(fracDigits, "0")
+}
+
+// Minor returns the amount of the minor units
+func (a Amount) Minor() int64 {
+	return int64(a)
+}
+
+// MarshalJSON encodes the amount as an exact JSON number
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON decodes the amount from a JSON number or a string of the number. See [Parse]
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	v, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// Value stores the amount as an integer of the minor units
+func (a Amount) Value() (driver.Value, error) {
+	return int64(a), nil
+}
+
+// Scan reads the amount from an integer of the minor units
+func (a *Amount) Scan(src any) error {
+	switch v := src.(type) {
+	case int64:
+		*a = Amount(v)
+		return nil
+	case []byte:
+		return a.scanString(string(v))
+	case string:
+		return a.scanString(v)
+	}
+	return fmt.Errorf("%w: can't scan %T", ErrInvalidAmount, src)
+}
+
+func (a *Amount) scanString(s string) error {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidAmount, err)
+	}
+	*a = Amount(n)
+	return nil
+}
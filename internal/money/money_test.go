@@ -0,0 +1,83 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Amount
+		wantErr error
+	}{
+		{"729.98", 72998, nil},
+		{"729.9", 72990, nil},
+		{"729", 72900, nil},
+		{"0.01", 1, nil},
+		{"-5.5", -550, nil},
+		{"0", 0, nil},
+		{"92233720368547758.07", math.MaxInt64, nil},
+		{"92233720368547758.08", 0, ErrOverflow},
+		{"729.981", 0, ErrInvalidAmount},
+		{"7.29e2", 0, ErrInvalidAmount},
+		{"729.", 0, ErrInvalidAmount},
+		{".98", 0, ErrInvalidAmount},
+		{"+1", 0, ErrInvalidAmount},
+		{"", 0, ErrInvalidAmount},
+		{"1 000", 0, ErrInvalidAmount},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.s)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("Parse(%q) = %d, %v, want %d, %v", tt.s, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		a    Amount
+		want string
+	}{
+		{72998, "729.98"},
+		{72990, "729.9"},
+		{72900, "729"},
+		{1, "0.01"},
+		{-550, "-5.5"},
+		{0, "0"},
+		{math.MinInt64, "-92233720368547758.08"},
+	}
+	for _, tt := range tests {
+		if got := tt.a.String(); got != tt.want {
+			t.Errorf("Amount(%d).String() = %s, want %s", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var v struct {
+		Accrual  Amount  `json:"accrual"`
+		Withdraw *Amount `json:"withdraw"`
+	}
+	if err := json.Unmarshal([]byte(`{"accrual": 729.98, "withdraw": "0.1"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Accrual != 72998 || v.Withdraw == nil || *v.Withdraw != 10 {
+		t.Fatalf("decoded %d, %v", v.Accrual, v.Withdraw)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"accrual":729.98,"withdraw":0.1}`; string(b) != want {
+		t.Errorf("encoded %s, want %s", b, want)
+	}
+
+	if err = json.Unmarshal([]byte(`{"accrual": 0.001}`), &v); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("decoding an inexact amount: got error %v, want %v", err, ErrInvalidAmount)
+	}
+}
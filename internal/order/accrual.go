@@ -0,0 +1,26 @@
+package order
+
+// AccrualStatus is the status of an order in the accrual system
+type AccrualStatus string
+
+// Statuses of the orders in the accrual system
+const (
+	AccrualStatusRegistered AccrualStatus = "REGISTERED"
+	AccrualStatusProcessing AccrualStatus = "PROCESSING"
+	AccrualStatusInvalid    AccrualStatus = "INVALID"
+	AccrualStatusProcessed  AccrualStatus = "PROCESSED"
+)
+
+// Status returns the status of the order which corresponds to the status in the accrual system.
+// It returns false if the accrual status is unknown
+func (s AccrualStatus) Status() (Status, bool) {
+	switch s {
+	case AccrualStatusRegistered, AccrualStatusProcessing:
+		return StatusProcessing, true
+	case AccrualStatusInvalid:
+		return StatusInvalid, true
+	case AccrualStatusProcessed:
+		return StatusProcessed, true
+	}
+	return "", false
+}
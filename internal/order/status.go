@@ -0,0 +1,109 @@
+// Package order is the lifecycle of the uploaded orders.
+//
+// An order is uploaded in the [StatusNew] and moves along the allowed transitions:
+//
+//	NEW -> PROCESSING -> PROCESSED
+//	  |        '-------> INVALID
+//	  '-> PROCESSED, INVALID
+//
+// [StatusProcessed] and [StatusInvalid] are final. An admin may reprocess a final order by moving it back
+// to [StatusNew], see [Reprocess]. The database mirrors the transitions by the CHECK constraint
+// of the order_status_history table so both must be changed together
+package order
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Status is the status of an order
+type Status string
+
+// Statuses of the orders
+const (
+	StatusNew        Status = "NEW"
+	StatusProcessing Status = "PROCESSING"
+	StatusInvalid    Status = "INVALID"
+	StatusProcessed  Status = "PROCESSED"
+)
+
+// Source is the originator of a status transition
+type Source string
+
+// Sources of the status transitions
+const (
+	SourceUpload    Source = "upload"    // the user uploaded the order
+	SourceAccrual   Source = "accrual"   // the accrual system processed the order
+	SourceImport    Source = "import"    // the order was imported in bulk
+	SourceMigration Source = "migration" // the status was recorded before the history was kept
+	SourceAdmin     Source = "admin"     // an admin reprocessed the order
+)
+
+// transitions are the statuses which can follow the status
+var transitions = map[Status][]Status{
+	StatusNew:        {StatusProcessing, StatusInvalid, StatusProcessed},
+	StatusProcessing: {StatusInvalid, StatusProcessed},
+	StatusInvalid:    nil,
+	StatusProcessed:  nil,
+}
+
+var (
+	// ErrInvalidStatus means the status is unknown
+	ErrInvalidStatus = errors.New("invalid order status")
+	// ErrTransition means the status can't follow the current one
+	ErrTransition = errors.New("forbidden order status transition")
+)
+
+// Statuses returns all the statuses in the order of the lifecycle
+func Statuses() []Status {
+	return []Status{StatusNew, StatusProcessing, StatusInvalid, StatusProcessed}
+}
+
+// ParseStatus returns the status or [ErrInvalidStatus] if s isn't a known one
+func ParseStatus(s string) (Status, error) {
+	status := Status(s)
+	if !status.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidStatus, s)
+	}
+	return status, nil
+}
+
+// Valid reports whether the status is a known one
+func (s Status) Valid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// Final reports whether the status can't be changed
+func (s Status) Final() bool {
+	return s.Valid() && len(transitions[s]) == 0
+}
+
+// CanTransition reports whether the status to can follow the status s.
+// A status can't follow itself
+func (s Status) CanTransition(to Status) bool {
+	return slices.Contains(transitions[s], to)
+}
+
+// Transition returns [ErrTransition] if the status to can't follow the status from
+func Transition(from, to Status) error {
+	if !from.CanTransition(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrTransition, from, to)
+	}
+	return nil
+}
+
+// Reprocess returns [ErrTransition] unless the order in the status from can be reprocessed.
+// Only a final order is reprocessed, it moves back to [StatusNew] by [SourceAdmin]
+func Reprocess(from Status) error {
+	if !from.Final() {
+		return fmt.Errorf("%w: %s -> %s isn't a reprocessing", ErrTransition, from, StatusNew)
+	}
+	return nil
+}
+
+// String returns the status as it's stored and encoded
+func (s Status) String() string {
+	return string(s)
+}
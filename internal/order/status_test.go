@@ -0,0 +1,83 @@
+package order
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTransition(t *testing.T) {
+	allowed := map[[2]Status]bool{
+		{StatusNew, StatusProcessing}:       true,
+		{StatusNew, StatusInvalid}:          true,
+		{StatusNew, StatusProcessed}:        true,
+		{StatusProcessing, StatusInvalid}:   true,
+		{StatusProcessing, StatusProcessed}: true,
+	}
+	statuses := append(Statuses(), "UNKNOWN")
+	for _, from := range statuses {
+		for _, to := range statuses {
+			err := Transition(from, to)
+			if allowed[[2]Status{from, to}] {
+				if err != nil {
+					t.Errorf("Transition(%s, %s) = %v, want nil", from, to, err)
+				}
+				continue
+			}
+			if !errors.Is(err, ErrTransition) {
+				t.Errorf("Transition(%s, %s) = %v, want %v", from, to, err, ErrTransition)
+			}
+		}
+	}
+}
+
+func TestFinal(t *testing.T) {
+	for _, s := range Statuses() {
+		want := s == StatusInvalid || s == StatusProcessed
+		if got := s.Final(); got != want {
+			t.Errorf("%s.Final() = %t, want %t", s, got, want)
+		}
+	}
+	if Status("UNKNOWN").Final() {
+		t.Error("an unknown status is final")
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	if s, err := ParseStatus("PROCESSING"); err != nil || s != StatusProcessing {
+		t.Errorf("ParseStatus(PROCESSING) = %s, %v", s, err)
+	}
+	if _, err := ParseStatus("processing"); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("ParseStatus(processing) = %v, want %v", err, ErrInvalidStatus)
+	}
+}
+
+func TestReprocess(t *testing.T) {
+	for _, s := range Statuses() {
+		err := Reprocess(s)
+		if s.Final() != (err == nil) {
+			t.Errorf("Reprocess(%s) = %v", s, err)
+		}
+		if err != nil && !errors.Is(err, ErrTransition) {
+			t.Errorf("Reprocess(%s) = %v, want %v", s, err, ErrTransition)
+		}
+	}
+}
+
+func TestAccrualStatus(t *testing.T) {
+	tests := []struct {
+		accrual AccrualStatus
+		want    Status
+		wantOK  bool
+	}{
+		{AccrualStatusRegistered, StatusProcessing, true},
+		{AccrualStatusProcessing, StatusProcessing, true},
+		{AccrualStatusInvalid, StatusInvalid, true},
+		{AccrualStatusProcessed, StatusProcessed, true},
+		{"NEW", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := tt.accrual.Status(); got != tt.want || ok != tt.wantOK {
+			t.Errorf("%s.Status() = %s, %t, want %s, %t", tt.accrual, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
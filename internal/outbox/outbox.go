@@ -0,0 +1,115 @@
+// Package outbox defines the domain events which are stored in the outbox by the transactions of the changes they describe
+// and the sinks the events are published to
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+// Type is the type of a domain event
+type Type string
+
+// Types of the domain events
+const (
+	// OrderUploaded is the event of the order uploaded by the user
+	OrderUploaded Type = "OrderUploaded"
+	// OrderProcessed is the event of the order moved to a final status by the accrual system
+	OrderProcessed Type = "OrderProcessed"
+	// PointsAccrued is the event of the points accrued to the user for the processed order
+	PointsAccrued Type = "PointsAccrued"
+	// PointsWithdrawn is the event of the points withdrawn by the user to pay for an order
+	PointsWithdrawn Type = "PointsWithdrawn"
+	// PointsAdjusted is the event of the balance of the user changed by an admin
+	PointsAdjusted Type = "PointsAdjusted"
+)
+
+// Message is a domain event to be stored in the outbox. Its data is JSON
+type Message struct {
+	Type Type
+	Data []byte
+}
+
+// Event is a domain event stored in the outbox. The IDs of the events grow in the order of their storing
+type Event struct {
+	ID        int64           `json:"id"`
+	Type      Type            `json:"type"`
+	UserID    uuid.UUID       `json:"user_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+	Attempts  int             `json:"-"` // the number of the failed publishings. It isn't published
+}
+
+// OrderData is the data of the order events
+type OrderData struct {
+	Number  string        `json:"number"`
+	Status  string        `json:"status"`
+	Accrual *money.Amount `json:"accrual,omitempty"`
+}
+
+// PointsData is the data of the points events
+type PointsData struct {
+	Order string       `json:"order"`
+	Sum   money.Amount `json:"sum"`
+}
+
+// AdjustmentData is the data of the [PointsAdjusted] events. The sum is negative if the balance decreases.
+// The order is set if the accrual of the reprocessed order is reversed
+type AdjustmentData struct {
+	Sum    money.Amount `json:"sum"`
+	Reason string       `json:"reason"`
+	Order  string       `json:"order,omitempty"`
+}
+
+// Uploaded returns the message of the order uploaded in the status
+func Uploaded(number string, status order.Status) (Message, error) {
+	return newMessage(OrderUploaded, OrderData{Number: number, Status: status.String()})
+}
+
+// Transition returns the messages of the transition of the order to the status.
+// The final statuses are [OrderProcessed] and the positive accrual of the processed order is [PointsAccrued]
+func Transition(number string, status order.Status, accrual *money.Amount) ([]Message, error) {
+	if !status.Final() {
+		return nil, nil
+	}
+	data := OrderData{Number: number, Status: status.String()}
+	if status == order.StatusProcessed {
+		data.Accrual = accrual
+	}
+	processed, err := newMessage(OrderProcessed, data)
+	if err != nil {
+		return nil, err
+	}
+	if status != order.StatusProcessed || accrual == nil || *accrual <= 0 {
+		return []Message{processed}, nil
+	}
+
+	accrued, err := newMessage(PointsAccrued, PointsData{Order: number, Sum: *accrual})
+	if err != nil {
+		return nil, err
+	}
+	return []Message{processed, accrued}, nil
+}
+
+// Withdrawn returns the message of the points withdrawn to pay for the order
+func Withdrawn(number string, sum money.Amount) (Message, error) {
+	return newMessage(PointsWithdrawn, PointsData{Order: number, Sum: sum})
+}
+
+// Adjusted returns the message of the balance changed by the sum for the reason.
+// The number is the order whose accrual is reversed. It's empty for a manual adjustment
+func Adjusted(sum money.Amount, reason, number string) (Message, error) {
+	return newMessage(PointsAdjusted, AdjustmentData{Sum: sum, Reason: reason, Order: number})
+}
+
+func newMessage(t Type, data any) (Message, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Type: t, Data: b}, nil
+}
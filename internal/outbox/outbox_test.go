@@ -0,0 +1,61 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+)
+
+func TestTransition(t *testing.T) {
+	accrual := money.Points(10)
+	var zero money.Amount
+	tests := []struct {
+		status  order.Status
+		accrual *money.Amount
+		want    []Type
+	}{
+		{order.StatusNew, nil, nil},
+		{order.StatusProcessing, nil, nil},
+		{order.StatusInvalid, nil, []Type{OrderProcessed}},
+		{order.StatusProcessed, nil, []Type{OrderProcessed}},
+		{order.StatusProcessed, &zero, []Type{OrderProcessed}},
+		{order.StatusProcessed, &accrual, []Type{OrderProcessed, PointsAccrued}},
+	}
+	for _, tt := range tests {
+		messages, err := Transition("12345678903", tt.status, tt.accrual)
+		if err != nil {
+			t.Fatalf("Transition(%s) = %v", tt.status, err)
+		}
+		if len(messages) != len(tt.want) {
+			t.Errorf("Transition(%s, %v) = %d messages, want %v", tt.status, tt.accrual, len(messages), tt.want)
+			continue
+		}
+		for i, m := range messages {
+			if m.Type != tt.want[i] {
+				t.Errorf("Transition(%s, %v) message %d = %s, want %s", tt.status, tt.accrual, i, m.Type, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestParseTarget(t *testing.T) {
+	valid := map[string]string{
+		"log":                    SinkLog,
+		" file:/tmp/events.json": SinkFile,
+		"http://localhost/e":     SinkHTTP,
+		"https://example.com":    SinkHTTP,
+	}
+	for s, kind := range valid {
+		target, err := ParseTarget(s)
+		if err != nil || target.Kind() != kind {
+			t.Errorf("ParseTarget(%q) = %s, %v, want %s", s, target.Kind(), err, kind)
+		}
+	}
+	for _, s := range []string{"", "file:", "ftp://example.com", "http://", "stdout"} {
+		if _, err := ParseTarget(s); !errors.Is(err, ErrInvalidTarget) {
+			t.Errorf("ParseTarget(%q) = %v, want %v", s, err, ErrInvalidTarget)
+		}
+	}
+}
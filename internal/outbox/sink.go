@@ -0,0 +1,182 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"goa.design/clue/log"
+)
+
+// Sink publishes the events. An event is published once Publish returns nil
+type Sink interface {
+	Publish(ctx context.Context, e Event) error
+	Close() error
+}
+
+// Doer sends the requests of the HTTP sinks. [http.Client] implements it
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Kinds of the sinks
+const (
+	SinkLog  = "log"  // the events are logged
+	SinkFile = "file" // the events are appended to a file as JSON lines
+	SinkHTTP = "http" // the events are posted to an http(s) URL
+)
+
+// HeaderEventID is the header of the ID of the event posted by the HTTP sink. The receiver deduplicates the events by it
+const HeaderEventID = "X-Gophermart-Event-ID"
+
+// maxResponseBody limits the response body which is read so the connection is reused
+const maxResponseBody = 64 << 10
+
+// ErrInvalidTarget means the sink target isn't log, file:<path> or an http(s) URL
+var ErrInvalidTarget = errors.New("invalid sink target")
+
+// Target is the sink written as log, file:<path> or an http(s) URL
+type Target struct {
+	kind     string
+	location string // the path of the file or the URL
+}
+
+// ParseTarget parses the sink target log, file:<path> or an http(s) URL
+func ParseTarget(s string) (Target, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == SinkLog:
+		return Target{kind: SinkLog}, nil
+	case strings.HasPrefix(s, SinkFile+":"):
+		path := strings.TrimPrefix(s, SinkFile+":")
+		if path == "" {
+			return Target{}, fmt.Errorf("%w: %q has no path", ErrInvalidTarget, s)
+		}
+		return Target{kind: SinkFile, location: path}, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Target{}, fmt.Errorf("%w: %q isn't log, file:<path> or an http(s) URL", ErrInvalidTarget, s)
+	}
+	return Target{kind: SinkHTTP, location: s}, nil
+}
+
+// Kind returns the kind of the sink
+func (t Target) Kind() string {
+	return t.kind
+}
+
+// String returns the target as it's parsed
+func (t Target) String() string {
+	switch t.kind {
+	case SinkFile:
+		return SinkFile + ":" + t.location
+	case SinkHTTP:
+		return t.location
+	}
+	return t.kind
+}
+
+// Open opens the sink of the target. The HTTP sink sends the requests by the client
+func (t Target) Open(client Doer) (Sink, error) {
+	switch t.kind {
+	case SinkLog:
+		return logSink{}, nil
+	case SinkFile:
+		f, err := os.OpenFile(t.location, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return &fileSink{f: f}, nil
+	case SinkHTTP:
+		return httpSink{url: t.location, client: client}, nil
+	}
+	return nil, fmt.Errorf("%w: the zero target", ErrInvalidTarget)
+}
+
+// logSink logs the events by the logger of the context
+type logSink struct{}
+
+func (logSink) Publish(ctx context.Context, e Event) error {
+	log.Print(ctx,
+		log.KV{K: "msg", V: "domain event"},
+		log.KV{K: "event.id", V: e.ID},
+		log.KV{K: "event.type", V: e.Type},
+		log.KV{K: "event.user_id", V: e.UserID},
+		log.KV{K: "event.data", V: string(e.Data)},
+	)
+	return nil
+}
+
+func (logSink) Close() error {
+	return nil
+}
+
+// fileSink appends the events to the file as JSON lines. An event is published once the file is synced
+type fileSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func (s *fileSink) Publish(_ context.Context, e Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err = s.f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return s.f.Sync()
+}
+
+func (s *fileSink) Close() error {
+	return s.f.Close()
+}
+
+// httpSink posts the events to the URL. An event is published once the receiver responds with 2xx
+type httpSink struct {
+	url    string
+	client Doer
+}
+
+func (s httpSink) Publish(ctx context.Context, e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, strconv.FormatInt(e.ID, 10))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBody))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("the sink %s responded with %s", s.url, res.Status)
+	}
+	return nil
+}
+
+func (httpSink) Close() error {
+	return nil
+}
@@ -0,0 +1,174 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/oleshko-g/oggophermart/internal/route"
+)
+
+// Config represents the limits of the routes. The zero value is the default config.
+// The limits are safe to be set while the requests are limited, the changes take effect upon the next request
+type Config struct {
+	limit  limit
+	routes routes
+	store  store
+}
+
+// Defaults of the [Config] parameters
+var (
+	// DefaultLimit limits the routes which have no limit of their own
+	DefaultLimit = Limit{Rate: 20, Burst: 40}
+	// DefaultRoutes are the limits of the expensive routes
+	DefaultRoutes = map[string]Limit{
+		"GET /api/user/orders": {Rate: 5, Burst: 20},
+	}
+)
+
+// Stores of the buckets
+const (
+	StoreMemory   = "memory"   // the buckets of every replica are its own
+	StoreDatabase = "database" // the buckets are shared by the replicas
+)
+
+// Limit returns a pointer to the [flag.Value] to set the limit of the routes which have no limit of their own
+func (c *Config) Limit() *limit { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.limit
+}
+
+// Routes returns a pointer to the [flag.Value] to set the limits of the routes
+func (c *Config) Routes() *routes { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.routes
+}
+
+// Store returns a pointer to the [flag.Value] to set the store of the buckets
+func (c *Config) Store() *store { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.store
+}
+
+// RouteLimit returns the route pattern which the request of the method to the path matches and its limit.
+// The pattern is "*" if the request is limited by the default limit
+func (c *Config) RouteLimit(method, path string) (pattern string, l Limit) {
+	for _, r := range c.routes.Routes() {
+		if r.pattern.Match(method, path) {
+			return r.pattern.String(), r.limit
+		}
+	}
+	return "*", c.limit.Limit()
+}
+
+// errParsingConfig indicates an invalid value of a [Config] parameter
+var errParsingConfig = errors.New("error parsing rate limit config")
+
+// limit is the [Limit] of the routes which have no limit of their own
+type limit struct {
+	v atomic.Pointer[Limit]
+}
+
+func (l *limit) String() string {
+	return l.Limit().String()
+}
+
+// Set parses s by [ParseLimit] and sets it or returns an error
+func (l *limit) Set(s string) error {
+	v, err := ParseLimit(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	l.v.Store(&v)
+	return nil
+}
+
+// Limit returns the limit or the [DefaultLimit] if it isn't set
+func (l *limit) Limit() Limit {
+	if v := l.v.Load(); v != nil {
+		return *v
+	}
+	return DefaultLimit
+}
+
+// routeLimit is the limit of the requests matching the pattern
+type routeLimit struct {
+	pattern route.Pattern
+	limit   Limit
+}
+
+// routes are the limits of the routes. The first matching route limits a request
+type routes struct {
+	v atomic.Pointer[[]routeLimit]
+}
+
+func (r *routes) String() string {
+	var s []string
+	for _, rl := range r.Routes() {
+		s = append(s, rl.pattern.String()+"="+rl.limit.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set parses s as the comma separated <METHOD> <path>=<limit> and sets it or returns an error.
+// The paths may have the segments in braces like the API paths, e.g. "GET /api/user/orders/{number}=1/s:5".
+// The limit is parsed by [ParseLimit]. The [DefaultRoutes] are replaced
+func (r *routes) Set(s string) error {
+	var v []routeLimit
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		pattern, limitValue, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf("%w: %q isn't <METHOD> <path>=<limit>", errParsingConfig, entry)
+		}
+		l, err := ParseLimit(limitValue)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errParsingConfig, err)
+		}
+		p, err := route.Parse(pattern)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errParsingConfig, err)
+		}
+		v = append(v, routeLimit{pattern: p, limit: l})
+	}
+	r.v.Store(&v)
+	return nil
+}
+
+// Routes returns the routes or the [DefaultRoutes] if they aren't set
+func (r *routes) Routes() []routeLimit {
+	if v := r.v.Load(); v != nil {
+		return *v
+	}
+	var v []routeLimit
+	for pattern, l := range DefaultRoutes {
+		p, _ := route.Parse(pattern) // the defaults are valid
+		v = append(v, routeLimit{pattern: p, limit: l})
+	}
+	slices.SortFunc(v, func(a, b routeLimit) int {
+		return strings.Compare(a.pattern.String(), b.pattern.String())
+	})
+	return v
+}
+
+// store is the name of the store of the buckets
+type store string
+
+func (s *store) String() string {
+	if *s == "" {
+		return StoreMemory
+	}
+	return string(*s)
+}
+
+// Set validates the name of the store and sets it or returns an error
+func (s *store) Set(v string) error {
+	switch v {
+	case StoreMemory, StoreDatabase:
+		*s = store(v)
+		return nil
+	}
+	return fmt.Errorf("%w: unsupported store %q", errParsingConfig, v)
+}
@@ -0,0 +1,128 @@
+// Package ratelimit is the token bucket which limits the rate of the requests.
+//
+// A bucket holds up to [Limit.Burst] tokens and is refilled by [Limit.Rate] tokens per second.
+// Every request takes a token and is rejected if the bucket is empty.
+// The buckets are kept by a store so the same algorithm of [Limit.Take] limits a single replica in memory
+// or all the replicas sharing a database
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is the rate of the refill of a bucket and its capacity. The zero value is unlimited
+type Limit struct {
+	Rate  float64 // tokens per second
+	Burst int     // the capacity of the bucket
+}
+
+// Unlimited is the limit which doesn't reject any request
+var Unlimited = Limit{}
+
+// ErrInvalidLimit is wrapped by the errors of parsing a [Limit]
+var ErrInvalidLimit = errors.New("invalid rate limit")
+
+// units are the periods of the rates in [ParseLimit]
+var units = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+}
+
+// ParseLimit parses the limit in the form <requests>/<s|m|h>[:<burst>], e.g. "5/s:20" or "100/m".
+// The burst is the number of the requests per the period if it's omitted. "off" is [Unlimited]
+func ParseLimit(s string) (Limit, error) {
+	if s == "off" {
+		return Unlimited, nil
+	}
+
+	rate, burst, hasBurst := strings.Cut(s, ":")
+	requests, unit, ok := strings.Cut(rate, "/")
+	period, known := units[unit]
+	if !ok || !known {
+		return Limit{}, fmt.Errorf("%w: %q isn't <requests>/<s|m|h>[:<burst>]", ErrInvalidLimit, s)
+	}
+
+	n, err := strconv.ParseFloat(requests, 64)
+	if err != nil || n <= 0 || math.IsInf(n, 0) {
+		return Limit{}, fmt.Errorf("%w: %q has no positive number of requests", ErrInvalidLimit, s)
+	}
+	l := Limit{
+		Rate:  n / period.Seconds(),
+		Burst: int(math.Ceil(n)),
+	}
+
+	if hasBurst {
+		if l.Burst, err = strconv.Atoi(burst); err != nil || l.Burst <= 0 {
+			return Limit{}, fmt.Errorf("%w: %q has no positive burst", ErrInvalidLimit, s)
+		}
+	}
+	return l, nil
+}
+
+// String returns the limit in the form of [ParseLimit] with the rate per second
+func (l Limit) String() string {
+	if l.Unlimited() {
+		return "off"
+	}
+	return strconv.FormatFloat(l.Rate, 'g', -1, 64) + "/s:" + strconv.Itoa(l.Burst)
+}
+
+// Unlimited reports whether the limit doesn't reject any request
+func (l Limit) Unlimited() bool {
+	return l.Burst <= 0 || l.Rate <= 0
+}
+
+// Bucket is the state of the bucket at the time of its last update
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Result is the outcome of taking a token
+type Result struct {
+	Allowed    bool
+	Limit      int           // the burst of the limit
+	Remaining  int           // the whole tokens left in the bucket
+	RetryAfter time.Duration // the time until a token is available if the request isn't allowed
+	Reset      time.Duration // the time until the bucket is full
+}
+
+// Full returns the full bucket at the time
+func (l Limit) Full(now time.Time) Bucket {
+	return Bucket{Tokens: float64(l.Burst), UpdatedAt: now}
+}
+
+// Take refills the bucket by the time passed since its update and takes a token from it if there is one.
+// It returns the bucket updated at now
+func (l Limit) Take(b Bucket, now time.Time) (Bucket, Result) {
+	elapsed := max(now.Sub(b.UpdatedAt), 0)
+	tokens := min(b.Tokens+elapsed.Seconds()*l.Rate, float64(l.Burst))
+
+	res := Result{Limit: l.Burst}
+	if tokens >= 1 {
+		tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = l.duration(1 - tokens)
+	}
+	res.Remaining = int(tokens)
+	res.Reset = l.duration(float64(l.Burst) - tokens)
+
+	return Bucket{Tokens: tokens, UpdatedAt: now}, res
+}
+
+// FullAt returns the time the bucket is refilled completely. The bucket can be forgotten after that
+func (l Limit) FullAt(b Bucket) time.Time {
+	return b.UpdatedAt.Add(l.duration(float64(l.Burst) - b.Tokens))
+}
+
+// duration returns the time of the refill of the tokens
+func (l Limit) duration(tokens float64) time.Duration {
+	return time.Duration(math.Ceil(tokens / l.Rate * float64(time.Second)))
+}
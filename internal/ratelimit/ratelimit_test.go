@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Limit
+	}{
+		{s: "5/s:20", want: Limit{Rate: 5, Burst: 20}},
+		{s: "120/m", want: Limit{Rate: 2, Burst: 120}},
+		{s: "0.5/s", want: Limit{Rate: 0.5, Burst: 1}},
+		{s: "off", want: Unlimited},
+	}
+	for _, tt := range tests {
+		if got, err := ParseLimit(tt.s); err != nil || got != tt.want {
+			t.Errorf("ParseLimit(%q) = %v, %v, want %v", tt.s, got, err, tt.want)
+		}
+	}
+
+	for _, s := range []string{"", "5", "5/d", "0/s", "-1/s", "5/s:0", "5/s:x", "x/s"} {
+		if _, err := ParseLimit(s); !errors.Is(err, ErrInvalidLimit) {
+			t.Errorf("ParseLimit(%q) = %v, want %v", s, err, ErrInvalidLimit)
+		}
+	}
+}
+
+func TestTake(t *testing.T) {
+	l := Limit{Rate: 2, Burst: 3}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := l.Full(now)
+
+	var res Result
+	for i := 2; i >= 0; i-- {
+		if b, res = l.Take(b, now); !res.Allowed || res.Remaining != i {
+			t.Fatalf("take %d: %+v, want allowed with %d remaining", 3-i, res, i)
+		}
+	}
+
+	b, res = l.Take(b, now)
+	if res.Allowed || res.RetryAfter != 500*time.Millisecond || res.Reset != 1500*time.Millisecond {
+		t.Fatalf("take from the empty bucket: %+v", res)
+	}
+
+	if _, res = l.Take(b, now.Add(500*time.Millisecond)); !res.Allowed || res.Remaining != 0 {
+		t.Fatalf("take after the refill of a token: %+v", res)
+	}
+
+	if b, _ = l.Take(b, now.Add(time.Hour)); b.Tokens != 2 {
+		t.Fatalf("the bucket is refilled over its burst: %+v", b)
+	}
+	if got, want := l.FullAt(b), now.Add(time.Hour+500*time.Millisecond); !got.Equal(want) {
+		t.Errorf("FullAt = %s, want %s", got, want)
+	}
+}
+
+func TestRouteLimit(t *testing.T) {
+	var c Config
+	if err := c.Routes().Set("GET /api/user/orders/{number}=1/s:5, POST /api/user/login=off"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		method, path string
+		route        string
+		limit        Limit
+	}{
+		{method: "GET", path: "/api/user/orders/18", route: "GET /api/user/orders/{number}", limit: Limit{Rate: 1, Burst: 5}},
+		{method: "POST", path: "/api/user/login", route: "POST /api/user/login", limit: Unlimited},
+		{method: "GET", path: "/api/user/orders", route: "*", limit: DefaultLimit},
+		{method: "GET", path: "/api/user/orders/18/x", route: "*", limit: DefaultLimit},
+	}
+	for _, tt := range tests {
+		if route, l := c.RouteLimit(tt.method, tt.path); route != tt.route || l != tt.limit {
+			t.Errorf("RouteLimit(%s, %s) = %s, %v, want %s, %v", tt.method, tt.path, route, l, tt.route, tt.limit)
+		}
+	}
+
+	for _, s := range []string{"GET /api=5", "/api=5/s", "GET api=5/s"} {
+		if err := c.Routes().Set(s); err == nil {
+			t.Errorf("Routes().Set(%q) isn't an error", s)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package role is the access of the users to the gophermart API.
+//
+// A role is stored with the user and is carried by the JWT tokens as the scopes it grants.
+// The roles are ordered by their access, each role grants the scopes of the previous ones:
+//
+//	user -> support -> admin
+//
+// The users manage their own orders and webhooks, the support reads the accounts of any user
+// and the admin changes them. The database mirrors the roles by the CHECK constraint of the users table
+// so both must be changed together
+package role
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Role is the role of a user
+type Role string
+
+// Roles of the users in the order of their access
+const (
+	User    Role = "user"
+	Support Role = "support"
+	Admin   Role = "admin"
+)
+
+// Scopes of the JWT tokens
+const (
+	ScopeUser    = "user"    // the own orders and webhooks of the user
+	ScopeSupport = "support" // reading the accounts of any user
+	ScopeAdmin   = "admin"   // changing the accounts of any user
+)
+
+// ErrInvalidRole means the role is unknown
+var ErrInvalidRole = errors.New("invalid role")
+
+// Roles returns all the roles in the order of their access
+func Roles() []Role {
+	return []Role{User, Support, Admin}
+}
+
+// Parse returns the role or [ErrInvalidRole] if s isn't a known one
+func Parse(s string) (Role, error) {
+	r := Role(s)
+	if !r.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, s)
+	}
+	return r, nil
+}
+
+// Valid reports whether the role is a known one
+func (r Role) Valid() bool {
+	return slices.Contains(Roles(), r)
+}
+
+// Scopes returns the scopes granted by the role. An unknown role grants none
+func (r Role) Scopes() []string {
+	scopes := []string{ScopeUser, ScopeSupport, ScopeAdmin}
+	i := slices.Index(Roles(), r)
+	return scopes[:i+1]
+}
+
+// Grants reports whether the role grants all the scopes
+func (r Role) Grants(scopes ...string) bool {
+	granted := r.Scopes()
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the role as it's stored and encoded
+func (r Role) String() string {
+	return string(r)
+}
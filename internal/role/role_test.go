@@ -0,0 +1,45 @@
+package role
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestScopes(t *testing.T) {
+	tests := map[Role][]string{
+		User:      {ScopeUser},
+		Support:   {ScopeUser, ScopeSupport},
+		Admin:     {ScopeUser, ScopeSupport, ScopeAdmin},
+		"UNKNOWN": {},
+	}
+	for r, want := range tests {
+		if got := r.Scopes(); !slices.Equal(got, want) {
+			t.Errorf("%s.Scopes() = %v, want %v", r, got, want)
+		}
+	}
+}
+
+func TestGrants(t *testing.T) {
+	if !Admin.Grants(ScopeSupport, ScopeAdmin) {
+		t.Error("admin doesn't grant support and admin")
+	}
+	if Support.Grants(ScopeSupport, ScopeAdmin) {
+		t.Error("support grants admin")
+	}
+	if !User.Grants() {
+		t.Error("user doesn't grant no scopes")
+	}
+	if Role("UNKNOWN").Grants(ScopeUser) {
+		t.Error("an unknown role grants user")
+	}
+}
+
+func TestParse(t *testing.T) {
+	if r, err := Parse("support"); err != nil || r != Support {
+		t.Errorf("Parse(support) = %s, %v", r, err)
+	}
+	if _, err := Parse("ADMIN"); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("Parse(ADMIN) = %v, want %v", err, ErrInvalidRole)
+	}
+}
@@ -0,0 +1,51 @@
+// Package route matches the requests to the route patterns written like the API paths, e.g. "GET /api/user/orders/{number}"
+package route
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPattern means the pattern isn't <METHOD> <path>
+var ErrInvalidPattern = errors.New("invalid route pattern")
+
+// Pattern is the method and the path of the route. The path segments in braces match any segment
+type Pattern struct {
+	method   string
+	segments []string
+}
+
+// Parse parses the pattern <METHOD> <path>
+func Parse(s string) (Pattern, error) {
+	method, path, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok || method == "" || !strings.HasPrefix(path, "/") {
+		return Pattern{}, fmt.Errorf("%w: %q isn't <METHOD> <path>", ErrInvalidPattern, s)
+	}
+	return Pattern{
+		method:   method,
+		segments: strings.Split(path, "/"),
+	}, nil
+}
+
+// Match reports whether the request of the method to the path matches the pattern
+func (p Pattern) Match(method, path string) bool {
+	if method != p.method {
+		return false
+	}
+	segments := strings.Split(path, "/")
+	if len(segments) != len(p.segments) {
+		return false
+	}
+	for i, s := range p.segments {
+		if s != segments[i] && !(strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}")) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the pattern as it's parsed
+func (p Pattern) String() string {
+	return p.method + " " + strings.Join(p.segments, "/")
+}
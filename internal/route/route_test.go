@@ -0,0 +1,36 @@
+package route
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	p, err := Parse("GET /api/user/orders/{number}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		method, path string
+		want         bool
+	}{
+		{method: "GET", path: "/api/user/orders/18", want: true},
+		{method: "POST", path: "/api/user/orders/18"},
+		{method: "GET", path: "/api/user/orders"},
+		{method: "GET", path: "/api/user/orders/18/history"},
+		{method: "GET", path: "/api/user/withdrawals/18"},
+	}
+	for _, tt := range tests {
+		if got := p.Match(tt.method, tt.path); got != tt.want {
+			t.Errorf("%s.Match(%s, %s) = %t, want %t", p, tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, s := range []string{"", "GET", "/api/user", "GET api/user"} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalidPattern) {
+			t.Errorf("Parse(%q) = %v, want %v", s, err, ErrInvalidPattern)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Package accrual is a scriptable stub of the accrual system implementing the [genAccrual] service generated by goa design.
+// It lets to run the gophermart end-to-end without the accrual system binary
+package accrual
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	genAccrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	"github.com/oleshko-g/oggophermart/internal/money"
+)
+
+// Statuses of the orders in the accrual system
+const (
+	StatusRegistered = "REGISTERED"
+	StatusProcessing = "PROCESSING"
+	StatusProcessed  = "PROCESSED"
+	StatusInvalid    = "INVALID"
+)
+
+// accrualStub is the accrual service stub implementation.
+type accrualStub struct {
+	script Script
+	now    func() time.Time
+
+	mu      sync.Mutex
+	orders  map[string]*order
+	window  time.Time // the start of the current rate limit window
+	counter int       // the number of requests in the current window
+}
+
+// order is the state of an order which is seen by the stub
+type order struct {
+	registeredAt time.Time
+	status       string // the final one
+	accrual      money.Amount
+}
+
+var _ genAccrual.Service = (*accrualStub)(nil)
+
+// New returns the accrual service stub which behaves as the script defines.
+func New(script Script) *accrualStub {
+	return &accrualStub{
+		script: script,
+		now:    time.Now,
+		orders: make(map[string]*order),
+	}
+}
+
+// GetOrder implements GetOrder.
+// An order is registered upon the first request of it and its status progresses with every [Script.StatusStep]
+func (s *accrualStub) GetOrder(_ context.Context, p *genAccrual.GetOrderPayload) (res *genAccrual.GetOrderResult, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if err = s.limit(now); err != nil {
+		return nil, err
+	}
+
+	o, ok := s.orders[p.Number]
+	if !ok {
+		rule := s.script.rule(p.Number)
+		if rule == nil {
+			notRegistered := "yes"
+			return &genAccrual.GetOrderResult{NotRegistered: &notRegistered}, nil
+		}
+		o = newOrder(rule, now)
+		s.orders[p.Number] = o
+	}
+
+	status := o.statusAt(now, time.Duration(s.script.StatusStep))
+	res = &genAccrual.GetOrderResult{
+		Order:  &p.Number,
+		Status: &status,
+	}
+	if status == StatusProcessed {
+		res.Accrual = &o.accrual
+	}
+	return res, nil
+}
+
+// limit counts the request in the fixed one minute window
+// and returns the error with the seconds left till the next window if the limit is exceeded
+func (s *accrualStub) limit(now time.Time) error {
+	if s.script.RequestsPerMinute == 0 {
+		return nil
+	}
+
+	if now.Sub(s.window) >= time.Minute {
+		s.window = now
+		s.counter = 0
+	}
+	if s.counter >= s.script.RequestsPerMinute {
+		retryAfter := s.window.Add(time.Minute).Sub(now)
+		return errTooManyRequests(int(math.Ceil(retryAfter.Seconds())))
+	}
+	s.counter++
+	return nil
+}
+
+func newOrder(rule *Rule, now time.Time) *order {
+	o := &order{
+		registeredAt: now,
+		status:       rule.Status,
+	}
+	if o.status == "" {
+		o.status = StatusProcessed
+	}
+
+	switch {
+	case rule.Accrual != nil:
+		o.accrual = *rule.Accrual
+	case rule.AccrualMax != nil && *rule.AccrualMax > rule.AccrualMin:
+		o.accrual = rule.AccrualMin + money.Amount(rand.Int64N(int64(*rule.AccrualMax-rule.AccrualMin)+1))
+	default:
+		o.accrual = rule.AccrualMin
+	}
+	return o
+}
+
+// statusAt returns the status of the order at the moment
+func (o *order) statusAt(now time.Time, step time.Duration) string {
+	switch elapsed := now.Sub(o.registeredAt); {
+	case elapsed < step:
+		return StatusRegistered
+	case elapsed < 2*step:
+		return StatusProcessing
+	}
+	return o.status
+}
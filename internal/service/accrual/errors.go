@@ -0,0 +1,13 @@
+package accrual
+
+import (
+	genSvc "github.com/oleshko-g/oggophermart/internal/gen/service"
+)
+
+// errTooManyRequests returns the error value which is used to map to the 429 Too Many Requests HTTP Status code
+func errTooManyRequests(retryAfter int) error {
+	return &genSvc.RateLimitError{
+		Name:       "Too many requests",
+		RetryAfter: retryAfter,
+	}
+}
@@ -0,0 +1,110 @@
+package accrual
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+)
+
+// Script is the behaviour of the accrual stub
+type Script struct {
+	// Rules define the orders known to the stub by their number prefixes.
+	// The orders which don't match any rule aren't registered
+	Rules []Rule `json:"rules"`
+	// StatusStep is the time an order stays in each of the statuses before its final one:
+	// REGISTERED, PROCESSING
+	StatusStep Duration `json:"status_step"`
+	// RequestsPerMinute limits the requests. Zero means unlimited
+	RequestsPerMinute int `json:"requests_per_minute"`
+}
+
+// Rule defines the processing of the orders which numbers start with the prefix
+type Rule struct {
+	Prefix string `json:"prefix"`
+	// Status is the final status of the orders: PROCESSED by default or INVALID
+	Status string `json:"status"`
+	// Accrual is a fixed accrual of the processed orders, e.g. 729.98
+	Accrual *money.Amount `json:"accrual"`
+	// AccrualMin and AccrualMax bound a random accrual of the processed orders if the fixed one isn't set.
+	// The accrual is AccrualMin if AccrualMax isn't set
+	AccrualMin money.Amount  `json:"accrual_min"`
+	AccrualMax *money.Amount `json:"accrual_max"`
+}
+
+// DefaultScript registers every order and accrues a random amount to it after a second in each status
+func DefaultScript() Script {
+	accrualMax := money.Points(1000)
+	return Script{
+		Rules:      []Rule{{AccrualMax: &accrualMax}},
+		StatusStep: Duration(time.Second),
+	}
+}
+
+// LoadScript reads the [Script] from the JSON file
+func LoadScript(path string) (Script, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Script{}, err
+	}
+
+	var s Script
+	if err = json.Unmarshal(data, &s); err != nil {
+		return Script{}, fmt.Errorf("parsing accrual script %s: %w", path, err)
+	}
+	return s, s.validate()
+}
+
+func (s Script) validate() error {
+	for _, r := range s.Rules {
+		switch r.Status {
+		case "", StatusProcessed, StatusInvalid:
+		default:
+			return fmt.Errorf("rule %q: final status must be %s or %s, got %q", r.Prefix, StatusProcessed, StatusInvalid, r.Status)
+		}
+		if r.AccrualMin < 0 || (r.Accrual != nil && *r.Accrual < 0) {
+			return fmt.Errorf("rule %q: accrual is negative", r.Prefix)
+		}
+		if r.AccrualMax != nil && r.AccrualMin > *r.AccrualMax {
+			return fmt.Errorf("rule %q: accrual_min %s is greater than accrual_max %s", r.Prefix, r.AccrualMin, *r.AccrualMax)
+		}
+	}
+	if s.RequestsPerMinute < 0 {
+		return fmt.Errorf("requests_per_minute %d is negative", s.RequestsPerMinute)
+	}
+	return nil
+}
+
+// rule returns the rule with the longest prefix of the number or nil if there's none
+func (s Script) rule(number string) *Rule {
+	var match *Rule
+	for i, r := range s.Rules {
+		if strings.HasPrefix(number, r.Prefix) && (match == nil || len(r.Prefix) > len(match.Prefix)) {
+			match = &s.Rules[i]
+		}
+	}
+	return match
+}
+
+// Duration is [time.Duration] encoded in JSON as a string like "1.5s"
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
@@ -0,0 +1,77 @@
+package accrual
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+)
+
+func TestScriptValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		wantErr bool
+	}{
+		{"empty", `{}`, false},
+		{"fixed accrual", `{"rules": [{"prefix": "1", "accrual": 729.98}]}`, false},
+		{"accrual range", `{"rules": [{"accrual_min": 10, "accrual_max": 20.5}]}`, false},
+		{"min only", `{"rules": [{"accrual_min": 10}]}`, false},
+		{"max only", `{"rules": [{"accrual_max": 10}]}`, false},
+		{"equal bounds", `{"rules": [{"accrual_min": 10, "accrual_max": 10}]}`, false},
+		{"invalid status", `{"rules": [{"status": "INVALID"}, {"prefix": "2", "status": "PROCESSED"}]}`, false},
+		{"unknown status", `{"rules": [{"status": "REGISTERED"}]}`, true},
+		{"min greater than max", `{"rules": [{"accrual_min": 20, "accrual_max": 10}]}`, true},
+		{"zero max below min", `{"rules": [{"accrual_min": 1, "accrual_max": 0}]}`, true},
+		{"negative min", `{"rules": [{"accrual_min": -1}]}`, true},
+		{"negative fixed accrual", `{"rules": [{"accrual": -1}]}`, true},
+		{"negative rate limit", `{"requests_per_minute": -1}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s Script
+			if err := json.Unmarshal([]byte(tt.script), &s); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("got the error %v, want an error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewOrderAccrual(t *testing.T) {
+	fixed, max, zero := money.Points(5), money.Points(20), money.Amount(0)
+	tests := []struct {
+		name     string
+		rule     Rule
+		min, max money.Amount
+	}{
+		{"fixed", Rule{Accrual: &fixed, AccrualMin: 10, AccrualMax: &max}, fixed, fixed},
+		{"min only", Rule{AccrualMin: 10}, 10, 10},
+		{"range", Rule{AccrualMin: 10, AccrualMax: &max}, 10, max},
+		{"zero max", Rule{AccrualMax: &zero}, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 100 {
+				if got := newOrder(&tt.rule, time.Now()).accrual; got < tt.min || got > tt.max {
+					t.Fatalf("got the accrual %s, want it from %s to %s", got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadScript(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "script.json")
+	if err := os.WriteFile(path, []byte(`{"rules": [{"accrual_min": 20, "accrual_max": 10}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadScript(path); err == nil {
+		t.Error("the invalid script is loaded")
+	}
+}
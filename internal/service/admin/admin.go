@@ -0,0 +1,303 @@
+// Package admin is an implementation of [genAdmin] service generated by goa design
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	genAdmin "github.com/oleshko-g/oggophermart/internal/gen/admin"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/service"
+	svcErrors "github.com/oleshko-g/oggophermart/internal/service/errors"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+type adminSvc struct {
+	storage.Admin
+	balance storage.Balance
+	users   storage.User
+	service.Auther
+}
+
+var _ genAdmin.Service = (*adminSvc)(nil)
+var _ genAdmin.Auther = (*adminSvc)(nil)
+
+// New returns the admin service implementation.
+// The scopes of the methods are checked by the auther before the methods are called
+func New(storage storage.Admin, balance storage.Balance, users storage.User, auther service.Auther) *adminSvc {
+	return &adminSvc{
+		Admin:   storage,
+		balance: balance,
+		users:   users,
+		Auther:  auther,
+	}
+}
+
+// Bootstrap promotes the registered user of the login to the first admin unless there is an admin already.
+// It reports whether the user is promoted. [ErrUserNotFound] is returned if the login isn't registered.
+// It's called once at the startup so the API never grants a role by the login
+func Bootstrap(ctx context.Context, admins storage.Admin, users storage.User, login string) (promoted bool, err error) {
+	existing, err := admins.SearchUsers(ctx, "", role.Admin, 1)
+	if err != nil || len(existing) > 0 {
+		return false, err
+	}
+	userID, _, err := users.RetrieveUserRole(ctx, login)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		return false, ErrUserNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+	if err = users.UpdateUserRole(ctx, userID, role.Admin); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SearchUsers implements SearchUsers
+func (s *adminSvc) SearchUsers(ctx context.Context, payload *genAdmin.SearchUsersPayload) (res []*genAdmin.AdminUser, err error) {
+	if _, err = s.Auther.UserIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	var login string
+	if payload.Login != nil {
+		login = *payload.Login
+	}
+	var r role.Role // any role matches
+	if payload.Role != nil {
+		if r, err = parseRole(*payload.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	users, err := s.Admin.SearchUsers(ctx, login, r, payload.Limit)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = make([]*genAdmin.AdminUser, 0, len(users)) // no users is an empty list
+	for _, u := range users {
+		res = append(res, adminUser(u))
+	}
+	return res, nil
+}
+
+// ListUserOrders implements ListUserOrders
+func (s *adminSvc) ListUserOrders(ctx context.Context, payload *genAdmin.ListUserOrdersPayload) (res []*genAdmin.Order, err error) {
+	if _, err = s.Auther.UserIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	userID, err := s.userID(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.balance.RetrieaveUserOrders(ctx, userID, storage.UserOrdersQuery{
+		Descending: true,
+		Limit:      payload.Limit,
+	})
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = make([]*genAdmin.Order, 0, len(orders))
+	for _, o := range orders {
+		userOrder := &genAdmin.Order{
+			Number:     o.Number,
+			Status:     o.Status.String(),
+			UploadedAt: o.CreatedAt.Format(time.RFC3339),
+		}
+		if o.Status == order.StatusProcessed {
+			userOrder.Accrual = o.Accrual
+		}
+		res = append(res, userOrder)
+	}
+	return res, nil
+}
+
+// GetUserLedger implements GetUserLedger
+func (s *adminSvc) GetUserLedger(ctx context.Context, payload *genAdmin.GetUserLedgerPayload) (res *genAdmin.Ledger, err error) {
+	if _, err = s.Auther.UserIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	userID, err := s.userID(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentBalance, withdrawn, err := s.balance.RetrieveUserBalance(ctx, userID)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+	entries, err := s.RetrieveLedger(ctx, userID, payload.Limit)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = &genAdmin.Ledger{
+		Balance:   currentBalance,
+		Withdrawn: withdrawn,
+		Entries:   make([]*genAdmin.LedgerEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		res.Entries = append(res.Entries, ledgerEntry(e))
+	}
+	return res, nil
+}
+
+// AdjustBalance implements AdjustBalance. The admin who adjusts the balance is recorded with the reason
+func (s *adminSvc) AdjustBalance(ctx context.Context, payload *genAdmin.AdjustBalancePayload) (res *genAdmin.LedgerEntry, err error) {
+	actorID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := parseID(payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Amount == 0 {
+		return nil, fmt.Errorf("%w: the amount is zero", svcErrors.ErrInvalidInputParameter)
+	}
+	reason := strings.TrimSpace(payload.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: no reason", svcErrors.ErrInvalidInputParameter)
+	}
+
+	e, err := s.Admin.AdjustBalance(ctx, storage.LedgerEntry{
+		UserID:    userID,
+		Kind:      storage.LedgerAdjustment,
+		Amount:    payload.Amount,
+		Reason:    sql.NullString{String: reason, Valid: true},
+		ActorID:   uuid.NullUUID{UUID: actorID, Valid: true},
+		CreatedAt: time.Now().UTC(),
+	})
+	switch {
+	case errors.Is(err, storageErrors.ErrNotFound):
+		return nil, ErrUserNotFound
+	case errors.Is(err, storageErrors.ErrNoAffect):
+		return nil, ErrInsufficientBalance
+	case err != nil:
+		return nil, svcErrors.ErrInternalServiceError
+	}
+	return ledgerEntry(e), nil
+}
+
+// SetUserRole implements SetUserRole
+func (s *adminSvc) SetUserRole(ctx context.Context, payload *genAdmin.SetUserRolePayload) (res *genAdmin.AdminUser, err error) {
+	if _, err = s.Auther.UserIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	userID, err := parseID(payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	r, err := parseRole(payload.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.users.UpdateUserRole(ctx, userID, r); err != nil {
+		return nil, serviceError(err)
+	}
+	u, err := s.RetrieveUserAccount(ctx, userID)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return adminUser(u), nil
+}
+
+// ReprocessOrder implements ReprocessOrder. The order is processed by the accrual system again
+func (s *adminSvc) ReprocessOrder(ctx context.Context, payload *genAdmin.ReprocessOrderPayload) (err error) {
+	actorID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.Admin.ReprocessOrder(ctx, payload.Number, actorID, time.Now().UTC())
+	switch {
+	case errors.Is(err, storageErrors.ErrNotFound):
+		return ErrOrderNotFound
+	case errors.Is(err, order.ErrTransition):
+		return ErrOrderNotFinal
+	case err != nil:
+		return svcErrors.ErrInternalServiceError
+	}
+	return nil
+}
+
+// userID returns the ID of the existing user. [ErrUserNotFound] is returned otherwise
+func (s *adminSvc) userID(ctx context.Context, id string) (uuid.UUID, error) {
+	userID, err := parseID(id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err = s.RetrieveUserAccount(ctx, userID); err != nil {
+		return uuid.Nil, serviceError(err)
+	}
+	return userID, nil
+}
+
+// serviceError maps the error of the storage to the error of the service
+func serviceError(err error) error {
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		return ErrUserNotFound
+	}
+	return svcErrors.ErrInternalServiceError
+}
+
+func parseID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	return id, nil
+}
+
+func parseRole(s string) (role.Role, error) {
+	r, err := role.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	return r, nil
+}
+
+func adminUser(u storage.UserAccount) *genAdmin.AdminUser {
+	return &genAdmin.AdminUser{
+		ID:        u.ID.String(),
+		Login:     u.Login,
+		Role:      u.Role.String(),
+		CreatedAt: u.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ledgerEntry(e storage.LedgerEntry) *genAdmin.LedgerEntry {
+	res := &genAdmin.LedgerEntry{
+		ID:        e.ID,
+		Kind:      e.Kind,
+		Amount:    e.Amount,
+		CreatedAt: e.CreatedAt.Format(time.RFC3339),
+	}
+	if e.OrderNumber.Valid {
+		res.Order = &e.OrderNumber.String
+	}
+	if e.Reason.Valid {
+		res.Reason = &e.Reason.String
+	}
+	if e.ActorID.Valid {
+		actorID := e.ActorID.UUID.String()
+		res.ActorID = &actorID
+	}
+	return res
+}
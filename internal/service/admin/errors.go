@@ -0,0 +1,16 @@
+package admin
+
+import (
+	"github.com/oleshko-g/oggophermart/internal/service/errors"
+)
+
+var (
+	// ErrUserNotFound is the error value which is used to map to the 404 Not Found HTTP Status code
+	ErrUserNotFound = errors.New("User not found")
+	// ErrOrderNotFound is the error value which is used to map to the 404 Not Found HTTP Status code
+	ErrOrderNotFound = errors.New("Order not found")
+	// ErrInsufficientBalance is the error value which is used to map to the 402 Payment Required HTTP Status code
+	ErrInsufficientBalance = errors.New("Insufficient balance")
+	// ErrOrderNotFinal is the error value which is used to map to the 409 Conflict HTTP Status code
+	ErrOrderNotFinal = errors.New("The order isn't processed yet")
+)
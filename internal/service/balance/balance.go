@@ -4,12 +4,14 @@ package balance
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
-	"github.com/EClaesson/go-luhn"
 	genBalance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	"github.com/oleshko-g/oggophermart/internal/order"
 	"github.com/oleshko-g/oggophermart/internal/service"
 	svcErrors "github.com/oleshko-g/oggophermart/internal/service/errors"
+	"github.com/oleshko-g/oggophermart/internal/service/ordernumber"
 	"github.com/oleshko-g/oggophermart/internal/storage"
 	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
 )
@@ -19,26 +21,23 @@ import (
 type balanceSvc struct {
 	storage.Balance
 	service.Auther
+	events *OrderEvents
 }
 
 var _ genBalance.Service = (*balanceSvc)(nil)
 var _ genBalance.Auther = (*balanceSvc)(nil)
 
-// New returns the balance service implementation.
-func New(storage storage.Balance, auther service.Auther) *balanceSvc {
+// New returns the balance service implementation. The order events are streamed from the events
+func New(storage storage.Balance, events *OrderEvents, auther service.Auther) *balanceSvc {
 	return &balanceSvc{
 		Balance: storage,
 		Auther:  auther,
+		events:  events,
 	}
 }
 
 // PostOrder implements post order.
 func (s *balanceSvc) UploadUserOrder(ctx context.Context, payload *genBalance.UploadUserOrderPayload) (res *genBalance.UploadUserOrderResult, err error) {
-	ctx, err = s.Auther.JWTAuth(ctx, payload.Authorization, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	userID, err := s.Auther.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -49,66 +48,65 @@ func (s *balanceSvc) UploadUserOrder(ctx context.Context, payload *genBalance.Up
 		return nil, err
 	}
 
-	dbUserID, err := s.RetreiveOrderUser(ctx, payload.OrderNumber)
-	if err != nil {
-		if !errors.Is(err, storageErrors.ErrNotFound) {
-			return nil, err
-		}
-	} else if dbUserID != userID {
-		return nil, ErrOwnerMismatch
-	}
-
 	res = &genBalance.UploadUserOrderResult{
 		Accepted: nil,
 	}
-	if dbUserID == userID {
-		return res, nil
-	}
+	// the order is inserted first so of the concurrent uploads exactly one is accepted
+	// and the rest find out the owner of the order which is already stored
+	err = s.WithinTx(ctx, func(tx storage.Tx) error {
+		res.Accepted = nil // the transaction may be retried
+		err := tx.StoreOrder(ctx, userID, payload.OrderNumber, order.StatusNew, time.Now().UTC())
+		if err == nil {
+			accepted := "yes"
+			res.Accepted = &accepted
+			return nil
+		}
+		if !errors.Is(err, storageErrors.ErrAlreadyExists) {
+			return err
+		}
 
-	err = s.StoreOrder(ctx, userID, payload.OrderNumber, OrderStatusNew, time.Now().UTC())
+		dbUserID, err := tx.RetreiveOrderUser(ctx, payload.OrderNumber)
+		if err != nil {
+			return err
+		}
+		if dbUserID != userID {
+			return ErrOwnerMismatch
+		}
+		return nil
+	})
 	if err != nil {
+		if errors.Is(err, ErrOwnerMismatch) {
+			return nil, ErrOwnerMismatch
+		}
 		return nil, svcErrors.ErrInternalServiceError
 	}
-	accepted := "yes"
-	res.Accepted = &accepted
 	return res, nil
 }
 
-// Orders statuses
-const (
-	OrderStatusNew        = "NEW"
-	OrderStatusProcessing = "PROCESSING"
-	OrderStatusProcessed  = "PROCESSED"
-	OrderStatusInvalid    = "INVALID"
-)
-
+// checkOrderNumber returns [ErrInvalidOrderNumber] wrapping the reason if the order number isn't valid
 func checkOrderNumber(orderNumber string) error {
-
-	valid, err := luhn.IsValid(orderNumber)
-	if err != nil {
-		return err
-	}
-
-	if !valid {
-		return ErrInvalidOrderNumber
+	if err := ordernumber.Validate(orderNumber); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidOrderNumber, err)
 	}
-
 	return nil
 }
 
 func (s *balanceSvc) ListUserOrder(ctx context.Context, payload *genBalance.ListUserOrderPayload) (res *genBalance.ListUserOrderResult, err error) {
 
-	ctx, err = s.Auther.JWTAuth(ctx, payload.Authorization, nil)
+	userID, err := s.Auther.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	userID, err := s.Auther.UserIDFromContext(ctx)
+	q, err := userOrdersQuery(payload)
 	if err != nil {
 		return nil, err
 	}
+	if q.Limit > 0 {
+		q.Limit++ // the extra order tells if there's the next page
+	}
 
-	ordersByUserID, err := s.RetrieaveUserOrders(ctx, userID)
+	ordersByUserID, err := s.RetrieaveUserOrders(ctx, userID, q)
 	if err != nil {
 		return nil, err
 	}
@@ -120,14 +118,134 @@ func (s *balanceSvc) ListUserOrder(ctx context.Context, payload *genBalance.List
 		return res, nil
 	}
 
+	if q.Limit > 0 && len(ordersByUserID) == q.Limit {
+		ordersByUserID = ordersByUserID[:q.Limit-1]
+		last := ordersByUserID[len(ordersByUserID)-1]
+		cursor := encodeCursor(storage.OrderKey{CreatedAt: last.CreatedAt, ID: last.ID})
+		link := nextPageLink(payload, cursor)
+		res.NextCursor, res.Link = &cursor, &link
+	}
+
 	for _, v := range ordersByUserID {
 		userOrder := &genBalance.Order{
 			Number:     v.Number,
-			Status:     v.Status,
+			Status:     v.Status.String(),
 			UploadedAt: v.CreatedAt.Format(time.RFC3339),
 		}
+		if v.Status == order.StatusProcessed {
+			userOrder.Accrual = v.Accrual
+		}
 		res.Orders = append(res.Orders, userOrder)
 	}
 
 	return res, nil
 }
+
+// GetUserOrder implements GetUserOrder.
+// The orders of the other users aren't found so their numbers can't be probed
+func (s *balanceSvc) GetUserOrder(ctx context.Context, payload *genBalance.GetUserOrderPayload) (res *genBalance.OrderTimeline, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	o, history, err := s.RetrieveUserOrder(ctx, userID, payload.Number)
+	if err != nil {
+		if errors.Is(err, storageErrors.ErrNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = &genBalance.OrderTimeline{
+		Number:     o.Number,
+		Status:     o.Status.String(),
+		UploadedAt: o.CreatedAt.Format(time.RFC3339),
+	}
+	if o.Status == order.StatusProcessed {
+		res.Accrual = o.Accrual
+	}
+	if o.ProcessedAt.Valid {
+		processedAt := o.ProcessedAt.Time.Format(time.RFC3339)
+		res.ProcessedAt = &processedAt
+	}
+	for _, h := range history {
+		change := &genBalance.OrderStatusChange{
+			Status: h.ToStatus.String(),
+			Source: string(h.Source),
+			At:     h.CreatedAt.Format(time.RFC3339),
+		}
+		if h.FromStatus != nil {
+			from := h.FromStatus.String()
+			change.From = &from
+		}
+		res.History = append(res.History, change)
+	}
+	return res, nil
+}
+
+// GetUserBalance implements GetUserBalance
+func (s *balanceSvc) GetUserBalance(ctx context.Context, payload *genBalance.GetUserBalancePayload) (res *genBalance.UserBalance, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, withdrawn, err := s.RetrieveUserBalance(ctx, userID)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+	return &genBalance.UserBalance{Current: current, Withdrawn: withdrawn}, nil
+}
+
+// Withdraw implements Withdraw. The order number is checked as the numbers of the uploaded orders
+func (s *balanceSvc) Withdraw(ctx context.Context, payload *genBalance.WithdrawPayload) (err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = checkOrderNumber(payload.Order); err != nil {
+		return err
+	}
+	if payload.Sum <= 0 {
+		return fmt.Errorf("%w: the sum isn't positive", svcErrors.ErrInvalidInputParameter)
+	}
+
+	err = s.WithdrawUserPoints(ctx, userID, payload.Order, payload.Sum, time.Now().UTC())
+	switch {
+	case errors.Is(err, storageErrors.ErrNoAffect):
+		return ErrInsufficientFunds
+	case err != nil:
+		return svcErrors.ErrInternalServiceError
+	}
+	return nil
+}
+
+// ListWithdrawals implements ListWithdrawals
+func (s *balanceSvc) ListWithdrawals(ctx context.Context, payload *genBalance.ListWithdrawalsPayload) (res *genBalance.ListWithdrawalsResult, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals, err := s.RetrieveUserWithdrawals(ctx, userID)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = new(genBalance.ListWithdrawalsResult)
+	if len(withdrawals) == 0 {
+		noWithdrawals := "yes"
+		res.NoWithdrawals = &noWithdrawals
+		return res, nil
+	}
+	for _, w := range withdrawals {
+		res.Withdrawals = append(res.Withdrawals, &genBalance.Withdrawal{
+			Order:       w.OrderNumber.String,
+			Sum:         -w.Amount,
+			ProcessedAt: w.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,181 @@
+package balance
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	genBalance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	svcErrors "github.com/oleshko-g/oggophermart/internal/service/errors"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+const (
+	// MaxUploadOrders is the limit of the orders uploaded by a single request
+	MaxUploadOrders = 10_000
+	// maxUploadBodySize is the limit of the body of a single upload
+	maxUploadBodySize = 4 << 20
+)
+
+// Results of the order upload
+const (
+	OrderUploadAccepted  = "accepted"
+	OrderUploadDuplicate = "duplicate"
+	OrderUploadConflict  = "conflict"
+	OrderUploadInvalid   = "invalid"
+)
+
+// UploadUserOrders stores the valid orders of the user in a single transaction
+// and returns the result for every uploaded number in the order of upload.
+// A number repeated in the upload is a duplicate of its first occurrence
+func (s *balanceSvc) UploadUserOrders(ctx context.Context, payload *genBalance.UploadUserOrdersPayload, body io.ReadCloser) (res *genBalance.UploadUserOrdersResult, err error) {
+	defer body.Close()
+
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var contentType string
+	if payload.ContentType != nil {
+		contentType = *payload.ContentType
+	}
+	numbers, err := parseOrderNumbers(contentType, body)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &genBalance.UploadUserOrdersResult{
+		Orders: make([]*genBalance.OrderUploadResult, len(numbers)),
+	}
+	firstOccurrence := make(map[string]int, len(numbers))
+	var toStore []string
+	for i, n := range numbers {
+		res.Orders[i] = &genBalance.OrderUploadResult{Number: n}
+		if checkOrderNumber(n) != nil {
+			res.Orders[i].Result = OrderUploadInvalid
+			continue
+		}
+		if _, ok := firstOccurrence[n]; !ok {
+			firstOccurrence[n] = i
+			toStore = append(toStore, n)
+		}
+	}
+
+	var skipped map[string]uuid.UUID
+	if len(toStore) > 0 {
+		err = s.WithinTx(ctx, func(tx storage.Tx) error {
+			var err error
+			skipped, err = tx.StoreUserOrders(ctx, userID, toStore, order.StatusNew, time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return nil, svcErrors.ErrInternalServiceError
+		}
+	}
+
+	for i, o := range res.Orders {
+		if o.Result == OrderUploadInvalid {
+			continue
+		}
+		if first := firstOccurrence[o.Number]; first < i {
+			o.Result = res.Orders[first].Result
+			if o.Result == OrderUploadAccepted {
+				o.Result = OrderUploadDuplicate
+			}
+			continue
+		}
+		owner, ok := skipped[o.Number]
+		switch {
+		case !ok:
+			o.Result = OrderUploadAccepted
+		case owner == userID:
+			o.Result = OrderUploadDuplicate
+		default:
+			o.Result = OrderUploadConflict
+		}
+	}
+	return res, nil
+}
+
+// parseOrderNumbers reads the order numbers from the body which is a JSON array of the numbers
+// if the contentType is application/json and the numbers delimited by new lines otherwise.
+// The numbers aren't validated. Empty lines are skipped
+func parseOrderNumbers(contentType string, body io.Reader) (numbers []string, err error) {
+	limited := &io.LimitedReader{R: body, N: maxUploadBodySize + 1}
+	defer func() {
+		if limited.N <= 0 {
+			numbers, err = nil, ErrTooManyOrders
+		}
+	}()
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/json" {
+		numbers, err = parseJSONOrderNumbers(limited)
+	} else {
+		numbers, err = parseTextOrderNumbers(limited)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(numbers) == 0 {
+		return nil, fmt.Errorf("%w: no order numbers", svcErrors.ErrInvalidInputParameter)
+	}
+	if len(numbers) > MaxUploadOrders {
+		return nil, ErrTooManyOrders
+	}
+	return numbers, nil
+}
+
+// parseJSONOrderNumbers reads a JSON array of the numbers which are either strings or JSON numbers
+func parseJSONOrderNumbers(body io.Reader) ([]string, error) {
+	dec := json.NewDecoder(body)
+	dec.UseNumber() // the numbers may be longer than float64 keeps precisely
+
+	var values []any
+	if err := dec.Decode(&values); err != nil {
+		return nil, fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: data after the JSON array", svcErrors.ErrInvalidInputParameter)
+	}
+
+	numbers := make([]string, 0, len(values))
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			numbers = append(numbers, v)
+		case json.Number:
+			numbers = append(numbers, v.String())
+		default:
+			return nil, fmt.Errorf("%w: the order number %v is neither a string nor a number", svcErrors.ErrInvalidInputParameter, v)
+		}
+	}
+	return numbers, nil
+}
+
+// parseTextOrderNumbers reads the numbers delimited by \n or \r\n
+func parseTextOrderNumbers(body io.Reader) ([]string, error) {
+	var numbers []string
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		numbers = append(numbers, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	return numbers, nil
+}
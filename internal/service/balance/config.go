@@ -0,0 +1,55 @@
+package balance
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Config represents the config of the streams of the order events. The zero value is the default config.
+// It's safe to be set while the streams are open, the changes take effect upon the next heartbeat
+type Config struct {
+	heartbeat interval
+}
+
+// DefaultHeartbeat is the default interval between the heartbeat events of the streams
+const DefaultHeartbeat = 15 * time.Second
+
+// Heartbeat returns a pointer to the [flag.Value] to set the interval between the heartbeat events of the streams
+func (c *Config) Heartbeat() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.heartbeat
+}
+
+// errParsingConfig indicates an invalid value of a [Config] parameter
+var errParsingConfig = errors.New("error parsing order events config")
+
+// interval is a positive [time.Duration]
+type interval struct {
+	v atomic.Int64
+}
+
+func (i *interval) String() string {
+	return i.Duration().String()
+}
+
+// Set parses s by [time.ParseDuration] and sets it or returns an error
+func (i *interval) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("%w: %s isn't positive", errParsingConfig, d)
+	}
+	i.v.Store(int64(d))
+	return nil
+}
+
+// Duration returns the interval or the [DefaultHeartbeat] if it isn't set
+func (i *interval) Duration() time.Duration {
+	if d := i.v.Load(); d > 0 {
+		return time.Duration(d)
+	}
+	return DefaultHeartbeat
+}
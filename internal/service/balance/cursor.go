@@ -0,0 +1,115 @@
+package balance
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	genBalance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	svcErrors "github.com/oleshko-g/oggophermart/internal/service/errors"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+// listUserOrderPath is the path of the next page links
+const listUserOrderPath = "/api/user/orders"
+
+// Directions of sorting the orders
+const (
+	sortAsc  = "asc"
+	sortDesc = "desc"
+)
+
+// userOrdersQuery returns the storage query of the page of the orders requested by the payload.
+// The payload without the paging parameters selects all the orders in the order of their upload
+func userOrdersQuery(payload *genBalance.ListUserOrderPayload) (q storage.UserOrdersQuery, err error) {
+	for _, s := range payload.Status {
+		status, err := order.ParseStatus(s)
+		if err != nil {
+			return q, fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, err)
+		}
+		q.Statuses = append(q.Statuses, status)
+	}
+	if payload.Limit != nil {
+		q.Limit = *payload.Limit
+	}
+	if payload.Sort != nil {
+		q.Descending = *payload.Sort == sortDesc
+	}
+	if payload.From != nil {
+		if q.From, err = time.Parse(time.RFC3339, *payload.From); err != nil {
+			return q, fmt.Errorf("%w: from: %w", svcErrors.ErrInvalidInputParameter, err)
+		}
+	}
+	if payload.To != nil {
+		if q.To, err = time.Parse(time.RFC3339, *payload.To); err != nil {
+			return q, fmt.Errorf("%w: to: %w", svcErrors.ErrInvalidInputParameter, err)
+		}
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return q, fmt.Errorf("%w: from must be before to", svcErrors.ErrInvalidInputParameter)
+	}
+	if payload.Cursor != nil {
+		key, err := decodeCursor(*payload.Cursor)
+		if err != nil {
+			return q, err
+		}
+		q.After = &key
+	}
+	return q, nil
+}
+
+// encodeCursor returns the opaque cursor of the position of the order in the listing
+func encodeCursor(key storage.OrderKey) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(strconv.FormatInt(key.CreatedAt.UnixNano(), 10) + "." + key.ID.String()),
+	)
+}
+
+// decodeCursor returns the position of the order encoded by [encodeCursor]
+func decodeCursor(cursor string) (storage.OrderKey, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return storage.OrderKey{}, fmt.Errorf("%w: cursor: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	nanos, id, ok := strings.Cut(string(b), ".")
+	if !ok {
+		return storage.OrderKey{}, fmt.Errorf("%w: malformed cursor", svcErrors.ErrInvalidInputParameter)
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return storage.OrderKey{}, fmt.Errorf("%w: cursor: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	key := storage.OrderKey{CreatedAt: time.Unix(0, n).UTC()}
+	if key.ID, err = uuid.Parse(id); err != nil {
+		return storage.OrderKey{}, fmt.Errorf("%w: cursor: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	return key, nil
+}
+
+// nextPageLink returns the value of the Link header of the page following the cursor.
+// The link keeps the filters of the payload
+func nextPageLink(payload *genBalance.ListUserOrderPayload, cursor string) string {
+	params := url.Values{}
+	params.Set("cursor", cursor)
+	if payload.Limit != nil {
+		params.Set("limit", strconv.Itoa(*payload.Limit))
+	}
+	for _, status := range payload.Status {
+		params.Add("status", status)
+	}
+	if payload.From != nil {
+		params.Set("from", *payload.From)
+	}
+	if payload.To != nil {
+		params.Set("to", *payload.To)
+	}
+	if payload.Sort != nil {
+		params.Set("sort", *payload.Sort)
+	}
+	return fmt.Sprintf(`<%s?%s>; rel="next"`, listUserOrderPath, params.Encode())
+}
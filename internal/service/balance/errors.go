@@ -9,4 +9,10 @@ var (
 	ErrOwnerMismatch = errors.New("The order belongs to another user")
 	// ErrInvalidOrderNumber is the error value which is used to map to the 422 Unprocessable Entity HTTP Status code
 	ErrInvalidOrderNumber = errors.New("Invalid order number")
+	// ErrOrderNotFound is the error value which is used to map to the 404 Not Found HTTP Status code
+	ErrOrderNotFound = errors.New("Order not found")
+	// ErrTooManyOrders is the error value which is used to map to the 413 Request Entity Too Large HTTP Status code
+	ErrTooManyOrders = errors.New("Too many orders")
+	// ErrInsufficientFunds is the error value which is used to map to the 402 Payment Required HTTP Status code
+	ErrInsufficientFunds = errors.New("Insufficient funds")
 )
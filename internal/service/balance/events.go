@@ -0,0 +1,205 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	genBalance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	svcErrors "github.com/oleshko-g/oggophermart/internal/service/errors"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"goa.design/clue/log"
+)
+
+const (
+	// eventsBatchSize is the maximum number of the events retrieved at once
+	eventsBatchSize = 100
+	// listenRetryInterval is the interval between the attempts to listen for the order events after a failure
+	listenRetryInterval = 5 * time.Second
+)
+
+// Types of the order events
+const (
+	eventTypeStatus    = "status"
+	eventTypeHeartbeat = "heartbeat"
+)
+
+// OrderEvents fans out the notifications of the order events from the storage to the streams of the users
+type OrderEvents struct {
+	storage     storage.OrderEvents
+	cfg         *Config
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+// NewOrderEvents returns the [OrderEvents] of the storage which is set up by the cfg
+func NewOrderEvents(storage storage.OrderEvents, cfg *Config) *OrderEvents {
+	return &OrderEvents{
+		storage:     storage,
+		cfg:         cfg,
+		subscribers: make(map[uuid.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+// Run listens for the notifications of the order events until ctx is done.
+// The listening is restarted after a failure, the events missed meanwhile are caught up by the streams upon their heartbeats
+func (e *OrderEvents) Run(ctx context.Context) error {
+	for {
+		err := e.storage.ListenOrderEvents(ctx, e.notify)
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Errorf(ctx, err, "failed to listen for the order events")
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(listenRetryInterval):
+		}
+	}
+}
+
+// subscribe returns the channel which receives a value when the orders of the user have new events.
+// The notifications which come while the previous one isn't received are merged into it
+func (e *OrderEvents) subscribe(userID uuid.UUID) (notified <-chan struct{}, unsubscribe func()) {
+	ch := make(chan struct{}, 1)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.subscribers[userID] == nil {
+		e.subscribers[userID] = make(map[chan struct{}]struct{})
+	}
+	e.subscribers[userID][ch] = struct{}{}
+
+	return ch, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		delete(e.subscribers[userID], ch)
+		if len(e.subscribers[userID]) == 0 {
+			delete(e.subscribers, userID)
+		}
+	}
+}
+
+func (e *OrderEvents) notify(userID uuid.UUID) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for ch := range e.subscribers[userID] {
+		select {
+		case ch <- struct{}{}:
+		default: // the stream is notified already
+		}
+	}
+}
+
+// StreamUserOrderEvents implements StreamUserOrderEvents.
+// The stream starts with a heartbeat and sends the events following the Last-Event-ID or the new events if it's absent.
+// A heartbeat is sent by the [Config.Heartbeat] and the events missed by the notifications are caught up on it.
+// The stream ends when the client leaves or the events can't be retrieved, so the client reconnects from its last event
+func (s *balanceSvc) StreamUserOrderEvents(ctx context.Context, payload *genBalance.StreamUserOrderEventsPayload, stream genBalance.StreamUserOrderEventsServerStream) (err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// subscribed before the last event is retrieved so the events following it are notified
+	notified, unsubscribe := s.events.subscribe(userID)
+	defer unsubscribe()
+
+	lastID, err := s.lastEventID(ctx, userID, payload.LastEventID)
+	if err != nil {
+		return err
+	}
+
+	if err = stream.SendWithContext(ctx, heartbeatEvent()); err != nil {
+		return nil // the client left
+	}
+	heartbeat := time.NewTimer(s.events.cfg.Heartbeat().Duration())
+	defer heartbeat.Stop()
+	for {
+		lastID, err = s.sendOrderEvents(ctx, stream, userID, lastID)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Errorf(ctx, err, "failed to stream the order events")
+			}
+			return nil // the response is started already
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notified:
+		case <-heartbeat.C:
+			if err = stream.SendWithContext(ctx, heartbeatEvent()); err != nil {
+				return nil
+			}
+			heartbeat.Reset(s.events.cfg.Heartbeat().Duration())
+		}
+	}
+}
+
+// lastEventID parses the ID of the last event received by the client.
+// If it's absent then the latest event of the user is the last one so only the new events are sent
+func (s *balanceSvc) lastEventID(ctx context.Context, userID uuid.UUID, lastEventID *string) (int64, error) {
+	if lastEventID == nil {
+		id, err := s.events.storage.RetrieveLastOrderEventID(ctx, userID)
+		if err != nil {
+			return 0, svcErrors.ErrInternalServiceError
+		}
+		return id, nil
+	}
+
+	id, err := strconv.ParseInt(*lastEventID, 10, 64)
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("%w: Last-Event-ID %q isn't an event ID", svcErrors.ErrInvalidInputParameter, *lastEventID)
+	}
+	return id, nil
+}
+
+// sendOrderEvents sends the events of the orders of the user following the event afterID and returns the ID of the last sent event
+func (s *balanceSvc) sendOrderEvents(ctx context.Context, stream genBalance.StreamUserOrderEventsServerStream, userID uuid.UUID, afterID int64) (lastID int64, err error) {
+	for {
+		events, err := s.events.storage.RetrieveOrderEvents(ctx, userID, afterID, eventsBatchSize)
+		if err != nil {
+			return afterID, err
+		}
+		for _, e := range events {
+			if err = stream.SendWithContext(ctx, orderEvent(e)); err != nil {
+				return afterID, err
+			}
+			afterID = e.ID
+		}
+		if len(events) < eventsBatchSize {
+			return afterID, nil
+		}
+	}
+}
+
+// orderEvent returns the status event of the transition. The accrual is set for the processed order
+func orderEvent(e storage.OrderEvent) *genBalance.OrderEvent {
+	status, source, at := e.ToStatus.String(), string(e.Source), e.CreatedAt.Format(time.RFC3339)
+	res := &genBalance.OrderEvent{
+		ID:     strconv.FormatInt(e.ID, 10),
+		Type:   eventTypeStatus,
+		Number: &e.Number,
+		Status: &status,
+		Source: &source,
+		At:     &at,
+	}
+	if e.FromStatus != nil {
+		from := e.FromStatus.String()
+		res.From = &from
+	}
+	if e.ToStatus == order.StatusProcessed {
+		res.Accrual = e.Accrual
+	}
+	return res
+}
+
+func heartbeatEvent() *genBalance.OrderEvent {
+	return &genBalance.OrderEvent{Type: eventTypeHeartbeat}
+}
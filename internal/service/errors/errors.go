@@ -17,6 +17,8 @@ var (
 	ErrInvalidInputParameter = New("Invalid input parameter")
 	// ErrUserIsNotAuthenticated is the error value which is used to map to the 401 Unauthorized HTTP Status code
 	ErrUserIsNotAuthenticated = New("User is not authenticated")
+	// ErrUserIsNotAuthorized is the error value which is used to map to the 403 Forbidden HTTP Status code
+	ErrUserIsNotAuthorized = New("User is not authorized")
 	// ErrInternalServiceError is the error value which is used to map to the 500 Internal Server Error HTTP Status code
 	ErrInternalServiceError = New("Internal service error")
 	// ErrNotImplemented is the error value which is used to map to the 501 Not Implemented HTTP Status code
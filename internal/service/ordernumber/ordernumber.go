@@ -0,0 +1,97 @@
+// Package ordernumber validates the numbers of the orders uploaded by the users
+// and handled by the accrual system.
+//
+// A valid order number is a string of [MinLength] to [MaxLength] ASCII digits 0-9
+// which doesn't start with 0 and passes the Luhn checksum. The number is validated as is:
+//   - whitespace anywhere, including a trailing new line, makes it invalid
+//   - Unicode digits other than ASCII 0-9, e.g. fullwidth or Arabic-Indic ones, make it invalid
+//   - a leading zero makes it invalid even if the checksum passes
+package ordernumber
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// MinLength is the number of digits of the shortest order number: a payload digit and a check digit
+	MinLength = 2
+	// MaxLength is the number of digits of the longest order number.
+	// It bounds the size of the numbers stored and sent to the accrual system
+	MaxLength = 64
+)
+
+var (
+	// ErrInvalid is wrapped by all the errors returned by [Validate]
+	ErrInvalid = errors.New("invalid order number")
+	// ErrLength means the number is shorter than [MinLength] or longer than [MaxLength]
+	ErrLength = fmt.Errorf("%w: length must be %d to %d digits", ErrInvalid, MinLength, MaxLength)
+	// ErrNotDigit means the number contains a character other than ASCII 0-9
+	ErrNotDigit = fmt.Errorf("%w: must contain only digits 0-9", ErrInvalid)
+	// ErrLeadingZero means the number starts with 0
+	ErrLeadingZero = fmt.Errorf("%w: must not start with 0", ErrInvalid)
+	// ErrChecksum means the number doesn't pass the Luhn checksum
+	ErrChecksum = fmt.Errorf("%w: Luhn checksum mismatch", ErrInvalid)
+)
+
+// Validate returns nil if the number is a valid order number
+// or one of the errors wrapping [ErrInvalid] otherwise
+func Validate(number string) error {
+	// the length in bytes is the number of digits if all of them are ASCII
+	if len(number) < MinLength || len(number) > MaxLength {
+		return ErrLength
+	}
+	for i := 0; i < len(number); i++ {
+		if !isDigit(number[i]) {
+			return ErrNotDigit
+		}
+	}
+	if number[0] == '0' {
+		return ErrLeadingZero
+	}
+	if !luhn(number) {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// CheckDigit returns the digit which makes the payload a number passing the Luhn checksum.
+// The payload must contain only digits 0-9
+func CheckDigit(payload string) (byte, error) {
+	for i := 0; i < len(payload); i++ {
+		if !isDigit(payload[i]) {
+			return 0, ErrNotDigit
+		}
+	}
+	// the payload is shifted by the check digit so every digit in an even position from the right is doubled
+	sum := luhnSum(payload, true)
+	return '0' + byte((10-sum%10)%10), nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// luhn reports whether the digits pass the Luhn checksum
+func luhn(digits string) bool {
+	return luhnSum(digits, false)%10 == 0
+}
+
+// luhnSum returns the Luhn sum of the digits.
+// The rightmost digit is doubled if doubleRightmost is true
+func luhnSum(digits string, doubleRightmost bool) int {
+	sum := 0
+	double := doubleRightmost
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum
+}
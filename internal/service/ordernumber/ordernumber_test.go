@@ -0,0 +1,154 @@
+package ordernumber
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestValidate(t *testing.T) {
+	longest := strings.Repeat("1", MaxLength-1)
+	d, _ := CheckDigit(longest)
+	longest += string(d)
+
+	tests := []struct {
+		name   string
+		number string
+		want   error
+	}{
+		{"valid", "12345678903", nil},
+		{"valid card number", "4561261212345467", nil},
+		{"valid shortest", "18", nil},
+		{"valid longest", longest, nil},
+		{"empty", "", ErrLength},
+		{"single digit", "0", ErrLength},
+		{"too long", strings.Repeat("1", MaxLength) + "3", ErrLength},
+		{"checksum mismatch", "12345678904", ErrChecksum},
+		{"leading zero", "012345678903", ErrLeadingZero},
+		{"all zeros", "00", ErrLeadingZero},
+		{"leading space", " 12345678903", ErrNotDigit},
+		{"trailing new line", "12345678903\n", ErrNotDigit},
+		{"inner space", "1234 5678903", ErrNotDigit},
+		{"sign", "+12345678903", ErrNotDigit},
+		{"letters", "1234567890a", ErrNotDigit},
+		{"fullwidth digits", "１２３４５６７８９０３", ErrNotDigit},
+		{"Arabic-Indic digits", "١٢٣٤٥٦٧٨٩٠٣", ErrNotDigit},
+		{"invalid UTF-8", "1234567890\xff", ErrNotDigit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.number)
+			if err != tt.want {
+				t.Errorf("Validate(%q) = %v, want %v", tt.number, err, tt.want)
+			}
+			if err != nil && !errors.Is(err, ErrInvalid) {
+				t.Errorf("Validate(%q) = %v doesn't wrap %v", tt.number, err, ErrInvalid)
+			}
+		})
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    byte
+	}{
+		{"", '0'},
+		{"1", '8'},
+		{"1234567890", '3'},
+		{"927892347", '0'},
+		{"456126121234546", '7'},
+	}
+	for _, tt := range tests {
+		got, err := CheckDigit(tt.payload)
+		if err != nil || got != tt.want {
+			t.Errorf("CheckDigit(%q) = %q, %v, want %q", tt.payload, got, err, tt.want)
+		}
+	}
+
+	if _, err := CheckDigit("12a"); err != ErrNotDigit {
+		t.Errorf("CheckDigit(%q) error = %v, want %v", "12a", err, ErrNotDigit)
+	}
+}
+
+// FuzzValidate checks that a number is accepted only if it satisfies every rule of the package doc
+func FuzzValidate(f *testing.F) {
+	for _, seed := range []string{
+		"12345678903", "12345678904", "012345678903", " 12345678903", "12345678903\n",
+		"１２３４５６７８９０３", "", "0", strings.Repeat("9", MaxLength+1),
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, number string) {
+		err := Validate(number)
+		if err != nil {
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Validate(%q) = %v doesn't wrap %v", number, err, ErrInvalid)
+			}
+			return
+		}
+
+		if n := utf8.RuneCountInString(number); n < MinLength || n > MaxLength {
+			t.Fatalf("Validate(%q) accepted %d digits", number, n)
+		}
+		for _, r := range number {
+			if r < '0' || r > '9' {
+				t.Fatalf("Validate(%q) accepted %q", number, r)
+			}
+		}
+		if number[0] == '0' {
+			t.Fatalf("Validate(%q) accepted a leading zero", number)
+		}
+		if !referenceLuhn(number) {
+			t.Fatalf("Validate(%q) accepted a Luhn checksum mismatch", number)
+		}
+	})
+}
+
+// FuzzCheckDigit checks that the check digit makes any payload pass the checksum
+// and any single digit substitution makes it fail
+func FuzzCheckDigit(f *testing.F) {
+	f.Add("1234567890", uint(0), byte(1))
+	f.Add("9", uint(1), byte(9))
+
+	f.Fuzz(func(t *testing.T, payload string, pos uint, delta byte) {
+		d, err := CheckDigit(payload)
+		if err != nil {
+			if !errors.Is(err, ErrNotDigit) {
+				t.Fatalf("CheckDigit(%q) error = %v, want %v", payload, err, ErrNotDigit)
+			}
+			return
+		}
+
+		number := payload + string(d)
+		if !luhn(number) || !referenceLuhn(number) {
+			t.Fatalf("%q with check digit %q doesn't pass the checksum", payload, d)
+		}
+
+		// the Luhn checksum detects every single digit error
+		if delta %= 10; delta == 0 {
+			return
+		}
+		b := []byte(number)
+		i := int(pos % uint(len(b)))
+		b[i] = '0' + (b[i]-'0'+delta)%10
+		if luhn(string(b)) {
+			t.Fatalf("%q with the digit %d changed to %q passes the checksum", number, i, b[i])
+		}
+	})
+}
+
+// referenceLuhn is the textbook Luhn algorithm the optimized one is checked against
+func referenceLuhn(digits string) bool {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+		}
+		sum += d/10 + d%10
+	}
+	return sum%10 == 0
+}
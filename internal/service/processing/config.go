@@ -0,0 +1,95 @@
+package processing
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Config represents the config of the [Worker]. The zero value is the default config.
+// It's safe to be set while the worker runs, the changes take effect upon the next poll
+type Config struct {
+	pollInterval interval
+	workers      count
+}
+
+// Default values of the [Config] parameters
+const (
+	DefaultPollInterval = time.Second
+	DefaultWorkers      = 4
+)
+
+// PollInterval returns a pointer to the [flag.Value] to set the interval between the polls of the accrual system
+func (c *Config) PollInterval() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.pollInterval
+}
+
+// Workers returns a pointer to the [flag.Value] to set the number of the concurrent requests to the accrual system
+func (c *Config) Workers() *count { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.workers
+}
+
+// errParsingConfig indicates an invalid value of a [Config] parameter
+var errParsingConfig = errors.New("error parsing processing config")
+
+// interval is a positive [time.Duration]
+type interval struct {
+	v atomic.Int64
+}
+
+func (i *interval) String() string {
+	return i.Duration().String()
+}
+
+// Set parses s by [time.ParseDuration] and sets it or returns an error
+func (i *interval) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("%w: %s isn't positive", errParsingConfig, d)
+	}
+	i.v.Store(int64(d))
+	return nil
+}
+
+// Duration returns the interval or the [DefaultPollInterval] if it isn't set
+func (i *interval) Duration() time.Duration {
+	if d := i.v.Load(); d > 0 {
+		return time.Duration(d)
+	}
+	return DefaultPollInterval
+}
+
+// count is a positive number
+type count struct {
+	v atomic.Int64
+}
+
+func (c *count) String() string {
+	return strconv.Itoa(c.Int())
+}
+
+// Set parses s as a positive integer and sets it or returns an error
+func (c *count) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if n <= 0 {
+		return fmt.Errorf("%w: %d isn't positive", errParsingConfig, n)
+	}
+	c.v.Store(int64(n))
+	return nil
+}
+
+// Int returns the count or the [DefaultWorkers] if it isn't set
+func (c *count) Int() int {
+	if n := c.v.Load(); n > 0 {
+		return int(n)
+	}
+	return DefaultWorkers
+}
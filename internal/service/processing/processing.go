@@ -0,0 +1,147 @@
+// Package processing is the worker which polls the accrual system for the orders in the non-final statuses
+// and stores their statuses and accruals
+package processing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	genAccrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	genSvc "github.com/oleshko-g/oggophermart/internal/gen/service"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/service/ordernumber"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+	"goa.design/clue/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// batchSize is the maximum number of the orders processed by a single poll
+	batchSize = 100
+	// requestTimeout limits a single request to the accrual system
+	requestTimeout = 10 * time.Second
+)
+
+// AccrualSystem is the client of the accrual system
+type AccrualSystem interface {
+	GetOrder(ctx context.Context, p *genAccrual.GetOrderPayload) (res *genAccrual.GetOrderResult, err error)
+}
+
+// Worker polls the accrual system for the orders in the non-final statuses
+type Worker struct {
+	storage storage.Balance
+	accrual AccrualSystem
+	cfg     *Config
+}
+
+// New returns the [Worker] which stores the results of the accrual system in the storage
+func New(storage storage.Balance, accrual AccrualSystem, cfg *Config) *Worker {
+	return &Worker{
+		storage: storage,
+		accrual: accrual,
+		cfg:     cfg,
+	}
+}
+
+// Run polls the accrual system until ctx is done.
+// If the accrual system limits the requests then the next poll waits for as long as it asks
+func (w *Worker) Run(ctx context.Context) error {
+	for {
+		wait := w.cfg.PollInterval().Duration()
+		retryAfter, err := w.poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf(ctx, err, "failed to poll the accrual system")
+		}
+		wait = max(wait, retryAfter)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// poll processes a batch of the orders by [Config.Workers] concurrent requests.
+// It returns the time to wait if the accrual system limits the requests
+func (w *Worker) poll(ctx context.Context) (retryAfter time.Duration, err error) {
+	orderNumbers, err := w.storage.RetrieveOrdersToProcess(ctx, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var mu sync.Mutex
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(w.cfg.Workers().Int())
+	for _, n := range orderNumbers {
+		g.Go(func() error {
+			if gCtx.Err() != nil {
+				return nil // the batch is stopped
+			}
+			err := w.process(gCtx, n)
+
+			var rateLimit *genSvc.RateLimitError
+			if errors.As(err, &rateLimit) {
+				mu.Lock()
+				retryAfter = max(retryAfter, time.Duration(rateLimit.RetryAfter)*time.Second)
+				mu.Unlock()
+				return err // stops the batch
+			}
+			if err != nil && gCtx.Err() == nil {
+				log.Errorf(ctx, err, "failed to process the order %s", n)
+			}
+			return nil
+		})
+	}
+	if err = g.Wait(); retryAfter > 0 {
+		log.Printf(ctx, "the accrual system limits the requests, retrying after %s", retryAfter)
+		return retryAfter, nil
+	}
+	return 0, err
+}
+
+// process requests the order from the accrual system and stores its status and accrual.
+// The order with an invalid number isn't requested, it's invalid
+func (w *Worker) process(ctx context.Context, orderNumber string) error {
+	if err := ordernumber.Validate(orderNumber); err != nil {
+		log.Warnf(ctx, "the order %s isn't processed by the accrual system: %s", orderNumber, err)
+		return w.store(ctx, orderNumber, order.StatusInvalid, nil)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	res, err := w.accrual.GetOrder(reqCtx, &genAccrual.GetOrderPayload{Number: orderNumber})
+	if err != nil {
+		return err
+	}
+	if res.NotRegistered != nil || res.Status == nil {
+		return nil // the order stays NEW until the accrual system registers it
+	}
+
+	status, ok := order.AccrualStatus(*res.Status).Status()
+	if !ok {
+		return nil
+	}
+	var accrualSum *money.Amount
+	if status == order.StatusProcessed {
+		accrualSum = res.Accrual
+	}
+	return w.store(ctx, orderNumber, status, accrualSum)
+}
+
+// store stores the status and the accrual of the order. The final status is stored with the time of the processing
+func (w *Worker) store(ctx context.Context, orderNumber string, status order.Status, accrualSum *money.Amount) error {
+	var processedAt time.Time
+	if status.Final() {
+		processedAt = time.Now().UTC()
+	}
+	err := w.storage.UpdateOrderAccrual(ctx, orderNumber, status, accrualSum, processedAt)
+	if errors.Is(err, storageErrors.ErrNoAffect) {
+		return nil // the order has moved to the status or a final one already
+	}
+	return err
+}
@@ -0,0 +1,176 @@
+package relay
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+)
+
+// Config represents the config of the [Relay]. The zero value is the default config.
+// The intervals are safe to be set while the relay runs, the sinks are opened once by [New]
+type Config struct {
+	sinks        sinks
+	pollInterval interval
+	timeout      interval
+	retention    interval
+	maxAttempts  count
+}
+
+// Default values of the [Config] parameters
+const (
+	DefaultSinks        = outbox.SinkLog
+	DefaultPollInterval = time.Second
+	DefaultTimeout      = 10 * time.Second
+	DefaultRetention    = 24 * time.Hour
+	DefaultMaxAttempts  = 20
+)
+
+// maxBackoff is the maximum delay of the retry of a failed event
+const maxBackoff = time.Hour
+
+// Sinks returns a pointer to the [flag.Value] to set the comma separated targets of the sinks the events are published to
+func (c *Config) Sinks() *sinks { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.sinks
+}
+
+// PollInterval returns a pointer to the [flag.Value] to set the interval between the polls of the unpublished events
+func (c *Config) PollInterval() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.pollInterval
+}
+
+// Timeout returns a pointer to the [flag.Value] to set the timeout of the publishing of an event to a sink
+func (c *Config) Timeout() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.timeout
+}
+
+// Retention returns a pointer to the [flag.Value] to set how long the published events are kept in the outbox
+func (c *Config) Retention() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.retention
+}
+
+// MaxAttempts returns a pointer to the [flag.Value] to set the number of the failed publishings after which the event is dead
+func (c *Config) MaxAttempts() *count { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxAttempts
+}
+
+// retryAfter returns the delay of the retry after the failed publishings. It starts at the poll interval and doubles with each retry
+func (c *Config) retryAfter(attempts int) time.Duration {
+	delay := c.pollInterval.Duration(DefaultPollInterval)
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
+
+// errParsingConfig indicates an invalid value of a [Config] parameter
+var errParsingConfig = errors.New("error parsing outbox config")
+
+// sinks is the list of the targets of the sinks
+type sinks struct {
+	targets []outbox.Target
+}
+
+func (s *sinks) String() string {
+	if len(s.targets) == 0 {
+		return "default"
+	}
+	targets := make([]string, 0, len(s.targets))
+	for _, t := range s.targets {
+		targets = append(targets, t.String())
+	}
+	return strings.Join(targets, ",")
+}
+
+// Set parses s as the comma separated targets and sets them or returns an error
+func (s *sinks) Set(v string) error {
+	var targets []outbox.Target
+	for t := range strings.SplitSeq(v, ",") {
+		target, err := outbox.ParseTarget(t)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errParsingConfig, err)
+		}
+		targets = append(targets, target)
+	}
+	s.targets = targets
+	return nil
+}
+
+// Targets returns the targets or the [DefaultSinks] if they aren't set
+func (s *sinks) Targets() []outbox.Target {
+	if len(s.targets) > 0 {
+		return s.targets
+	}
+	t, _ := outbox.ParseTarget(DefaultSinks)
+	return []outbox.Target{t}
+}
+
+// interval is a positive [time.Duration]
+type interval struct {
+	v atomic.Int64
+}
+
+func (i *interval) String() string {
+	if d := i.v.Load(); d > 0 {
+		return time.Duration(d).String()
+	}
+	return "default"
+}
+
+// Set parses s by [time.ParseDuration] and sets it or returns an error
+func (i *interval) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("%w: %s isn't positive", errParsingConfig, d)
+	}
+	i.v.Store(int64(d))
+	return nil
+}
+
+// Duration returns the interval or the default if it isn't set
+func (i *interval) Duration(defaultDuration time.Duration) time.Duration {
+	if d := i.v.Load(); d > 0 {
+		return time.Duration(d)
+	}
+	return defaultDuration
+}
+
+// count is a positive number
+type count struct {
+	v atomic.Int64
+}
+
+func (c *count) String() string {
+	if n := c.v.Load(); n > 0 {
+		return strconv.FormatInt(n, 10)
+	}
+	return "default"
+}
+
+// Set parses s as a positive integer and sets it or returns an error
+func (c *count) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if n <= 0 {
+		return fmt.Errorf("%w: %d isn't positive", errParsingConfig, n)
+	}
+	c.v.Store(int64(n))
+	return nil
+}
+
+// Int returns the count or the default if it isn't set
+func (c *count) Int(defaultCount int) int {
+	if n := c.v.Load(); n > 0 {
+		return int(n)
+	}
+	return defaultCount
+}
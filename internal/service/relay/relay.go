@@ -0,0 +1,174 @@
+// Package relay publishes the domain events of the outbox to the sinks
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"goa.design/clue/log"
+)
+
+const (
+	// batchSize is the maximum number of the events retrieved by a single poll
+	batchSize = 100
+	// leaseTerm is the minimal term of the lease of the relay
+	leaseTerm = 30 * time.Second
+	// purgeInterval is the interval between the deletions of the events published before the [Config.Retention]
+	purgeInterval = time.Minute
+)
+
+// Relay publishes the events of the outbox to every sink at least once.
+// The events of a user are published in the order of their storing: an event which fails holds back the following events of its user until its retry
+// while the events of the other users are published. The event is dead once the [Config.MaxAttempts] are exhausted so it no longer holds back its user.
+// A single relay of the replicas publishes the events at a time by holding the lease in the storage
+type Relay struct {
+	storage   storage.Outbox
+	cfg       *Config
+	sinks     []outbox.Sink
+	owner     string
+	lastPurge time.Time
+}
+
+// New returns the [Relay] of the events of the storage which opens the sinks of the cfg. The HTTP sinks send the requests by the client
+func New(storage storage.Outbox, client outbox.Doer, cfg *Config) (*Relay, error) {
+	r := &Relay{
+		storage:   storage,
+		cfg:       cfg,
+		owner:     uuid.NewString(),
+		lastPurge: time.Now(),
+	}
+	for _, t := range cfg.Sinks().Targets() {
+		sink, err := t.Open(client)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to open the outbox sink %s: %w", t, err), r.close())
+		}
+		r.sinks = append(r.sinks, sink)
+	}
+	return r, nil
+}
+
+// Run polls the unpublished events until ctx is done and closes the sinks
+func (r *Relay) Run(ctx context.Context) error {
+	defer func() {
+		if err := r.close(); err != nil {
+			log.Errorf(ctx, err, "failed to close the outbox sinks")
+		}
+	}()
+	for {
+		n, err := r.poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf(ctx, err, "failed to relay the outbox events")
+		}
+		if n == batchSize {
+			continue // more events are unpublished
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.cfg.PollInterval().Duration(DefaultPollInterval)):
+		}
+	}
+}
+
+// poll publishes a batch of the events if the relay holds the lease and returns the number of the retrieved events
+func (r *Relay) poll(ctx context.Context) (int, error) {
+	// the lease outlives the publishing of an event to every sink
+	publishTimeout := r.cfg.Timeout().Duration(DefaultTimeout) * time.Duration(len(r.sinks))
+	now := time.Now().UTC()
+	leaseUntil := now.Add(max(leaseTerm, 2*publishTimeout))
+	leased, err := r.storage.AcquireOutboxLease(ctx, r.owner, now, leaseUntil)
+	if err != nil || !leased {
+		return 0, err
+	}
+
+	if time.Since(r.lastPurge) >= purgeInterval {
+		r.purge(ctx)
+	}
+
+	events, err := r.storage.RetrieveOutboxEvents(ctx, time.Now().UTC(), batchSize)
+	if err != nil {
+		return 0, err
+	}
+	heldBack := make(map[uuid.UUID]bool)
+	for _, e := range events {
+		if time.Until(leaseUntil) < publishTimeout {
+			return 0, nil // the rest of the batch is published under the renewed lease
+		}
+		if heldBack[e.UserID] {
+			continue
+		}
+		if err = r.publish(ctx, e); err != nil {
+			if ctx.Err() != nil {
+				return 0, nil
+			}
+			heldBack[e.UserID] = true
+			if err = r.fail(ctx, e, err); err != nil {
+				return 0, err // the event is published again by the next poll
+			}
+			continue
+		}
+		if err = r.storage.MarkOutboxEventPublished(ctx, e.ID, time.Now().UTC()); err != nil {
+			return 0, err // the event is published again by the next poll
+		}
+	}
+	// the users of the failed events aren't retrieved again until the retries so the next batch is polled at once if this one is full
+	return len(events), nil
+}
+
+// fail saves the failed publishing of the event which is retried with an exponential backoff until the attempts are exhausted
+func (r *Relay) fail(ctx context.Context, e outbox.Event, publishErr error) error {
+	now := time.Now().UTC()
+	a := storage.OutboxAttempt{
+		Attempts:      e.Attempts + 1,
+		NextAttemptAt: now.Add(r.cfg.retryAfter(e.Attempts + 1)),
+	}
+	if a.Attempts >= r.cfg.MaxAttempts().Int(DefaultMaxAttempts) {
+		a.DeadAt = now
+		log.Errorf(ctx, publishErr, "the outbox event %d is dead after %d attempts", e.ID, a.Attempts)
+	} else {
+		log.Errorf(ctx, publishErr, "failed to publish the outbox event %d, retrying at %s", e.ID, a.NextAttemptAt.Format(time.RFC3339))
+	}
+	return r.storage.SaveOutboxAttempt(ctx, e.ID, a)
+}
+
+// publish publishes the event to every sink
+func (r *Relay) publish(ctx context.Context, e outbox.Event) error {
+	for _, sink := range r.sinks {
+		err := func() error {
+			ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout().Duration(DefaultTimeout))
+			defer cancel()
+			return sink.Publish(ctx, e)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purge deletes the events published before the [Config.Retention]
+func (r *Relay) purge(ctx context.Context) {
+	r.lastPurge = time.Now()
+	deleted, err := r.storage.DeletePublishedOutboxEvents(ctx, time.Now().UTC().Add(-r.cfg.Retention().Duration(DefaultRetention)))
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Errorf(ctx, err, "failed to delete the published outbox events")
+		}
+		return
+	}
+	log.Debugf(ctx, "deleted %d published outbox events", deleted)
+}
+
+func (r *Relay) close() error {
+	var errs []error
+	for _, sink := range r.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
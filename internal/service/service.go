@@ -6,17 +6,23 @@ import (
 
 	"github.com/google/uuid"
 	_ "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	genAdmin "github.com/oleshko-g/oggophermart/internal/gen/admin"
 	genBalance "github.com/oleshko-g/oggophermart/internal/gen/balance"
 	_ "github.com/oleshko-g/oggophermart/internal/gen/http/accrual/client"
 	genUser "github.com/oleshko-g/oggophermart/internal/gen/user"
+	genWebhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
 )
 
 type Service struct {
+	Admin   genAdmin.Service
 	Balance genBalance.Service
 	User    genUser.Service
+	Webhook genWebhook.Service
 }
 
 type Auther interface {
 	genBalance.Auther
+	// Authenticate returns the ctx with the result of the authentication of the token
+	Authenticate(ctx context.Context, token string) context.Context
 	UserIDFromContext(context.Context) (uuid.UUID, error)
 }
@@ -1,7 +1,14 @@
 package user
 
+import (
+	"strings"
+	"sync/atomic"
+)
+
 type Config struct {
-	secretKey secret
+	secretKey          secret
+	previousSecretKeys secrets
+	adminLogin         login
 }
 
 // SecretAuthKey returns a pointer to the [flag.Value] to set up the [Server]
@@ -9,13 +16,81 @@ func (c *Config) SecretAuthKey() *secret { // revive:disable-line:unexported-ret
 	return &c.secretKey
 }
 
-type secret string
+// PreviousSecretAuthKeys returns a pointer to the [flag.Value] to set up the keys
+// which are no longer used to sign new tokens but are still accepted to verify them.
+// It lets to rotate [Config.SecretAuthKey] without logging out users.
+func (c *Config) PreviousSecretAuthKeys() *secrets { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.previousSecretKeys
+}
+
+// AdminLogin returns a pointer to the [flag.Value] to set up the login of the registered user
+// who is promoted to the first admin at the startup unless there is an admin already.
+// It bootstraps the first admin who grants the roles to the others
+func (c *Config) AdminLogin() *login { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.adminLogin
+}
+
+// verificationKeys returns the current key followed by the previous keys
+func (c *Config) verificationKeys() []string {
+	return append([]string{c.secretKey.value()}, c.previousSecretKeys.value()...)
+}
+
+// secret is safe to be set while it is read by the running service
+type secret struct {
+	v atomic.Pointer[string]
+}
 
-func (sec secret) String() string {
+func (sec *secret) String() string {
 	return ""
 }
 
 func (sec *secret) Set(s string) error {
-	*sec = secret(s)
+	sec.v.Store(&s)
+	return nil
+}
+
+func (sec *secret) value() string {
+	if v := sec.v.Load(); v != nil {
+		return *v
+	}
+	return ""
+}
+
+// secrets is a comma separated list of [secret]s
+type secrets struct {
+	v atomic.Pointer[[]string]
+}
+
+func (secs *secrets) String() string {
+	return ""
+}
+
+func (secs *secrets) Set(s string) error {
+	var keys []string
+	for _, key := range strings.Split(s, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	secs.v.Store(&keys)
+	return nil
+}
+
+func (secs *secrets) value() []string {
+	if v := secs.v.Load(); v != nil {
+		return *v
+	}
+	return nil
+}
+
+// login is set once before the startup
+type login string
+
+func (l *login) String() string {
+	return string(*l)
+}
+
+func (l *login) Set(s string) error {
+	*l = login(strings.TrimSpace(s))
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	genSvc "github.com/oleshko-g/oggophermart/internal/gen/service"
 	genUser "github.com/oleshko-g/oggophermart/internal/gen/user"
+	"github.com/oleshko-g/oggophermart/internal/role"
 	svcErrors "github.com/oleshko-g/oggophermart/internal/service/errors"
 	"github.com/oleshko-g/oggophermart/internal/storage"
 	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
@@ -43,7 +44,9 @@ func (s *userSvc) Register(ctx context.Context, p *genUser.LoginPassword) (authT
 		return &genSvc.JWTToken{}, svcErrors.ErrInternalServiceError
 	}
 
-	err = s.User.StoreUser(ctx, p.Login, hashedPassword)
+	// the roles are granted by the admins only, the first admin is promoted at the startup
+	r := role.User
+	err = s.User.StoreUser(ctx, p.Login, hashedPassword, r)
 	if err != nil {
 		if errors.Is(err, storageErrors.ErrAlreadyExists) {
 			return &genSvc.JWTToken{}, ErrLoginTaken
@@ -51,7 +54,7 @@ func (s *userSvc) Register(ctx context.Context, p *genUser.LoginPassword) (authT
 		return &genSvc.JWTToken{}, svcErrors.ErrInternalServiceError
 	}
 
-	return s.authorize(p)
+	return s.authorize(p.Login, r)
 }
 
 // Login implements login.
@@ -72,11 +75,17 @@ func (s *userSvc) Login(ctx context.Context, p *genUser.LoginPassword) (authToke
 		return nil, svcErrors.ErrInternalServiceError
 	}
 
-	return s.authorize(p)
+	_, r, err := s.RetrieveUserRole(ctx, p.Login)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	return s.authorize(p.Login, r)
 }
 
-func (s *userSvc) authorize(p *genUser.LoginPassword) (*genSvc.JWTToken, error) {
-	jwt, err := signUserJWT(p.Login, s.secretKey.String(), 1*time.Hour)
+// authorize returns the token which carries the scopes of the role
+func (s *userSvc) authorize(login string, r role.Role) (*genSvc.JWTToken, error) {
+	jwt, err := signUserJWT(login, r.Scopes(), s.secretKey.value(), 1*time.Hour)
 	if err != nil {
 		return &genSvc.JWTToken{}, svcErrors.ErrInternalServiceError
 	}
@@ -100,65 +109,113 @@ func checkPasswordHash(hashedPassword, password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 }
 
-func signUserJWT(login string, jwtSecret string, expiresIn time.Duration) (string, error) {
+// userClaims are the claims of the token of the user. The tokens issued before the roles carry no scopes
+type userClaims struct {
+	Scopes []string `json:"scopes,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func signUserJWT(login string, scopes []string, jwtSecret string, expiresIn time.Duration) (string, error) {
 	t := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		jwt.RegisteredClaims{
-			Issuer:    "oggophermart",
-			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
-			ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(expiresIn)),
-			Subject:   login,
+		userClaims{
+			Scopes: scopes,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "oggophermart",
+				IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(expiresIn)),
+				Subject:   login,
+			},
 		},
 	)
 	return t.SignedString([]byte(jwtSecret))
 }
 
-func (s *userSvc) JWTAuth(ctx context.Context, tokenString string, _ *security.JWTScheme) (context.Context, error) {
-	userID, err := s.authenticate(ctx, tokenString)
-	if err != nil {
-		return nil, err
-	}
-	return s.ContextWithUserID(ctx, userID), nil
+// authentication is the result of the authentication of the token which is kept in the context of the request
+type authentication struct {
+	token  string
+	userID uuid.UUID
+	role   role.Role
+	scopes []string
+	err    error
+}
+
+// Authenticate returns the ctx with the result of the authentication of the token.
+// The token is authenticated once per request: [userSvc.JWTAuth] and [userSvc.UserIDFromContext] read the result from the ctx
+func (s *userSvc) Authenticate(ctx context.Context, tokenString string) context.Context {
+	a := &authentication{token: tokenString}
+	a.userID, a.role, a.scopes, a.err = s.authenticate(ctx, tokenString)
+	return context.WithValue(ctx, contextKeyAuthentication, a)
 }
 
-func (s *userSvc) ContextWithUserID(ctx context.Context, userID uuid.UUID) context.Context {
-	return context.WithValue(ctx, contextKeyUserID, userID)
+// JWTAuth authenticates the user of the token unless the ctx has the result of its authentication already.
+// If the scheme requires scopes then both the token and the current role of the user must grant them
+// so the scopes of the demoted user are revoked at once.
+// [svcErrors.ErrUserIsNotAuthorized] is returned otherwise
+func (s *userSvc) JWTAuth(ctx context.Context, tokenString string, scheme *security.JWTScheme) (context.Context, error) {
+	a, ok := ctx.Value(contextKeyAuthentication).(*authentication)
+	if !ok || a.token != tokenString {
+		ctx = s.Authenticate(ctx, tokenString)
+		a = ctx.Value(contextKeyAuthentication).(*authentication)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+	if scheme != nil && len(scheme.RequiredScopes) > 0 {
+		if err := scheme.Validate(a.scopes); err != nil {
+			return nil, fmt.Errorf("%w: %w", svcErrors.ErrUserIsNotAuthorized, err)
+		}
+		if !a.role.Grants(scheme.RequiredScopes...) {
+			return nil, fmt.Errorf("%w: the role %s doesn't grant the scopes %v", svcErrors.ErrUserIsNotAuthorized, a.role, scheme.RequiredScopes)
+		}
+	}
+	return ctx, nil
 }
+
+// UserIDFromContext returns the ID of the user authenticated by the ctx or [svcErrors.ErrUserIsNotAuthenticated]
 func (s *userSvc) UserIDFromContext(ctx context.Context) (userID uuid.UUID, err error) {
-	v := ctx.Value(contextKeyUserID)
-	if v, ok := v.(uuid.UUID); ok {
-		return v, nil
+	a, ok := ctx.Value(contextKeyAuthentication).(*authentication)
+	if !ok || a.err != nil {
+		return uuid.UUID{}, svcErrors.ErrUserIsNotAuthenticated
 	}
-	return uuid.UUID{}, svcErrors.ErrUserIsNotAuthenticated
+	return a.userID, nil
 }
 
-func (s *userSvc) authenticate(ctx context.Context, tokenString string) (userID uuid.UUID, err error) {
-	claims := jwt.RegisteredClaims{}
+func (s *userSvc) authenticate(ctx context.Context, tokenString string) (userID uuid.UUID, r role.Role, scopes []string, err error) {
+	claims := userClaims{}
 	userJWT, err := jwt.ParseWithClaims(tokenString, &claims,
 		func(token *jwt.Token) (interface{}, error) {
 			if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
 				return nil, fmt.Errorf("error signing method must be HS256. Token's method is %s", token.Method.Alg())
 			}
-			return []byte(s.secretKey.String()), nil
+			// any of the keys is accepted so the tokens survive the key rotation
+			var keys jwt.VerificationKeySet
+			for _, key := range s.verificationKeys() {
+				keys.Keys = append(keys.Keys, []byte(key))
+			}
+			return keys, nil
 		},
 	)
 	if err != nil {
-		return uuid.UUID{}, err
+		return uuid.UUID{}, "", nil, fmt.Errorf("%w: %w", svcErrors.ErrUserIsNotAuthenticated, err)
 	}
 	login, err := userJWT.Claims.GetSubject()
 	if err != nil {
-		return uuid.UUID{}, err
+		return uuid.UUID{}, "", nil, fmt.Errorf("%w: %w", svcErrors.ErrUserIsNotAuthenticated, err)
 	}
 
-	userID, err = s.RetrieveUser(ctx, login)
+	userID, r, err = s.RetrieveUserRole(ctx, login)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		return uuid.UUID{}, "", nil, svcErrors.ErrUserIsNotAuthenticated
+	}
 	if err != nil {
-		return uuid.UUID{}, err
+		return uuid.UUID{}, "", nil, err
 	}
 
-	return userID, nil
+	return userID, r, claims.Scopes, nil
 }
 
 type contextKey int
 
 const (
-	contextKeyUserID contextKey = iota
+	contextKeyAuthentication contextKey = iota
 )
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// errAddressNotPublic indicates the address of the webhook in the internal network
+var errAddressNotPublic = errors.New("the webhook address isn't public")
+
+// nonPublicPrefixes are the ranges of the global unicast addresses which aren't reachable from the internet
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // this network
+	netip.MustParsePrefix("100.64.0.0/10"), // the shared address space of the carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // the IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // the benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),  // the NAT64 translating to any IPv4 address
+}
+
+// NewClient returns the client of the deliveries. Unless the dev mode of the cfg is on,
+// it connects to the public addresses only so a webhook can't reach the internal network.
+// The addresses are checked once they are resolved, right before the connection, so a host name can't be rebound to a private address.
+// The redirects aren't followed since they could lead to the internal network, the deliveries which are redirected fail
+func NewClient(cfg *Config) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			if cfg.DevMode().Enabled() {
+				return nil
+			}
+			return checkAddress(address)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // a proxy would connect to the address which isn't checked
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// checkAddress returns [errAddressNotPublic] unless the resolved ip:port is a public unicast address
+func checkAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errAddressNotPublic, err)
+	}
+	if !publicAddr(ip) {
+		return errAddressNotPublic
+	}
+	return nil
+}
+
+// publicAddr reports whether the ip is a global unicast address which isn't private, loopback or link-local
+func publicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
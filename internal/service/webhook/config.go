@@ -0,0 +1,161 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Config represents the config of the [Dispatcher]. The zero value is the default config.
+// It's safe to be set while the dispatcher runs, the changes take effect upon the next attempts
+type Config struct {
+	pollInterval interval
+	timeout      interval
+	backoff      interval
+	maxBackoff   interval
+	maxAttempts  count
+	devMode      devMode
+}
+
+// Default values of the [Config] parameters
+const (
+	DefaultPollInterval = time.Second
+	DefaultTimeout      = 10 * time.Second
+	DefaultBackoff      = 10 * time.Second
+	DefaultMaxBackoff   = time.Hour
+	DefaultMaxAttempts  = 10
+)
+
+// PollInterval returns a pointer to the [flag.Value] to set the interval between the polls of the due deliveries
+func (c *Config) PollInterval() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.pollInterval
+}
+
+// Timeout returns a pointer to the [flag.Value] to set the timeout of an attempt of a delivery
+func (c *Config) Timeout() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.timeout
+}
+
+// Backoff returns a pointer to the [flag.Value] to set the delay of the first retry. The delay doubles with each retry
+func (c *Config) Backoff() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.backoff
+}
+
+// MaxBackoff returns a pointer to the [flag.Value] to set the maximum delay of a retry
+func (c *Config) MaxBackoff() *interval { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxBackoff
+}
+
+// MaxAttempts returns a pointer to the [flag.Value] to set the number of the attempts after which the delivery is dead
+func (c *Config) MaxAttempts() *count { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxAttempts
+}
+
+// DevMode returns a pointer to the [flag.Value] to allow the http URLs and the private addresses of the webhooks for the local development
+func (c *Config) DevMode() *devMode { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.devMode
+}
+
+// retryAfter returns the delay of the retry after the failed attempts
+func (c *Config) retryAfter(attempts int) time.Duration {
+	delay, maxDelay := c.backoff.Duration(DefaultBackoff), c.maxBackoff.Duration(DefaultMaxBackoff)
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// errParsingConfig indicates an invalid value of a [Config] parameter
+var errParsingConfig = errors.New("error parsing webhook config")
+
+// interval is a positive [time.Duration]
+type interval struct {
+	v atomic.Int64
+}
+
+func (i *interval) String() string {
+	if d := i.v.Load(); d > 0 {
+		return time.Duration(d).String()
+	}
+	return "default"
+}
+
+// Set parses s by [time.ParseDuration] and sets it or returns an error
+func (i *interval) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("%w: %s isn't positive", errParsingConfig, d)
+	}
+	i.v.Store(int64(d))
+	return nil
+}
+
+// Duration returns the interval or the default if it isn't set
+func (i *interval) Duration(defaultDuration time.Duration) time.Duration {
+	if d := i.v.Load(); d > 0 {
+		return time.Duration(d)
+	}
+	return defaultDuration
+}
+
+// count is a positive number
+type count struct {
+	v atomic.Int64
+}
+
+func (c *count) String() string {
+	if n := c.v.Load(); n > 0 {
+		return strconv.FormatInt(n, 10)
+	}
+	return "default"
+}
+
+// Set parses s as a positive integer and sets it or returns an error
+func (c *count) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if n <= 0 {
+		return fmt.Errorf("%w: %d isn't positive", errParsingConfig, n)
+	}
+	c.v.Store(int64(n))
+	return nil
+}
+
+// Int returns the count or the default if it isn't set
+func (c *count) Int(defaultCount int) int {
+	if n := c.v.Load(); n > 0 {
+		return int(n)
+	}
+	return defaultCount
+}
+
+// devMode is a switch which is off by default
+type devMode struct {
+	v atomic.Bool
+}
+
+func (m *devMode) String() string {
+	return strconv.FormatBool(m.v.Load())
+}
+
+// Set parses s by [strconv.ParseBool] and sets it or returns an error
+func (m *devMode) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("%w: %q isn't a boolean", errParsingConfig, s)
+	}
+	m.v.Store(v)
+	return nil
+}
+
+// Enabled reports whether the dev mode is on
+func (m *devMode) Enabled() bool {
+	return m.v.Load()
+}
@@ -0,0 +1,237 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"goa.design/clue/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// batchSize is the maximum number of the deliveries claimed by a single poll
+	batchSize = 100
+	// concurrency is the maximum number of the concurrent deliveries
+	concurrency = 8
+	// maxResponseBody limits the response body which is read so the connection is reused
+	maxResponseBody = 64 << 10
+	// maxLastError limits the failure of the attempt which is stored
+	maxLastError = 512
+)
+
+// Headers of the requests of the deliveries
+const (
+	HeaderEvent     = "X-Gophermart-Event"
+	HeaderDelivery  = "X-Gophermart-Delivery"
+	HeaderTimestamp = "X-Gophermart-Timestamp"
+	HeaderSignature = "X-Gophermart-Signature"
+)
+
+// errResponseStatus indicates the delivery which the partner responded to with a status other than 2xx
+var errResponseStatus = errors.New("the webhook responded with")
+
+// Doer sends the requests of the deliveries. [http.Client] implements it
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Dispatcher delivers the events of the orders to the webhooks.
+// A delivery is retried with an exponential backoff until the partner responds with 2xx or the attempts are exhausted
+type Dispatcher struct {
+	storage storage.Webhooks
+	client  Doer
+	cfg     *Config
+}
+
+// NewDispatcher returns the [Dispatcher] of the deliveries of the storage which are sent by the client
+func NewDispatcher(storage storage.Webhooks, client Doer, cfg *Config) *Dispatcher {
+	return &Dispatcher{
+		storage: storage,
+		client:  client,
+		cfg:     cfg,
+	}
+}
+
+// Run polls the due deliveries until ctx is done.
+// The claimed deliveries are leased for twice the [Config.Timeout] so a delivery interrupted by a crash is retried after the lease
+func (d *Dispatcher) Run(ctx context.Context) error {
+	for {
+		n, err := d.poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf(ctx, err, "failed to dispatch the webhook deliveries")
+		}
+		if n == batchSize {
+			continue // more deliveries are due
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(d.cfg.PollInterval().Duration(DefaultPollInterval)):
+		}
+	}
+}
+
+// poll claims a batch of the due deliveries and attempts them concurrently. It returns the number of the claimed deliveries
+func (d *Dispatcher) poll(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	timeout := d.cfg.Timeout().Duration(DefaultTimeout)
+	deliveries, err := d.storage.ClaimWebhookDeliveries(ctx, now, now.Add(2*timeout), batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+	for _, delivery := range deliveries {
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return nil // the claimed delivery is retried after the lease
+			}
+			attempt := d.attempt(ctx, delivery, timeout)
+			if ctx.Err() != nil {
+				return nil // the interrupted attempt isn't counted
+			}
+			if err := d.storage.SaveWebhookAttempt(ctx, delivery.ID, attempt); err != nil {
+				log.Errorf(ctx, err, "failed to save the attempt of the webhook delivery %d", delivery.ID)
+			}
+			return nil
+		})
+	}
+	return len(deliveries), g.Wait()
+}
+
+// attempt sends the delivery and returns its outcome
+func (d *Dispatcher) attempt(ctx context.Context, delivery storage.DueWebhookDelivery, timeout time.Duration) storage.WebhookAttempt {
+	err := d.send(ctx, delivery, timeout)
+	now := time.Now().UTC()
+	if err == nil {
+		return storage.WebhookAttempt{
+			State:         storage.WebhookDeliveryDelivered,
+			Attempts:      int(delivery.Attempts),
+			NextAttemptAt: now,
+			DeliveredAt:   now,
+		}
+	}
+
+	log.Debugf(ctx, "the attempt of the webhook delivery %d failed: %v", delivery.ID, err)
+	a := storage.WebhookAttempt{
+		State:         storage.WebhookDeliveryPending,
+		Attempts:      int(delivery.Attempts) + 1,
+		NextAttemptAt: now,
+		LastError:     truncate(deliveryError(err), maxLastError),
+	}
+	if a.Attempts >= d.cfg.MaxAttempts().Int(DefaultMaxAttempts) {
+		a.State = storage.WebhookDeliveryDead
+		return a
+	}
+	a.NextAttemptAt = now.Add(d.cfg.retryAfter(a.Attempts))
+	return a
+}
+
+// event is the body of the request of a delivery
+type event struct {
+	ID        int64     `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt string    `json:"created_at"`
+	Data      eventData `json:"data"`
+}
+
+type eventData struct {
+	Number  string        `json:"number"`
+	Status  string        `json:"status"`
+	Accrual *money.Amount `json:"accrual,omitempty"`
+}
+
+// send posts the event of the delivery signed by the secret of the webhook.
+// It returns an error unless the partner responds with 2xx
+func (d *Dispatcher) send(ctx context.Context, delivery storage.DueWebhookDelivery, timeout time.Duration) error {
+	e := event{
+		ID:        delivery.ID,
+		Type:      eventType(delivery.ToStatus.String()),
+		CreatedAt: delivery.CreatedAt.UTC().Format(time.RFC3339),
+		Data: eventData{
+			Number: delivery.Number,
+			Status: delivery.ToStatus.String(),
+		},
+	}
+	if delivery.ToStatus == order.StatusProcessed {
+		e.Data.Accrual = delivery.Accrual
+	}
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, e.Type)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, body))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBody))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("%w %s", errResponseStatus, res.Status)
+	}
+	return nil
+}
+
+// deliveryError returns the failure of the attempt which is shown to the user.
+// The failures to connect are generalized so the deliveries can't probe the internal network
+func deliveryError(err error) string {
+	switch {
+	case errors.Is(err, errResponseStatus):
+		return err.Error()
+	case errors.Is(err, errAddressNotPublic):
+		return errAddressNotPublic.Error()
+	case errors.Is(err, context.DeadlineExceeded):
+		return "the webhook timed out"
+	default:
+		return "the webhook is unreachable"
+	}
+}
+
+// Sign returns the value of the signature header of the body sent at the timestamp.
+// It's sha256= followed by the hex of the HMAC-SHA256 of "<timestamp>.<body>" keyed by the secret
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// truncate returns the first n bytes of s dropping the rune cut by them
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
@@ -0,0 +1,10 @@
+package webhook
+
+import (
+	"github.com/oleshko-g/oggophermart/internal/service/errors"
+)
+
+var (
+	// ErrWebhookNotFound is the error value which is used to map to the 404 Not Found HTTP Status code
+	ErrWebhookNotFound = errors.New("Webhook not found")
+)
@@ -0,0 +1,316 @@
+// Package webhook is an implementation of [genWebhook] service generated by goa design
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	genWebhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
+	"github.com/oleshko-g/oggophermart/internal/service"
+	svcErrors "github.com/oleshko-g/oggophermart/internal/service/errors"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+// secretPrefix tells the secrets of the webhooks apart from the other keys of the partners
+const secretPrefix = "whsec_"
+
+type webhookSvc struct {
+	storage.Webhooks
+	service.Auther
+	cfg *Config
+}
+
+var _ genWebhook.Service = (*webhookSvc)(nil)
+var _ genWebhook.Auther = (*webhookSvc)(nil)
+
+// New returns the webhook service implementation. The URLs of the webhooks are checked by the cfg
+func New(storage storage.Webhooks, auther service.Auther, cfg *Config) *webhookSvc {
+	return &webhookSvc{
+		Webhooks: storage,
+		Auther:   auther,
+		cfg:      cfg,
+	}
+}
+
+// CreateWebhook implements CreateWebhook
+func (s *webhookSvc) CreateWebhook(ctx context.Context, payload *genWebhook.CreateWebhookPayload) (res *genWebhook.Webhook, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkInput(payload.URL, payload.Statuses); err != nil {
+		return nil, err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	now := time.Now().UTC()
+	w := storage.Webhook{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Url:       payload.URL,
+		Secret:    secret,
+		Statuses:  joinStatuses(payload.Statuses),
+		Active:    payload.Active,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err = s.StoreWebhook(ctx, w); err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = webhook(w)
+	res.Secret = &secret
+	return res, nil
+}
+
+// ListWebhooks implements ListWebhooks
+func (s *webhookSvc) ListWebhooks(ctx context.Context, payload *genWebhook.ListWebhooksPayload) (res []*genWebhook.Webhook, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.RetrieveWebhooks(ctx, userID)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = make([]*genWebhook.Webhook, 0, len(webhooks)) // no webhooks is an empty list
+	for _, w := range webhooks {
+		res = append(res, webhook(w))
+	}
+	return res, nil
+}
+
+// GetWebhook implements GetWebhook.
+// The webhooks of the other users aren't found
+func (s *webhookSvc) GetWebhook(ctx context.Context, payload *genWebhook.GetWebhookPayload) (res *genWebhook.Webhook, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseID(payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.RetrieveWebhook(ctx, userID, id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return webhook(w), nil
+}
+
+// UpdateWebhook implements UpdateWebhook. The secret isn't changed
+func (s *webhookSvc) UpdateWebhook(ctx context.Context, payload *genWebhook.UpdateWebhookPayload) (res *genWebhook.Webhook, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseID(payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkInput(payload.URL, payload.Statuses); err != nil {
+		return nil, err
+	}
+
+	w, err := s.RetrieveWebhook(ctx, userID, id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	w.Url = payload.URL
+	w.Statuses = joinStatuses(payload.Statuses)
+	w.Active = payload.Active
+	w.UpdatedAt = time.Now().UTC()
+	if err = s.Webhooks.UpdateWebhook(ctx, w); err != nil {
+		return nil, serviceError(err)
+	}
+	return webhook(w), nil
+}
+
+// DeleteWebhook implements DeleteWebhook
+func (s *webhookSvc) DeleteWebhook(ctx context.Context, payload *genWebhook.DeleteWebhookPayload) (err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseID(payload.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.Webhooks.DeleteWebhook(ctx, userID, id); err != nil {
+		return serviceError(err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries implements ListWebhookDeliveries. The latest deliveries are listed first
+func (s *webhookSvc) ListWebhookDeliveries(ctx context.Context, payload *genWebhook.ListWebhookDeliveriesPayload) (res []*genWebhook.WebhookDelivery, err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseID(payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the webhook is retrieved so the deliveries of an unknown webhook aren't an empty list
+	if _, err = s.RetrieveWebhook(ctx, userID, id); err != nil {
+		return nil, serviceError(err)
+	}
+
+	var state string
+	if payload.State != nil {
+		state = *payload.State
+	}
+	deliveries, err := s.RetrieveWebhookDeliveries(ctx, userID, id, state, payload.Limit)
+	if err != nil {
+		return nil, svcErrors.ErrInternalServiceError
+	}
+
+	res = make([]*genWebhook.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, webhookDelivery(d))
+	}
+	return res, nil
+}
+
+// RedeliverWebhook implements RedeliverWebhook
+func (s *webhookSvc) RedeliverWebhook(ctx context.Context, payload *genWebhook.RedeliverWebhookPayload) (err error) {
+	userID, err := s.Auther.UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseID(payload.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.Webhooks.RedeliverWebhook(ctx, userID, id, payload.DeliveryID, time.Now().UTC()); err != nil {
+		return serviceError(err)
+	}
+	return nil
+}
+
+// serviceError maps the error of the storage to the error of the service
+func serviceError(err error) error {
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		return ErrWebhookNotFound
+	}
+	return svcErrors.ErrInternalServiceError
+}
+
+func parseID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	return id, nil
+}
+
+// checkInput returns [svcErrors.ErrInvalidInputParameter] if the URL isn't an absolute https URL or no status is notified.
+// The URL of an IP address must be public. The http URLs and the private addresses are allowed in the dev mode
+func (s *webhookSvc) checkInput(rawURL string, statuses []string) error {
+	if len(statuses) == 0 {
+		return fmt.Errorf("%w: no statuses", svcErrors.ErrInvalidInputParameter)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, err)
+	}
+	if s.cfg.DevMode().Enabled() {
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: %q isn't an http(s) URL", svcErrors.ErrInvalidInputParameter, rawURL)
+		}
+		return nil
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%w: %q isn't an https URL", svcErrors.ErrInvalidInputParameter, rawURL)
+	}
+	// the host names are checked once they are resolved by the client of the deliveries
+	if ip, err := netip.ParseAddr(u.Hostname()); err == nil && !publicAddr(ip) {
+		return fmt.Errorf("%w: %w", svcErrors.ErrInvalidInputParameter, errAddressNotPublic)
+	}
+	return nil
+}
+
+// newSecret returns a random key of the signatures of the deliveries
+func newSecret() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(key), nil
+}
+
+// joinStatuses returns the distinct statuses separated by commas as they are stored
+func joinStatuses(statuses []string) string {
+	statuses = slices.Clone(statuses)
+	slices.Sort(statuses)
+	return strings.Join(slices.Compact(statuses), ",")
+}
+
+// webhook returns the webhook without its secret
+func webhook(w storage.Webhook) *genWebhook.Webhook {
+	return &genWebhook.Webhook{
+		ID:        w.ID.String(),
+		URL:       w.Url,
+		Statuses:  strings.Split(w.Statuses, ","),
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: w.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// webhookDelivery returns the delivery. The time of the next attempt is set for the pending delivery only
+func webhookDelivery(d storage.WebhookDelivery) *genWebhook.WebhookDelivery {
+	res := &genWebhook.WebhookDelivery{
+		ID:        d.ID,
+		Event:     eventType(d.ToStatus.String()),
+		Number:    d.Number,
+		State:     d.State,
+		Attempts:  int(d.Attempts),
+		CreatedAt: d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.State == storage.WebhookDeliveryPending {
+		nextAttemptAt := d.NextAttemptAt.Format(time.RFC3339)
+		res.NextAttemptAt = &nextAttemptAt
+	}
+	if d.LastError.Valid {
+		res.LastError = &d.LastError.String
+	}
+	if d.DeliveredAt.Valid {
+		deliveredAt := d.DeliveredAt.Time.Format(time.RFC3339)
+		res.DeliveredAt = &deliveredAt
+	}
+	return res
+}
+
+// eventType returns the type of the event of the order status
+func eventType(status string) string {
+	return "order." + strings.ToLower(status)
+}
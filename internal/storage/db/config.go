@@ -2,14 +2,53 @@
 package db
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
+	"strconv"
+	"time"
 
+	"github.com/oleshko-g/oggophermart/internal/storage"
 	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
 )
 
 // Config represents a config of an SQL database
 type Config struct {
 	dataSource
+	maxOpenConns    count
+	maxIdleConns    count
+	connMaxLifetime duration
+	queryTimeout    duration
+	connectTimeout  duration
+	connectRetries  count
+	txIsolation     isolation
+	txRetries       count
+}
+
+// Default values of the [Config] parameters
+const (
+	DefaultMaxOpenConns    = 10
+	DefaultMaxIdleConns    = 5
+	DefaultConnMaxLifetime = 30 * time.Minute
+	DefaultQueryTimeout    = 5 * time.Second
+	DefaultConnectTimeout  = 5 * time.Second
+	DefaultConnectRetries  = 5
+	DefaultTxIsolation     = storage.IsolationLevelSerializable
+	DefaultTxRetries       = 3
+)
+
+// NewConfig returns a [Config] with the default values
+func NewConfig() Config {
+	return Config{
+		maxOpenConns:    DefaultMaxOpenConns,
+		maxIdleConns:    DefaultMaxIdleConns,
+		connMaxLifetime: duration(DefaultConnMaxLifetime),
+		queryTimeout:    duration(DefaultQueryTimeout),
+		connectTimeout:  duration(DefaultConnectTimeout),
+		connectRetries:  DefaultConnectRetries,
+		txIsolation:     isolation(DefaultTxIsolation),
+		txRetries:       DefaultTxRetries,
+	}
 }
 
 // DSN returns a pointer to the [flag.Value] to set the database source name
@@ -17,6 +56,125 @@ func (c *Config) DSN() *dataSource { // revive:disable-line:unexported-return pr
 	return &c.dataSource
 }
 
+// MaxOpenConns returns a pointer to the [flag.Value] to set the maximum number of open connections to the database.
+// Zero means unlimited
+func (c *Config) MaxOpenConns() *count { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxOpenConns
+}
+
+// MaxIdleConns returns a pointer to the [flag.Value] to set the maximum number of idle connections in the pool
+func (c *Config) MaxIdleConns() *count { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxIdleConns
+}
+
+// ConnMaxLifetime returns a pointer to the [flag.Value] to set the maximum amount of time a connection may be reused.
+// Zero means forever
+func (c *Config) ConnMaxLifetime() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.connMaxLifetime
+}
+
+// QueryTimeout returns a pointer to the [flag.Value] to set the deadline of every query.
+// It's also set as the statement timeout of the database session
+func (c *Config) QueryTimeout() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.queryTimeout
+}
+
+// ConnectTimeout returns a pointer to the [flag.Value] to set the timeout of a single attempt to connect to the database
+func (c *Config) ConnectTimeout() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.connectTimeout
+}
+
+// ConnectRetries returns a pointer to the [flag.Value] to set the number of retries to connect to the database upon the start
+func (c *Config) ConnectRetries() *count { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.connectRetries
+}
+
+// TxIsolation returns a pointer to the [flag.Value] to set the isolation level of the transactions
+// which don't set their own
+func (c *Config) TxIsolation() *isolation { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.txIsolation
+}
+
+// TxRetries returns a pointer to the [flag.Value] to set the number of retries of a transaction
+// which failed due to a serialization failure
+func (c *Config) TxRetries() *count { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.txRetries
+}
+
+// ConnString returns the DSN with the statement timeout of the PostgreSQL session set to the [Config.QueryTimeout],
+// unless the DSN sets its own
+func (c *Config) ConnString() (string, error) {
+	dsn, err := url.Parse(c.DSN().String())
+	if err != nil {
+		return "", err
+	}
+
+	if c.DSN().DriverName == DriverNameSQLite {
+		return dsn.String(), nil // SQLite has no statement timeout, the queries are limited by the context only
+	}
+
+	timeout := c.QueryTimeout().Duration()
+	q := dsn.Query()
+	if timeout > 0 && !q.Has("statement_timeout") {
+		q.Set("statement_timeout", strconv.FormatInt(timeout.Milliseconds(), 10))
+		dsn.RawQuery = q.Encode()
+	}
+	return dsn.String(), nil
+}
+
+// errParsingConfig indicates an invalid value of a [Config] parameter
+var errParsingConfig = errors.New("error parsing database config")
+
+// count is a non-negative number
+type count int
+
+func (c count) String() string {
+	return strconv.Itoa(int(c))
+}
+
+// Set parses s as a non-negative integer and sets it or returns an error
+func (c *count) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if n < 0 {
+		return fmt.Errorf("%w: %d is negative", errParsingConfig, n)
+	}
+	*c = count(n)
+	return nil
+}
+
+// Int returns c as int
+func (c count) Int() int {
+	return int(c)
+}
+
+// duration is a non-negative [time.Duration]
+type duration time.Duration
+
+func (d duration) String() string {
+	return time.Duration(d).String()
+}
+
+// Set parses s by [time.ParseDuration] and sets it or returns an error
+func (d *duration) Set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingConfig, err)
+	}
+	if v < 0 {
+		return fmt.Errorf("%w: %s is negative", errParsingConfig, v)
+	}
+	*d = duration(v)
+	return nil
+}
+
+// Duration returns d as [time.Duration]
+func (d duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
 // dataSource represent a valid Data Source
 type dataSource struct {
 	name string
@@ -38,18 +196,44 @@ func (d *dataSource) Set(s string) error {
 		return err
 	}
 
-	if url.Scheme != string(DriverNamePostgres) && url.Scheme != string(DriverNamePostgreSQL) {
+	switch DriverName(url.Scheme) {
+	case DriverNamePostgres, DriverNamePostgreSQL:
+		d.DriverName = DriverNamePostgres
+	case DriverNamePgx:
+		d.DriverName = DriverNamePgx
+		url.Scheme = string(DriverNamePostgres) // pgx parses the postgres URLs only
+	case DriverNameSQLite:
+		d.DriverName = DriverNameSQLite
+		d.name = sqliteFileName(url)
+		return nil
+	default:
 		return storageErrors.ErrUnsupportedDataSource
 	}
 
-	// there's only "postgres" SQL driver
-	d.DriverName = DriverName(DriverNamePostgres)
-
 	d.name = url.String()
 
 	return nil
 }
 
+// sqliteFileName converts sqlite://path?params to the file name with the default pragmas
+// unless the params set their own:
+//   - sqlite://gophermart.db is the file in the working directory
+//   - sqlite:///var/lib/gophermart.db is the absolute path
+func sqliteFileName(url *url.URL) string {
+	q := url.Query()
+	if !q.Has("_txlock") {
+		q.Set("_txlock", "immediate") // takes the write lock upon BEGIN so the transactions don't deadlock upgrading it
+	}
+	if !q.Has("_pragma") {
+		q["_pragma"] = []string{
+			"foreign_keys(1)",
+			"journal_mode(WAL)",
+			"busy_timeout(5000)",
+		}
+	}
+	return "file:" + url.Host + url.Path + "?" + q.Encode()
+}
+
 func (d *dataSource) String() string {
 	return d.name
 }
@@ -63,6 +247,8 @@ func (d DriverName) String() string {
 
 // Supported database drivers
 const (
-	DriverNamePostgres   DriverName = "postgres"
+	DriverNamePostgres   DriverName = "postgres" // lib/pq through database/sql
 	DriverNamePostgreSQL DriverName = "postgresql"
+	DriverNamePgx        DriverName = "pgx"    // native pgx/v5
+	DriverNameSQLite     DriverName = "sqlite" // pure-Go modernc.org/sqlite
 )
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"errors"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+func TestConfigPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   func(c *Config) flag.Value
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"max open conns", func(c *Config) flag.Value { return c.MaxOpenConns() }, "25", "25", false},
+		{"unlimited open conns", func(c *Config) flag.Value { return c.MaxOpenConns() }, "0", "0", false},
+		{"negative idle conns", func(c *Config) flag.Value { return c.MaxIdleConns() }, "-1", "", true},
+		{"not a number", func(c *Config) flag.Value { return c.ConnectRetries() }, "five", "", true},
+		{"conn max lifetime", func(c *Config) flag.Value { return c.ConnMaxLifetime() }, "90s", "1m30s", false},
+		{"negative query timeout", func(c *Config) flag.Value { return c.QueryTimeout() }, "-1s", "", true},
+		{"duration without a unit", func(c *Config) flag.Value { return c.ConnectTimeout() }, "5", "", true},
+		{"tx isolation", func(c *Config) flag.Value { return c.TxIsolation() }, "read-committed", "read-committed", false},
+		{"unknown tx isolation", func(c *Config) flag.Value { return c.TxIsolation() }, "snapshot", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewConfig()
+			v := tt.value(&c)
+			err := v.Set(tt.s)
+			if tt.wantErr {
+				if !errors.Is(err, errParsingConfig) {
+					t.Errorf("got the error %v, want %v", err, errParsingConfig)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		c := NewConfig()
+		if c.MaxOpenConns().Int() != DefaultMaxOpenConns || c.MaxIdleConns().Int() != DefaultMaxIdleConns ||
+			c.ConnMaxLifetime().Duration() != DefaultConnMaxLifetime || c.TxIsolation().Level() != storage.IsolationLevelSerializable {
+			t.Errorf("got the config %+v, want the defaults", c)
+		}
+	})
+}
+
+func TestConfigConnString(t *testing.T) {
+	tests := []struct {
+		name         string
+		dsn          string
+		queryTimeout time.Duration
+		want         string
+		wantDriver   DriverName
+	}{
+		{"statement timeout", "postgres://u:p@localhost/db", 5 * time.Second, "postgres://u:p@localhost/db?statement_timeout=5000", DriverNamePostgres},
+		{"own statement timeout", "postgresql://localhost/db?statement_timeout=100", 5 * time.Second, "postgresql://localhost/db?statement_timeout=100", DriverNamePostgres},
+		{"no query timeout", "postgres://localhost/db", 0, "postgres://localhost/db", DriverNamePostgres},
+		{"pgx", "pgx://localhost/db", time.Second, "postgres://localhost/db?statement_timeout=1000", DriverNamePgx},
+		{"sqlite", "sqlite://gophermart.db", time.Second,
+			"file:gophermart.db?_pragma=foreign_keys%281%29&_pragma=journal_mode%28WAL%29&_pragma=busy_timeout%285000%29&_txlock=immediate", DriverNameSQLite},
+		{"sqlite with own params", "sqlite:///var/lib/gophermart.db?_txlock=deferred&_pragma=foreign_keys(1)", time.Second,
+			"file:/var/lib/gophermart.db?_pragma=foreign_keys%281%29&_txlock=deferred", DriverNameSQLite},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewConfig()
+			if err := c.DSN().Set(tt.dsn); err != nil {
+				t.Fatal(err)
+			}
+			*c.QueryTimeout() = duration(tt.queryTimeout)
+			got, err := c.ConnString()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || c.DSN().DriverName != tt.wantDriver {
+				t.Errorf("got %s by %s, want %s by %s", got, c.DSN().DriverName, tt.want, tt.wantDriver)
+			}
+		})
+	}
+
+	t.Run("unsupported data source", func(t *testing.T) {
+		c := NewConfig()
+		if err := c.DSN().Set("mysql://localhost/db"); !errors.Is(err, storageErrors.ErrUnsupportedDataSource) {
+			t.Errorf("got the error %v, want %v", err, storageErrors.ErrUnsupportedDataSource)
+		}
+	})
+}
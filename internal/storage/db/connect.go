@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"goa.design/clue/log"
+)
+
+// Backoff between the attempts to connect to the database
+const (
+	connectBackoffInitial = 500 * time.Millisecond
+	connectBackoffMax     = 10 * time.Second
+)
+
+// Connect calls ping until the database responds or the [Config.ConnectRetries] are exhausted.
+// Every attempt is limited by the [Config.ConnectTimeout] and the delay between them doubles up to [connectBackoffMax]
+func Connect(ctx context.Context, c *Config, ping func(context.Context) error) (err error) {
+	retries := c.ConnectRetries().Int()
+	backoff := connectBackoffInitial
+	for attempt := 0; ; attempt++ {
+		err = pingWithTimeout(ctx, c.ConnectTimeout().Duration(), ping)
+		if err == nil || attempt == retries {
+			return err
+		}
+
+		log.Warnf(ctx, "failed to connect to the database (attempt %d of %d), retrying in %s: %s", attempt+1, retries+1, backoff, err)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, connectBackoffMax)
+	}
+}
+
+func pingWithTimeout(ctx context.Context, timeout time.Duration, ping func(context.Context) error) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return ping(ctx)
+}
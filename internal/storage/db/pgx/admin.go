@@ -0,0 +1,265 @@
+package pgx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDBPgx "github.com/oleshko-g/oggophermart/internal/gen/storage/db/pgx"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+var _ storage.Admin = (*Storage)(nil)
+
+// reversalReason is the reason of the reversal of the accrual of the reprocessed order
+const reversalReason = "the order is reprocessed"
+
+// SearchUsers retrieves the users whose logins contain login in the order of the logins
+func (s *Storage) SearchUsers(ctx context.Context, login string, r role.Role, limit int) ([]storage.UserAccount, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectUsers(ctx, genDBPgx.SelectUsersParams{
+		Login:    login,
+		Role:     r.String(),
+		MaxUsers: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	users := make([]storage.UserAccount, 0, len(rows))
+	for _, u := range rows {
+		users = append(users, storage.UserAccount(u))
+	}
+	return users, nil
+}
+
+// RetrieveUserAccount retrieves the user by their ID
+func (s *Storage) RetrieveUserAccount(ctx context.Context, userID uuid.UUID) (storage.UserAccount, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	u, err := s.queries.SelectUserByID(ctx, userID)
+	return storage.UserAccount(u), translateError(err)
+}
+
+// RetrieveLedger retrieves the latest entries of the ledger of the user
+func (s *Storage) RetrieveLedger(ctx context.Context, userID uuid.UUID, limit int) ([]storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectLedgerEntries(ctx, genDBPgx.SelectLedgerEntriesParams{
+		UserID:     userID,
+		MaxEntries: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	entries := make([]storage.LedgerEntry, 0, len(rows))
+	for _, r := range rows {
+		entries = append(entries, storage.LedgerEntry{
+			ID:          r.ID,
+			UserID:      r.UserID,
+			Kind:        r.Kind,
+			Amount:      r.Amount,
+			OrderNumber: sql.NullString{String: r.OrderNumber.String, Valid: r.OrderNumber.Valid},
+			Reason:      sql.NullString{String: r.Reason.String, Valid: r.Reason.Valid},
+			ActorID:     r.ActorID,
+			CreatedAt:   r.CreatedAt,
+		})
+	}
+	return entries, nil
+}
+
+// AdjustBalance stores the adjustment of the balance unless it makes the balance negative.
+// The user is locked until the adjustment is stored so the concurrent changes of the balance don't overdraw it
+func (s *Storage) AdjustBalance(ctx context.Context, e storage.LedgerEntry) (storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err := s.withinTx(ctx, func(tx *Storage) error {
+		if _, err := tx.queries.SelectUserForUpdate(ctx, e.UserID); err != nil {
+			return translateError(err)
+		}
+		balance, err := tx.queries.SelectUserBalance(ctx, e.UserID)
+		if err != nil {
+			return translateError(err)
+		}
+		if money.Amount(balance.Balance)+e.Amount < 0 {
+			return fmt.Errorf("%w: the balance %s of the user %s is less than %s",
+				storageErrors.ErrNoAffect, money.Amount(balance.Balance), e.UserID, -e.Amount)
+		}
+
+		e.ID, err = tx.storeLedgerEntry(ctx, e)
+		if err != nil {
+			return err
+		}
+		message, err := outbox.Adjusted(e.Amount, e.Reason.String, "")
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, e.UserID, e.CreatedAt, message)
+	})
+	return e, err
+}
+
+// ReprocessOrder moves the final order back to NEW. The order is locked until the transition is recorded
+func (s *Storage) ReprocessOrder(ctx context.Context, orderNumber string, actorID uuid.UUID, reprocessedAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		current, err := tx.queries.SelectOrderStatusForUpdate(ctx, orderNumber)
+		if err != nil {
+			return translateError(err)
+		}
+		if err = order.Reprocess(current.Status); err != nil {
+			return fmt.Errorf("%w: the order %s: %w", storageErrors.ErrNoAffect, orderNumber, err)
+		}
+
+		res, err := tx.queries.UpdateOrderAccrual(ctx, genDBPgx.UpdateOrderAccrualParams{
+			ID:         current.ID,
+			FromStatus: current.Status,
+			Status:     order.StatusNew,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: the status of the order %s has changed", storageErrors.ErrNoAffect, orderNumber)
+		}
+
+		err = tx.queries.InsertOrderStatusHistory(ctx, genDBPgx.InsertOrderStatusHistoryParams{
+			OrderID:    current.ID,
+			FromStatus: &current.Status,
+			ToStatus:   order.StatusNew,
+			Source:     order.SourceAdmin,
+			CreatedAt:  reprocessedAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the accrual is taken back since it's accrued again when the order is processed
+		if current.Status != order.StatusProcessed || current.Accrual == nil || *current.Accrual <= 0 {
+			return nil
+		}
+		reversal := storage.LedgerEntry{
+			UserID:      current.UserID,
+			Kind:        storage.LedgerReversal,
+			Amount:      -*current.Accrual,
+			OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+			Reason:      sql.NullString{String: reversalReason, Valid: true},
+			ActorID:     uuid.NullUUID{UUID: actorID, Valid: true},
+			CreatedAt:   reprocessedAt,
+		}
+		if _, err = tx.storeLedgerEntry(ctx, reversal); err != nil {
+			return err
+		}
+		message, err := outbox.Adjusted(reversal.Amount, reversalReason, orderNumber)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, current.UserID, reprocessedAt, message)
+	})
+}
+
+// ImportOrders copies the orders by COPY and records their statuses in the status history by the same transaction.
+// Their [outbox.OrderUploaded] events are stored by a single batch
+func (s *Storage) ImportOrders(ctx context.Context, orders []storage.Order) (imported int64, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows := make([]genDBPgx.CopyOrdersParams, len(orders))
+	ids := make([]uuid.UUID, len(orders))
+	events := make([]genDBPgx.InsertOutboxEventsParams, len(orders))
+	for i, o := range orders {
+		if o.ID == uuid.Nil {
+			if o.ID, err = uuid.NewV7(); err != nil {
+				return 0, err
+			}
+		}
+		rows[i] = genDBPgx.CopyOrdersParams{
+			ID:        o.ID,
+			Number:    o.Number,
+			UserID:    o.UserID,
+			Status:    o.Status,
+			CreatedAt: o.CreatedAt,
+		}
+		ids[i] = o.ID
+		uploaded, err := outbox.Uploaded(o.Number, o.Status)
+		if err != nil {
+			return 0, err
+		}
+		events[i] = genDBPgx.InsertOutboxEventsParams{
+			Type:      string(uploaded.Type),
+			Data:      uploaded.Data,
+			CreatedAt: o.CreatedAt,
+			UserID:    o.UserID,
+		}
+	}
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		if imported, err = tx.queries.CopyOrders(ctx, rows); err != nil {
+			return translateError(err)
+		}
+		err = tx.queries.InsertImportedOrdersStatusHistory(ctx, genDBPgx.InsertImportedOrdersStatusHistoryParams{
+			Source: string(order.SourceImport),
+			Ids:    ids,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		var errs []error
+		tx.queries.InsertOutboxEvents(ctx, events).Exec(func(_ int, err error) {
+			if err != nil {
+				errs = append(errs, translateError(err))
+			}
+		})
+		return errors.Join(errs...)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return imported, nil
+}
+
+// storeAccrual records the positive accrual of the processed order in the ledger of the user.
+// It's called by the transaction of the transition of the order
+func (s *Storage) storeAccrual(ctx context.Context, userID uuid.UUID, orderNumber string, status order.Status, accrual *money.Amount, accruedAt time.Time) error {
+	if status != order.StatusProcessed || accrual == nil || *accrual <= 0 {
+		return nil
+	}
+	_, err := s.storeLedgerEntry(ctx, storage.LedgerEntry{
+		UserID:      userID,
+		Kind:        storage.LedgerAccrual,
+		Amount:      *accrual,
+		OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+		CreatedAt:   accruedAt,
+	})
+	return err
+}
+
+func (s *Storage) storeLedgerEntry(ctx context.Context, e storage.LedgerEntry) (id int64, err error) {
+	id, err = s.queries.InsertLedgerEntry(ctx, genDBPgx.InsertLedgerEntryParams{
+		UserID:      e.UserID,
+		Kind:        e.Kind,
+		Amount:      e.Amount,
+		OrderNumber: pgtype.Text{String: e.OrderNumber.String, Valid: e.OrderNumber.Valid},
+		Reason:      pgtype.Text{String: e.Reason.String, Valid: e.Reason.Valid},
+		ActorID:     e.ActorID,
+		CreatedAt:   e.CreatedAt,
+	})
+	return id, translateError(err)
+}
@@ -0,0 +1,101 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"github.com/oleshko-g/oggophermart/internal/storage/db"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+// newTestStorage returns the pgx storage of the PostgreSQL database of the TEST_DATABASE_URI.
+// The test is skipped if it isn't set
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+	uri, ok := os.LookupEnv("TEST_DATABASE_URI")
+	if !ok {
+		t.Skip("TEST_DATABASE_URI isn't set")
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.Scheme = string(db.DriverNamePgx)
+	c := db.NewConfig()
+	if err = c.DSN().Set(u.String()); err != nil {
+		t.Fatal(err)
+	}
+	s, err := New(context.Background(), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestImportOrders(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	login := "importer-" + uuid.NewString()
+	if err := s.StoreUser(ctx, login, "hashed", role.User); err != nil {
+		t.Fatal(err)
+	}
+	userID, err := s.RetrieveUser(ctx, login)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	// the numbers aren't stored by the other runs against the same database
+	prefix := createdAt.UnixNano()
+	orders := make([]storage.Order, 100)
+	for i := range orders {
+		orders[i] = storage.Order{Number: fmt.Sprintf("%d%03d", prefix, i), UserID: userID, Status: order.StatusNew, CreatedAt: createdAt}
+	}
+	orders[len(orders)-1].Status = order.StatusInvalid
+
+	imported, err := s.ImportOrders(ctx, orders)
+	if err != nil || imported != int64(len(orders)) {
+		t.Fatalf("got %d imported orders and the error %v, want %d", imported, err, len(orders))
+	}
+	last := orders[len(orders)-1]
+	o, history, err := s.RetrieveUserOrder(ctx, userID, last.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != order.StatusInvalid {
+		t.Errorf("got the status %s, want %s", o.Status, order.StatusInvalid)
+	}
+	if len(history) != 1 || history[0].FromStatus != nil || history[0].ToStatus != order.StatusInvalid ||
+		history[0].Source != order.SourceImport || !history[0].CreatedAt.Equal(createdAt) {
+		t.Errorf("got the history %+v, want the single imported status %s at %v", history, order.StatusInvalid, createdAt)
+	}
+	var events int
+	err = s.pool.QueryRow(ctx, `SELECT count(*) FROM outbox_events WHERE user_id = $1`, userID).Scan(&events)
+	if err != nil || events != len(orders) {
+		t.Errorf("got %d outbox events and the error %v, want %d", events, err, len(orders))
+	}
+
+	t.Run("stored number", func(t *testing.T) {
+		conflicting := []storage.Order{
+			{Number: fmt.Sprintf("%d", prefix), UserID: userID, Status: order.StatusNew, CreatedAt: createdAt},
+			orders[0],
+		}
+		imported, err := s.ImportOrders(ctx, conflicting)
+		if !errors.Is(err, storageErrors.ErrAlreadyExists) || imported != 0 {
+			t.Fatalf("got %d imported orders and the error %v, want %v", imported, err, storageErrors.ErrAlreadyExists)
+		}
+		if _, _, err = s.RetrieveUserOrder(ctx, userID, conflicting[0].Number); !errors.Is(err, storageErrors.ErrNotFound) {
+			t.Errorf("got the error %v, want the order which isn't stored", err)
+		}
+	})
+}
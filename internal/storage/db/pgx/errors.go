@@ -0,0 +1,46 @@
+package pgx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+// PostgreSQL error codes which are translated to the storage errors
+const (
+	pgCodeUniqueViolation      = "23505"
+	pgCodeForeignKeyViolation  = "23503"
+	pgCodeSerializationFailure = "40001"
+	pgCodeDeadlockDetected     = "40P01"
+	pgCodeQueryCanceled        = "57014"
+)
+
+// translateError wraps err with the storage error which corresponds to the driver error.
+// The errors which don't correspond to any storage error are returned as is
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %w", storageErrors.ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case pgCodeUniqueViolation:
+		return fmt.Errorf("%w: %w", storageErrors.ErrAlreadyExists, err)
+	case pgCodeForeignKeyViolation:
+		return fmt.Errorf("%w: %w", storageErrors.ErrReferenceNotFound, err)
+	case pgCodeSerializationFailure, pgCodeDeadlockDetected:
+		return fmt.Errorf("%w: %w", storageErrors.ErrSerializationFailure, err)
+	case pgCodeQueryCanceled:
+		return fmt.Errorf("%w: %w", storageErrors.ErrCanceled, err)
+	}
+	return err
+}
@@ -0,0 +1,49 @@
+package pgx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+func TestTranslateError(t *testing.T) {
+	errOther := errors.New("other")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"unique violation", &pgconn.PgError{Code: pgCodeUniqueViolation}, storageErrors.ErrAlreadyExists},
+		{"foreign key violation", &pgconn.PgError{Code: pgCodeForeignKeyViolation}, storageErrors.ErrReferenceNotFound},
+		{"serialization failure", &pgconn.PgError{Code: pgCodeSerializationFailure}, storageErrors.ErrSerializationFailure},
+		{"deadlock", &pgconn.PgError{Code: pgCodeDeadlockDetected}, storageErrors.ErrSerializationFailure},
+		{"query canceled", &pgconn.PgError{Code: pgCodeQueryCanceled}, storageErrors.ErrCanceled},
+		{"wrapped error", fmt.Errorf("commit: %w", &pgconn.PgError{Code: pgCodeSerializationFailure}), storageErrors.ErrSerializationFailure},
+		{"no rows", pgx.ErrNoRows, storageErrors.ErrNotFound},
+		{"other code", &pgconn.PgError{Code: "22P02"}, nil},
+		{"other error", errOther, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translateError(tt.err)
+			if tt.want == nil {
+				if got != tt.err {
+					t.Errorf("got the error %v, want it as is", got)
+				}
+				return
+			}
+			if !errors.Is(got, tt.want) || !errors.Is(got, tt.err) {
+				t.Errorf("got the error %v, want %v wrapping %v", got, tt.want, tt.err)
+			}
+		})
+	}
+
+	if translateError(nil) != nil {
+		t.Error("nil is translated to an error")
+	}
+}
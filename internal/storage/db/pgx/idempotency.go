@@ -0,0 +1,113 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	genDBPgx "github.com/oleshko-g/oggophermart/internal/gen/storage/db/pgx"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+var _ storage.Idempotency = (*Storage)(nil)
+
+// StoreIdempotencyKey stores the key of the request of the user replacing the expired one
+func (s *Storage) StoreIdempotencyKey(ctx context.Context, userID uuid.UUID, key, fingerprint string, createdAt, notBefore time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		err := tx.queries.DeleteExpiredIdempotencyKey(ctx, genDBPgx.DeleteExpiredIdempotencyKeyParams{
+			UserID:    userID,
+			Key:       key,
+			CreatedAt: notBefore,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		res, err := tx.queries.InsertIdempotencyKey(ctx, genDBPgx.InsertIdempotencyKeyParams{
+			UserID:      userID,
+			Key:         key,
+			Fingerprint: fingerprint,
+			CreatedAt:   createdAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the insert does nothing on conflict
+		if res.RowsAffected() == 0 {
+			return storageErrors.ErrAlreadyExists
+		}
+		return nil
+	})
+}
+
+// RetrieveIdempotencyKey retrieves the key of the user
+func (s *Storage) RetrieveIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (storage.IdempotencyKey, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	row, err := s.queries.SelectIdempotencyKey(ctx, genDBPgx.SelectIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	if err != nil {
+		return storage.IdempotencyKey{}, translateError(err)
+	}
+	return storage.IdempotencyKey{
+		Fingerprint: row.Fingerprint,
+		StatusCode:  int(row.StatusCode),
+		Header:      row.ResponseHeader,
+		Body:        row.ResponseBody,
+		CreatedAt:   row.CreatedAt,
+	}, nil
+}
+
+// SaveIdempotentResponse saves the response to the request of the key
+func (s *Storage) SaveIdempotentResponse(ctx context.Context, userID uuid.UUID, key string, statusCode int, header, body []byte) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.UpdateIdempotentResponse(ctx, genDBPgx.UpdateIdempotentResponseParams{
+		StatusCode:     int32(statusCode),
+		ResponseHeader: header,
+		ResponseBody:   body,
+		UserID:         userID,
+		Key:            key,
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("%w: the idempotency key %s doesn't exist", storageErrors.ErrNoAffect, key)
+	}
+	return nil
+}
+
+// DeleteIdempotencyKey deletes the key of the user
+func (s *Storage) DeleteIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err := s.queries.DeleteIdempotencyKey(ctx, genDBPgx.DeleteIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	return translateError(err)
+}
+
+// DeleteExpiredIdempotencyKeys deletes the keys stored before notBefore
+func (s *Storage) DeleteExpiredIdempotencyKeys(ctx context.Context, notBefore time.Time) (deleted int64, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.DeleteIdempotencyKeysCreatedBefore(ctx, notBefore)
+	if err != nil {
+		return 0, translateError(err)
+	}
+	return res.RowsAffected(), nil
+}
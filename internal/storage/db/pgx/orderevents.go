@@ -0,0 +1,71 @@
+package pgx
+
+import (
+	"context"
+	"math"
+
+	"github.com/google/uuid"
+	genDBPgx "github.com/oleshko-g/oggophermart/internal/gen/storage/db/pgx"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+// orderEventsChannel is the channel notified by the trigger of the order status history
+const orderEventsChannel = "order_events"
+
+var _ storage.OrderEvents = (*Storage)(nil)
+
+// RetrieveOrderEvents retrieves the events of the orders of the user following the event afterID
+func (s *Storage) RetrieveOrderEvents(ctx context.Context, userID uuid.UUID, afterID int64, limit int) ([]storage.OrderEvent, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectOrderEventsByUserID(ctx, genDBPgx.SelectOrderEventsByUserIDParams{
+		UserID:    userID,
+		AfterID:   afterID,
+		MaxEvents: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	events := make([]storage.OrderEvent, 0, len(rows))
+	for _, r := range rows {
+		events = append(events, storage.OrderEvent(r))
+	}
+	return events, nil
+}
+
+// RetrieveLastOrderEventID retrieves the number of the latest event of the user.
+// The events of the user are numbered by the trigger of the order status history under the lock of the user
+func (s *Storage) RetrieveLastOrderEventID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	id, err := s.queries.SelectLastUserOrderEventID(ctx, userID)
+	return id, translateError(err)
+}
+
+// ListenOrderEvents listens on the channel notified by the trigger of the order status history.
+// A connection of the pool is held until ctx is done or the connection fails
+func (s *Storage) ListenOrderEvents(ctx context.Context, notify func(userID uuid.UUID)) error {
+	conn, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return translateError(err)
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+orderEventsChannel); err != nil {
+		return translateError(err)
+	}
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return translateError(err)
+		}
+		if userID, err := uuid.Parse(n.Payload); err == nil {
+			notify(userID)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package pgx
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	genDBPgx "github.com/oleshko-g/oggophermart/internal/gen/storage/db/pgx"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+var _ storage.Outbox = (*Storage)(nil)
+
+// AcquireOutboxLease takes the lease of the relay if it's held by the owner already or it has expired at now
+func (s *Storage) AcquireOutboxLease(ctx context.Context, owner string, now, leaseUntil time.Time) (bool, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	tag, err := s.queries.UpdateOutboxRelayLease(ctx, genDBPgx.UpdateOutboxRelayLeaseParams{
+		Owner:      owner,
+		LeaseUntil: leaseUntil,
+		Now:        now,
+	})
+	if err != nil {
+		return false, translateError(err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// RetrieveOutboxEvents retrieves the unpublished events which aren't held back at now in the order of their IDs
+func (s *Storage) RetrieveOutboxEvents(ctx context.Context, now time.Time, limit int) ([]outbox.Event, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectOutboxEvents(ctx, genDBPgx.SelectOutboxEventsParams{
+		Now:       &now,
+		MaxEvents: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	events := make([]outbox.Event, 0, len(rows))
+	for _, r := range rows {
+		events = append(events, outbox.Event{
+			ID:        r.ID,
+			Type:      outbox.Type(r.Type),
+			UserID:    r.UserID,
+			CreatedAt: r.CreatedAt,
+			Data:      r.Data,
+			Attempts:  int(r.Attempts),
+		})
+	}
+	return events, nil
+}
+
+// MarkOutboxEventPublished marks the event published
+func (s *Storage) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err := s.queries.UpdateOutboxEventPublished(ctx, genDBPgx.UpdateOutboxEventPublishedParams{
+		PublishedAt: &publishedAt,
+		ID:          id,
+	})
+	return translateError(err)
+}
+
+// SaveOutboxAttempt saves the failed publishing of the event
+func (s *Storage) SaveOutboxAttempt(ctx context.Context, id int64, a storage.OutboxAttempt) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	var deadAt *time.Time
+	if !a.DeadAt.IsZero() {
+		deadAt = &a.DeadAt
+	}
+	err := s.queries.UpdateOutboxEventAttempt(ctx, genDBPgx.UpdateOutboxEventAttemptParams{
+		Attempts:      int32(min(a.Attempts, math.MaxInt32)),
+		NextAttemptAt: &a.NextAttemptAt,
+		DeadAt:        deadAt,
+		ID:            id,
+	})
+	return translateError(err)
+}
+
+// DeletePublishedOutboxEvents deletes the events published before publishedBefore
+func (s *Storage) DeletePublishedOutboxEvents(ctx context.Context, publishedBefore time.Time) (deleted int64, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	tag, err := s.queries.DeleteOutboxEventsPublishedBefore(ctx, &publishedBefore)
+	if err != nil {
+		return 0, translateError(err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// storeOutboxMessages stores the events of the user in the outbox. It's called by the transaction of the changes they describe
+func (s *Storage) storeOutboxMessages(ctx context.Context, userID uuid.UUID, createdAt time.Time, messages ...outbox.Message) error {
+	for _, m := range messages {
+		err := s.queries.InsertOutboxEvent(ctx, genDBPgx.InsertOutboxEventParams{
+			Type:      string(m.Type),
+			Data:      m.Data,
+			CreatedAt: createdAt,
+			UserID:    userID,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+	}
+	return nil
+}
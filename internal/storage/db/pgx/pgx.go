@@ -0,0 +1,541 @@
+// Package pgx is the implementation of the storage on the native pgx/v5 PostgreSQL driver
+package pgx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	genDBPgx "github.com/oleshko-g/oggophermart/internal/gen/storage/db/pgx"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"github.com/oleshko-g/oggophermart/internal/storage/db"
+	"github.com/oleshko-g/oggophermart/internal/storage/db/sql/schema"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+// New configures and opens a new pool of connections to the db and returns a [Storage] or an error.
+// It retries to connect with exponential backoff so the database may start up a bit later than the gophermart.
+func New(ctx context.Context, c *db.Config) (s *Storage, err error) {
+	dsn, err := c.ConnString()
+	if err != nil {
+		return nil, err
+	}
+
+	poolCfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if n := c.MaxOpenConns().Int(); n > 0 {
+		poolCfg.MaxConns = int32(n)
+	}
+	if d := c.ConnMaxLifetime().Duration(); d > 0 {
+		poolCfg.MaxConnLifetime = d
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Connect(ctx, c, pool.Ping)
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	// the migrations run on database/sql
+	database := stdlib.OpenDBFromPool(pool)
+	if err = schema.Up(c.DSN().DriverName, database); err != nil {
+		pool.Close()
+		return nil, errors.Join(err, database.Close())
+	}
+	if err = database.Close(); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &Storage{
+		pool:         pool,
+		queries:      genDBPgx.New(pool),
+		queryTimeout: c.QueryTimeout().Duration(),
+		txIsolation:  c.TxIsolation().Level(),
+		txRetries:    c.TxRetries().Int(),
+	}, nil
+}
+
+// Storage represents an internal implementation of [pgxpool.Pool]
+type Storage struct {
+	pool         *pgxpool.Pool
+	queries      *genDBPgx.Queries
+	queryTimeout time.Duration
+	txIsolation  storage.IsolationLevel
+	txRetries    int
+	tx           pgx.Tx // the transaction the storage is bound to by [Storage.WithinTx]
+}
+
+var _ storage.User = (*Storage)(nil)
+var _ storage.Balance = (*Storage)(nil)
+
+// withQueryTimeout returns the ctx with the deadline of a single storage operation
+func (s *Storage) withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.queryTimeout)
+}
+
+// Close closes all the connections of the pool
+func (s *Storage) Close() {
+	s.pool.Close()
+}
+
+// RetrieveUserBalance retrieves the sum of the ledger entries of the user and the sum of their withdrawals
+func (s *Storage) RetrieveUserBalance(ctx context.Context, userID uuid.UUID) (currentBalance, withdrawn money.Amount, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	balance, err := s.queries.SelectUserBalance(ctx, userID)
+	if err != nil {
+		return 0, 0, translateError(err)
+	}
+	return money.Amount(balance.Balance), money.Amount(balance.Withdrawn), nil
+}
+
+// WithdrawUserPoints records the withdrawal of the sum unless the balance of the user is less than the sum.
+// The user is locked until the withdrawal is stored so the concurrent withdrawals don't overdraw the balance
+func (s *Storage) WithdrawUserPoints(ctx context.Context, userID uuid.UUID, orderNumber string, sum money.Amount, withdrawnAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		if _, err := tx.queries.SelectUserForUpdate(ctx, userID); err != nil {
+			return translateError(err)
+		}
+		balance, err := tx.queries.SelectUserBalance(ctx, userID)
+		if err != nil {
+			return translateError(err)
+		}
+		if money.Amount(balance.Balance) < sum {
+			return fmt.Errorf("%w: the balance %s of the user %s is less than %s",
+				storageErrors.ErrNoAffect, money.Amount(balance.Balance), userID, sum)
+		}
+
+		_, err = tx.storeLedgerEntry(ctx, storage.LedgerEntry{
+			UserID:      userID,
+			Kind:        storage.LedgerWithdrawal,
+			Amount:      -sum,
+			OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+			CreatedAt:   withdrawnAt,
+		})
+		if err != nil {
+			return err
+		}
+		message, err := outbox.Withdrawn(orderNumber, sum)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, userID, withdrawnAt, message)
+	})
+}
+
+// RetrieveUserWithdrawals retrieves the withdrawals of the user in the order they were made
+func (s *Storage) RetrieveUserWithdrawals(ctx context.Context, userID uuid.UUID) ([]storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectWithdrawals(ctx, userID)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	entries := make([]storage.LedgerEntry, 0, len(rows))
+	for _, r := range rows {
+		entries = append(entries, storage.LedgerEntry{
+			ID:          r.ID,
+			UserID:      r.UserID,
+			Kind:        r.Kind,
+			Amount:      r.Amount,
+			OrderNumber: sql.NullString{String: r.OrderNumber.String, Valid: r.OrderNumber.Valid},
+			Reason:      sql.NullString{String: r.Reason.String, Valid: r.Reason.Valid},
+			ActorID:     r.ActorID,
+			CreatedAt:   r.CreatedAt,
+		})
+	}
+	return entries, nil
+}
+
+// RetrieveUser retrieves a user id by their login
+func (s *Storage) RetrieveUser(ctx context.Context, login string) (userID uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	userID, err = s.queries.SelectUserIDByLogin(ctx, login)
+	if err != nil {
+		return uuid.UUID{}, translateError(err)
+	}
+	return userID, nil
+}
+
+// RetrieveUserRole retrieves the ID and the role of the user by their login
+func (s *Storage) RetrieveUserRole(ctx context.Context, login string) (userID uuid.UUID, r role.Role, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	user, err := s.queries.SelectUserRoleByLogin(ctx, login)
+	if err != nil {
+		return uuid.UUID{}, "", translateError(err)
+	}
+	return user.ID, user.Role, nil
+}
+
+// UpdateUserRole changes the role of the user
+func (s *Storage) UpdateUserRole(ctx context.Context, userID uuid.UUID, r role.Role) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	tag, err := s.queries.UpdateUserRole(ctx, genDBPgx.UpdateUserRoleParams{
+		Role:      r,
+		UpdatedAt: time.Now().UTC(),
+		ID:        userID,
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: the user %s", storageErrors.ErrNotFound, userID)
+	}
+	return nil
+}
+
+// StoreUser stores the user by their name, their hashed password and their role.
+//   - name MUST be unique. If it's taken then [storageErrors.ErrAlreadyExists] is returned
+func (s *Storage) StoreUser(ctx context.Context, login, hashedPassword string, r role.Role) (err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	newUserID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	tag, err := s.queries.InsertUser(ctx,
+		genDBPgx.InsertUserParams{
+			ID:             newUserID,
+			Login:          login,
+			HashedPassword: hashedPassword,
+			Role:           r,
+			CreatedAt:      now,
+			UpdatedAt:      now},
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	num := tag.RowsAffected()
+	switch num {
+	case 1:
+		return nil
+	case 0: // the insert does nothing on conflict
+		return storageErrors.ErrAlreadyExists
+	}
+	return fmt.Errorf("%w: expected to affect 1 row, affected %d", storageErrors.ErrNoAffect, num)
+}
+
+// RetreiveUserPassword retrieves the hashed password of the user by their login
+func (s *Storage) RetreiveUserPassword(ctx context.Context, login string) (hashedPassword string, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	hashedPassword, err = s.queries.SelectUserHashedPasswordByLogin(ctx, login)
+	if err != nil {
+		return "", translateError(err)
+	}
+	return hashedPassword, nil
+}
+
+// StoreOrder stores the order of the user and records its first status in the status history by a single statement.
+// The [outbox.OrderUploaded] event is stored by the same transaction
+func (s *Storage) StoreOrder(ctx context.Context, userID uuid.UUID, orderNumber string, orderStatus order.Status, createdAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	newOrderID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	uploaded, err := outbox.Uploaded(orderNumber, orderStatus)
+	if err != nil {
+		return err
+	}
+	return s.withinTx(ctx, func(tx *Storage) error {
+		tag, err := tx.queries.InsertOrder(ctx,
+			genDBPgx.InsertOrderParams{
+				ID:        newOrderID,
+				UserID:    userID,
+				Number:    orderNumber,
+				Status:    orderStatus,
+				CreatedAt: createdAt,
+				Source:    order.SourceUpload,
+			})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the insert does nothing on conflict
+		if tag.RowsAffected() == 0 {
+			return storageErrors.ErrAlreadyExists
+		}
+
+		return tx.storeOutboxMessages(ctx, userID, createdAt, uploaded)
+	})
+}
+
+// StoreUserOrders stores the orders of the user by a single multi-row insert skipping the numbers which are stored already.
+// The first statuses of the stored orders are recorded in the status history by the same statement
+// and their [outbox.OrderUploaded] events by the same transaction.
+// It returns the users who uploaded the skipped numbers
+func (s *Storage) StoreUserOrders(ctx context.Context, userID uuid.UUID, orderNumbers []string, orderStatus order.Status, createdAt time.Time) (skipped map[string]uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	ids := make([]uuid.UUID, len(orderNumbers))
+	for i := range ids {
+		if ids[i], err = uuid.NewV7(); err != nil {
+			return nil, err
+		}
+	}
+	var stored []string
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		stored, err = tx.queries.InsertOrdersSkipConflicts(ctx, genDBPgx.InsertOrdersSkipConflictsParams{
+			Ids:       ids,
+			Numbers:   orderNumbers,
+			UserID:    userID,
+			Status:    orderStatus.String(),
+			CreatedAt: createdAt,
+			Source:    string(order.SourceUpload),
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		for _, n := range stored {
+			uploaded, err := outbox.Uploaded(n, orderStatus)
+			if err != nil {
+				return err
+			}
+			if err = tx.storeOutboxMessages(ctx, userID, createdAt, uploaded); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(stored) == len(orderNumbers) {
+		return map[string]uuid.UUID{}, nil
+	}
+
+	isStored := make(map[string]bool, len(stored))
+	for _, n := range stored {
+		isStored[n] = true
+	}
+	var skippedNumbers []string
+	for _, n := range orderNumbers {
+		if !isStored[n] {
+			skippedNumbers = append(skippedNumbers, n)
+		}
+	}
+
+	rows, err := s.queries.SelectUsersIDsByOrderNumbers(ctx, skippedNumbers)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	skipped = make(map[string]uuid.UUID, len(rows))
+	for _, r := range rows {
+		skipped[r.Number] = r.UserID
+	}
+	return skipped, nil
+}
+
+// RetreiveOrderUser retrieves the ID of the user who uploaded the order
+func (s *Storage) RetreiveOrderUser(ctx context.Context, orderNumber string) (userID uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	userID, err = s.queries.SelectUserIDByOrderNumber(ctx, orderNumber)
+	if err != nil {
+		return uuid.UUID{}, translateError(err)
+	}
+	return userID, nil
+}
+
+// RetrieaveUserOrders retrieves the page of the orders of the user selected by the q
+func (s *Storage) RetrieaveUserOrders(ctx context.Context, userID uuid.UUID, q storage.UserOrdersQuery) (userOrders []storage.UserOrder, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	params := genDBPgx.SelectOrdersByUserIDParams{
+		UserID:   userID,
+		Statuses: q.StatusStrings(),
+		MaxRows:  math.MaxInt32,
+	}
+	if !q.From.IsZero() {
+		params.CreatedFrom = &q.From
+	}
+	if !q.To.IsZero() {
+		params.CreatedTo = &q.To
+	}
+	if q.After != nil {
+		params.AfterCreatedAt = &q.After.CreatedAt
+		params.AfterID = q.After.ID
+	}
+	if q.Limit > 0 {
+		params.MaxRows = int32(min(q.Limit, math.MaxInt32))
+	}
+
+	var rows []genDBPgx.SelectOrdersByUserIDRow
+	if !q.Descending {
+		rows, err = s.queries.SelectOrdersByUserID(ctx, params)
+	} else {
+		var descRows []genDBPgx.SelectOrdersByUserIDDescRow
+		descRows, err = s.queries.SelectOrdersByUserIDDesc(ctx, genDBPgx.SelectOrdersByUserIDDescParams(params))
+		for _, r := range descRows {
+			rows = append(rows, genDBPgx.SelectOrdersByUserIDRow(r))
+		}
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	for _, r := range rows {
+		o := storage.UserOrder{
+			ID:        r.ID,
+			Number:    r.Number,
+			Status:    r.Status,
+			Accrual:   r.Accrual,
+			CreatedAt: r.CreatedAt,
+		}
+		userOrders = append(userOrders, o)
+	}
+	return userOrders, nil
+}
+
+// RetrieveOrdersToProcess retrieves the numbers of the orders in the non-final statuses in the order of their upload
+func (s *Storage) RetrieveOrdersToProcess(ctx context.Context, limit int) (orderNumbers []string, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	orderNumbers, err = s.queries.SelectOrdersToProcess(ctx, int32(min(limit, math.MaxInt32)))
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return orderNumbers, nil
+}
+
+// RetrieveUserOrder retrieves the order of the user by its number with the history of its statuses
+func (s *Storage) RetrieveUserOrder(ctx context.Context, userID uuid.UUID, orderNumber string) (o storage.OrderDetails, history []storage.OrderStatusChange, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		row, err := tx.queries.SelectUserOrderByNumber(ctx, genDBPgx.SelectUserOrderByNumberParams{
+			Number: orderNumber,
+			UserID: userID,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		o = storage.OrderDetails{
+			ID:        row.ID,
+			Number:    row.Number,
+			Status:    row.Status,
+			Accrual:   row.Accrual,
+			CreatedAt: row.CreatedAt,
+		}
+		if row.ProcessedAt != nil {
+			o.ProcessedAt = sql.NullTime{Time: *row.ProcessedAt, Valid: true}
+		}
+
+		rows, err := tx.queries.SelectOrderStatusHistory(ctx, row.ID)
+		if err != nil {
+			return translateError(err)
+		}
+		history = history[:0]
+		for _, r := range rows {
+			history = append(history, storage.OrderStatusChange(r))
+		}
+		return nil
+	}, storage.WithIsolation(storage.IsolationLevelRepeatableRead), storage.ReadOnly())
+	if err != nil {
+		return storage.OrderDetails{}, nil, err
+	}
+	return o, history, nil
+}
+
+// UpdateOrderAccrual moves the order to the status, sets its accrual and records the transition in the status history
+// with its webhook deliveries, its outbox events and the ledger entry of the accrual. The order is locked until the transition is recorded
+func (s *Storage) UpdateOrderAccrual(ctx context.Context, orderNumber string, status order.Status, accrual *money.Amount, processedAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		current, err := tx.queries.SelectOrderStatusForUpdate(ctx, orderNumber)
+		if err != nil {
+			return translateError(err)
+		}
+		if err = order.Transition(current.Status, status); err != nil {
+			return fmt.Errorf("%w: the order %s: %w", storageErrors.ErrNoAffect, orderNumber, err)
+		}
+
+		params := genDBPgx.UpdateOrderAccrualParams{
+			ID:         current.ID,
+			FromStatus: current.Status,
+			Status:     status,
+			Accrual:    accrual,
+		}
+		if !processedAt.IsZero() {
+			params.ProcessedAt = &processedAt
+		}
+		res, err := tx.queries.UpdateOrderAccrual(ctx, params)
+		if err != nil {
+			return translateError(err)
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: the status of the order %s has changed", storageErrors.ErrNoAffect, orderNumber)
+		}
+
+		transitedAt := time.Now().UTC()
+		err = tx.queries.InsertOrderStatusHistory(ctx, genDBPgx.InsertOrderStatusHistoryParams{
+			OrderID:    current.ID,
+			FromStatus: &current.Status,
+			ToStatus:   status,
+			Source:     order.SourceAccrual,
+			CreatedAt:  transitedAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the webhook deliveries and the events of the transition are stored with it so none is lost
+		if err = tx.queries.InsertWebhookDeliveries(ctx, current.ID); err != nil {
+			return translateError(err)
+		}
+		if err = tx.storeAccrual(ctx, current.UserID, orderNumber, status, accrual, transitedAt); err != nil {
+			return err
+		}
+		messages, err := outbox.Transition(orderNumber, status, accrual)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, current.UserID, transitedAt, messages...)
+	})
+}
@@ -0,0 +1,5 @@
+-- name: CopyOrders :copyfrom
+INSERT INTO
+  orders (id, number, user_id, status, created_at)
+VALUES
+  ($1, $2, $3, $4, $5);
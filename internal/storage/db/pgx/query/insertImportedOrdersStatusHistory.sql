@@ -0,0 +1,16 @@
+-- name: InsertImportedOrdersStatusHistory :exec
+-- the statuses of the orders copied by CopyOrders are recorded as their first statuses
+INSERT INTO
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  id,
+  status,
+  @source::text,
+  created_at
+FROM
+  orders
+WHERE
+  id = ANY (@ids::uuid[])
+ORDER BY
+  created_at,
+  id;
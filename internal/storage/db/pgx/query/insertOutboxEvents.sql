@@ -0,0 +1,21 @@
+-- name: InsertOutboxEvents :batchexec
+-- the batched InsertOutboxEvent. The user is locked until the transaction ends
+WITH
+  author AS (
+    SELECT
+      users.id
+    FROM
+      users
+    WHERE
+      users.id = @user_id
+    FOR NO KEY UPDATE
+  )
+INSERT INTO
+  outbox_events (user_id, type, data, created_at)
+SELECT
+  author.id,
+  @type,
+  @data,
+  @created_at
+FROM
+  author;
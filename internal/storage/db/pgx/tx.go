@@ -0,0 +1,63 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"github.com/oleshko-g/oggophermart/internal/storage/db"
+)
+
+var _ storage.Transactor = (*Storage)(nil)
+
+// WithinTx runs fn in a transaction. See [storage.Transactor]
+func (s *Storage) WithinTx(ctx context.Context, fn func(tx storage.Tx) error, opts ...storage.TxOption) error {
+	return s.withinTx(ctx, func(tx *Storage) error {
+		return fn(tx)
+	}, opts...)
+}
+
+// withinTx runs fn with the storage bound to a transaction
+func (s *Storage) withinTx(ctx context.Context, fn func(tx *Storage) error, opts ...storage.TxOption) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	o := storage.NewTxOptions(s.txIsolation, opts...)
+	txOpts := pgx.TxOptions{
+		IsoLevel:   isolationLevel(o.Isolation),
+		AccessMode: pgx.ReadWrite,
+	}
+	if o.ReadOnly {
+		txOpts.AccessMode = pgx.ReadOnly
+	}
+
+	return db.RetryTx(ctx, s.txRetries, func() error {
+		tx, err := s.pool.BeginTx(ctx, txOpts)
+		if err != nil {
+			return translateError(err)
+		}
+
+		txStorage := *s
+		txStorage.tx = tx
+		txStorage.queries = s.queries.WithTx(tx)
+		if err = fn(&txStorage); err != nil {
+			return errors.Join(err, tx.Rollback(ctx))
+		}
+		return translateError(tx.Commit(ctx))
+	})
+}
+
+// isolationLevel converts the level to the pgx one
+func isolationLevel(level storage.IsolationLevel) pgx.TxIsoLevel {
+	switch level {
+	case storage.IsolationLevelReadCommitted:
+		return pgx.ReadCommitted
+	case storage.IsolationLevelRepeatableRead:
+		return pgx.RepeatableRead
+	case storage.IsolationLevelSerializable:
+		return pgx.Serializable
+	}
+	return ""
+}
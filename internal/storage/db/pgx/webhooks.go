@@ -0,0 +1,188 @@
+package pgx
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	genDBPgx "github.com/oleshko-g/oggophermart/internal/gen/storage/db/pgx"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+var _ storage.Webhooks = (*Storage)(nil)
+
+// StoreWebhook stores the webhook of the user
+func (s *Storage) StoreWebhook(ctx context.Context, w storage.Webhook) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return translateError(s.queries.InsertWebhook(ctx, genDBPgx.InsertWebhookParams(w)))
+}
+
+// RetrieveWebhooks retrieves the webhooks of the user in the order of their creation
+func (s *Storage) RetrieveWebhooks(ctx context.Context, userID uuid.UUID) ([]storage.Webhook, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectWebhooksByUserID(ctx, userID)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	webhooks := make([]storage.Webhook, 0, len(rows))
+	for _, r := range rows {
+		webhooks = append(webhooks, storage.Webhook(r))
+	}
+	return webhooks, nil
+}
+
+// RetrieveWebhook retrieves the webhook of the user
+func (s *Storage) RetrieveWebhook(ctx context.Context, userID, id uuid.UUID) (storage.Webhook, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	w, err := s.queries.SelectWebhook(ctx, genDBPgx.SelectWebhookParams{ID: id, UserID: userID})
+	return storage.Webhook(w), translateError(err)
+}
+
+// UpdateWebhook updates the webhook of the user
+func (s *Storage) UpdateWebhook(ctx context.Context, w storage.Webhook) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.UpdateWebhook(ctx, genDBPgx.UpdateWebhookParams{
+		Url:       w.Url,
+		Statuses:  w.Statuses,
+		Active:    w.Active,
+		UpdatedAt: w.UpdatedAt,
+		ID:        w.ID,
+		UserID:    w.UserID,
+	})
+	return webhookAffected(res, err, w.ID)
+}
+
+// DeleteWebhook deletes the webhook of the user with its deliveries
+func (s *Storage) DeleteWebhook(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.DeleteWebhook(ctx, genDBPgx.DeleteWebhookParams{ID: id, UserID: userID})
+	return webhookAffected(res, err, id)
+}
+
+// RetrieveWebhookDeliveries retrieves the latest deliveries of the webhook of the user
+func (s *Storage) RetrieveWebhookDeliveries(ctx context.Context, userID, webhookID uuid.UUID, state string, limit int) ([]storage.WebhookDelivery, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectWebhookDeliveries(ctx, genDBPgx.SelectWebhookDeliveriesParams{
+		WebhookID:     webhookID,
+		UserID:        userID,
+		State:         state,
+		MaxDeliveries: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	deliveries := make([]storage.WebhookDelivery, 0, len(rows))
+	for _, r := range rows {
+		d := storage.WebhookDelivery{
+			ID:            r.ID,
+			ToStatus:      r.ToStatus,
+			Number:        r.Number,
+			State:         r.State,
+			Attempts:      r.Attempts,
+			NextAttemptAt: r.NextAttemptAt,
+			LastError:     sql.NullString{String: r.LastError.String, Valid: r.LastError.Valid},
+			CreatedAt:     r.CreatedAt,
+		}
+		if r.DeliveredAt != nil {
+			d.DeliveredAt = sql.NullTime{Time: *r.DeliveredAt, Valid: true}
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+// RedeliverWebhook makes the delivery of the webhook of the user pending from the first attempt
+func (s *Storage) RedeliverWebhook(ctx context.Context, userID, webhookID uuid.UUID, deliveryID int64, now time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.UpdateWebhookDeliveryRedeliver(ctx, genDBPgx.UpdateWebhookDeliveryRedeliverParams{
+		Now:       now,
+		ID:        deliveryID,
+		WebhookID: webhookID,
+		UserID:    userID,
+	})
+	return webhookAffected(res, err, webhookID)
+}
+
+// ClaimWebhookDeliveries retrieves the due deliveries and postpones them until leaseUntil.
+// The deliveries locked by the other replicas are skipped
+func (s *Storage) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) (deliveries []storage.DueWebhookDelivery, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		rows, err := tx.queries.SelectDueWebhookDeliveries(ctx, genDBPgx.SelectDueWebhookDeliveriesParams{
+			Now:           now,
+			MaxDeliveries: int32(min(limit, math.MaxInt32)),
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		deliveries = deliveries[:0]
+		for _, d := range rows {
+			deliveries = append(deliveries, storage.DueWebhookDelivery(d))
+			err = tx.queries.UpdateWebhookDeliveryNextAttempt(ctx, genDBPgx.UpdateWebhookDeliveryNextAttemptParams{
+				NextAttemptAt: leaseUntil,
+				ID:            d.ID,
+			})
+			if err != nil {
+				return translateError(err)
+			}
+		}
+		return nil
+	}, storage.WithIsolation(storage.IsolationLevelReadCommitted))
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// SaveWebhookAttempt saves the outcome of the attempt of the delivery
+func (s *Storage) SaveWebhookAttempt(ctx context.Context, deliveryID int64, a storage.WebhookAttempt) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	var deliveredAt *time.Time
+	if !a.DeliveredAt.IsZero() {
+		deliveredAt = &a.DeliveredAt
+	}
+	err := s.queries.UpdateWebhookDeliveryAttempt(ctx, genDBPgx.UpdateWebhookDeliveryAttemptParams{
+		State:         a.State,
+		Attempts:      int32(min(a.Attempts, math.MaxInt32)),
+		NextAttemptAt: a.NextAttemptAt,
+		LastError:     pgtype.Text{String: a.LastError, Valid: a.LastError != ""},
+		DeliveredAt:   deliveredAt,
+		ID:            deliveryID,
+	})
+	return translateError(err)
+}
+
+// webhookAffected returns [storageErrors.ErrNotFound] if the statement of the webhook affected no rows
+func webhookAffected(res pgconn.CommandTag, err error, webhookID uuid.UUID) error {
+	if err != nil {
+		return translateError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("%w: the webhook %s", storageErrors.ErrNotFound, webhookID)
+	}
+	return nil
+}
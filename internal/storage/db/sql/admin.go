@@ -0,0 +1,241 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	genDBSQL "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sql"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+var _ storage.Admin = (*Storage)(nil)
+
+// reversalReason is the reason of the reversal of the accrual of the reprocessed order
+const reversalReason = "the order is reprocessed"
+
+// SearchUsers retrieves the users whose logins contain login in the order of the logins
+func (s *Storage) SearchUsers(ctx context.Context, login string, r role.Role, limit int) ([]storage.UserAccount, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectUsers(ctx, genDBSQL.SelectUsersParams{
+		Login:    login,
+		Role:     r.String(),
+		MaxUsers: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	users := make([]storage.UserAccount, 0, len(rows))
+	for _, u := range rows {
+		users = append(users, storage.UserAccount(u))
+	}
+	return users, nil
+}
+
+// RetrieveUserAccount retrieves the user by their ID
+func (s *Storage) RetrieveUserAccount(ctx context.Context, userID uuid.UUID) (storage.UserAccount, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	u, err := s.queries.SelectUserByID(ctx, userID)
+	return u, translateError(err)
+}
+
+// RetrieveLedger retrieves the latest entries of the ledger of the user
+func (s *Storage) RetrieveLedger(ctx context.Context, userID uuid.UUID, limit int) ([]storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	entries, err := s.queries.SelectLedgerEntries(ctx, genDBSQL.SelectLedgerEntriesParams{
+		UserID:     userID,
+		MaxEntries: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return entries, nil
+}
+
+// AdjustBalance stores the adjustment of the balance unless it makes the balance negative.
+// The user is locked until the adjustment is stored so the concurrent changes of the balance don't overdraw it
+func (s *Storage) AdjustBalance(ctx context.Context, e storage.LedgerEntry) (storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err := s.withinTx(ctx, func(tx *Storage) error {
+		if _, err := tx.queries.SelectUserForUpdate(ctx, e.UserID); err != nil {
+			return translateError(err)
+		}
+		balance, err := tx.queries.SelectUserBalance(ctx, e.UserID)
+		if err != nil {
+			return translateError(err)
+		}
+		if money.Amount(balance.Balance)+e.Amount < 0 {
+			return fmt.Errorf("%w: the balance %s of the user %s is less than %s",
+				storageErrors.ErrNoAffect, money.Amount(balance.Balance), e.UserID, -e.Amount)
+		}
+
+		e.ID, err = tx.storeLedgerEntry(ctx, e)
+		if err != nil {
+			return err
+		}
+		message, err := outbox.Adjusted(e.Amount, e.Reason.String, "")
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, e.UserID, e.CreatedAt, message)
+	})
+	return e, err
+}
+
+// ReprocessOrder moves the final order back to NEW. The order is locked until the transition is recorded
+func (s *Storage) ReprocessOrder(ctx context.Context, orderNumber string, actorID uuid.UUID, reprocessedAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		current, err := tx.queries.SelectOrderStatusForUpdate(ctx, orderNumber)
+		if err != nil {
+			return translateError(err)
+		}
+		if err = order.Reprocess(current.Status); err != nil {
+			return fmt.Errorf("%w: the order %s: %w", storageErrors.ErrNoAffect, orderNumber, err)
+		}
+
+		res, err := tx.queries.UpdateOrderAccrual(ctx, genDBSQL.UpdateOrderAccrualParams{
+			ID:         current.ID,
+			FromStatus: current.Status,
+			Status:     order.StatusNew,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("%w: the status of the order %s has changed", storageErrors.ErrNoAffect, orderNumber)
+		}
+
+		err = tx.queries.InsertOrderStatusHistory(ctx, genDBSQL.InsertOrderStatusHistoryParams{
+			OrderID:    current.ID,
+			FromStatus: &current.Status,
+			ToStatus:   order.StatusNew,
+			Source:     order.SourceAdmin,
+			CreatedAt:  reprocessedAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the accrual is taken back since it's accrued again when the order is processed
+		if current.Status != order.StatusProcessed || current.Accrual == nil || *current.Accrual <= 0 {
+			return nil
+		}
+		reversal := storage.LedgerEntry{
+			UserID:      current.UserID,
+			Kind:        storage.LedgerReversal,
+			Amount:      -*current.Accrual,
+			OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+			Reason:      sql.NullString{String: reversalReason, Valid: true},
+			ActorID:     uuid.NullUUID{UUID: actorID, Valid: true},
+			CreatedAt:   reprocessedAt,
+		}
+		if _, err = tx.storeLedgerEntry(ctx, reversal); err != nil {
+			return err
+		}
+		message, err := outbox.Adjusted(reversal.Amount, reversalReason, orderNumber)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, current.UserID, reprocessedAt, message)
+	})
+}
+
+// ImportOrders stores the orders by a single multi-row insert which records their statuses in the status history as well.
+// Their [outbox.OrderUploaded] events are stored by the same transaction
+func (s *Storage) ImportOrders(ctx context.Context, orders []storage.Order) (imported int64, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	params := genDBSQL.InsertImportedOrdersParams{
+		Source:     string(order.SourceImport),
+		Ids:        make([]uuid.UUID, len(orders)),
+		Numbers:    make([]string, len(orders)),
+		UserIds:    make([]uuid.UUID, len(orders)),
+		Statuses:   make([]string, len(orders)),
+		CreatedAts: make([]time.Time, len(orders)),
+	}
+	for i, o := range orders {
+		if o.ID == uuid.Nil {
+			if o.ID, err = uuid.NewV7(); err != nil {
+				return 0, err
+			}
+		}
+		params.Ids[i] = o.ID
+		params.Numbers[i] = o.Number
+		params.UserIds[i] = o.UserID
+		params.Statuses[i] = o.Status.String()
+		params.CreatedAts[i] = o.CreatedAt
+	}
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		if imported, err = tx.queries.InsertImportedOrders(ctx, params); err != nil {
+			return translateError(err)
+		}
+		for _, o := range orders {
+			uploaded, err := outbox.Uploaded(o.Number, o.Status)
+			if err != nil {
+				return err
+			}
+			if err = tx.storeOutboxMessages(ctx, o.UserID, o.CreatedAt, uploaded); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return imported, nil
+}
+
+// storeAccrual records the positive accrual of the processed order in the ledger of the user.
+// It's called by the transaction of the transition of the order
+func (s *Storage) storeAccrual(ctx context.Context, userID uuid.UUID, orderNumber string, status order.Status, accrual *money.Amount, accruedAt time.Time) error {
+	if status != order.StatusProcessed || accrual == nil || *accrual <= 0 {
+		return nil
+	}
+	_, err := s.storeLedgerEntry(ctx, storage.LedgerEntry{
+		UserID:      userID,
+		Kind:        storage.LedgerAccrual,
+		Amount:      *accrual,
+		OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+		CreatedAt:   accruedAt,
+	})
+	return err
+}
+
+func (s *Storage) storeLedgerEntry(ctx context.Context, e storage.LedgerEntry) (id int64, err error) {
+	id, err = s.queries.InsertLedgerEntry(ctx, genDBSQL.InsertLedgerEntryParams{
+		UserID:      e.UserID,
+		Kind:        e.Kind,
+		Amount:      e.Amount,
+		OrderNumber: e.OrderNumber,
+		Reason:      e.Reason,
+		ActorID:     e.ActorID,
+		CreatedAt:   e.CreatedAt,
+	})
+	return id, translateError(err)
+}
@@ -0,0 +1,46 @@
+package sql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+// PostgreSQL error codes which are translated to the storage errors
+const (
+	pqCodeUniqueViolation      pq.ErrorCode = "23505"
+	pqCodeForeignKeyViolation  pq.ErrorCode = "23503"
+	pqCodeSerializationFailure pq.ErrorCode = "40001"
+	pqCodeDeadlockDetected     pq.ErrorCode = "40P01"
+	pqCodeQueryCanceled        pq.ErrorCode = "57014"
+)
+
+// translateError wraps err with the storage error which corresponds to the driver error.
+// The errors which don't correspond to any storage error are returned as is
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %w", storageErrors.ErrNotFound, err)
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+	switch pqErr.Code {
+	case pqCodeUniqueViolation:
+		return fmt.Errorf("%w: %w", storageErrors.ErrAlreadyExists, err)
+	case pqCodeForeignKeyViolation:
+		return fmt.Errorf("%w: %w", storageErrors.ErrReferenceNotFound, err)
+	case pqCodeSerializationFailure, pqCodeDeadlockDetected:
+		return fmt.Errorf("%w: %w", storageErrors.ErrSerializationFailure, err)
+	case pqCodeQueryCanceled:
+		return fmt.Errorf("%w: %w", storageErrors.ErrCanceled, err)
+	}
+	return err
+}
@@ -0,0 +1,49 @@
+package sql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+func TestTranslateError(t *testing.T) {
+	errOther := errors.New("other")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"unique violation", &pq.Error{Code: pqCodeUniqueViolation}, storageErrors.ErrAlreadyExists},
+		{"foreign key violation", &pq.Error{Code: pqCodeForeignKeyViolation}, storageErrors.ErrReferenceNotFound},
+		{"serialization failure", &pq.Error{Code: pqCodeSerializationFailure}, storageErrors.ErrSerializationFailure},
+		{"deadlock", &pq.Error{Code: pqCodeDeadlockDetected}, storageErrors.ErrSerializationFailure},
+		{"query canceled", &pq.Error{Code: pqCodeQueryCanceled}, storageErrors.ErrCanceled},
+		{"wrapped error", fmt.Errorf("commit: %w", &pq.Error{Code: pqCodeSerializationFailure}), storageErrors.ErrSerializationFailure},
+		{"no rows", sql.ErrNoRows, storageErrors.ErrNotFound},
+		{"other code", &pq.Error{Code: "22P02"}, nil},
+		{"other error", errOther, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translateError(tt.err)
+			if tt.want == nil {
+				if got != tt.err {
+					t.Errorf("got the error %v, want it as is", got)
+				}
+				return
+			}
+			if !errors.Is(got, tt.want) || !errors.Is(got, tt.err) {
+				t.Errorf("got the error %v, want %v wrapping %v", got, tt.want, tt.err)
+			}
+		})
+	}
+
+	if translateError(nil) != nil {
+		t.Error("nil is translated to an error")
+	}
+}
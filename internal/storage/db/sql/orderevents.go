@@ -0,0 +1,74 @@
+package sql
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	genDBSQL "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sql"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+// orderEventsChannel is the channel notified by the trigger of the order status history
+const orderEventsChannel = "order_events"
+
+// Reconnection intervals of the listener of the order events
+const (
+	listenerMinReconnectInterval = time.Second
+	listenerMaxReconnectInterval = time.Minute
+)
+
+var _ storage.OrderEvents = (*Storage)(nil)
+
+// RetrieveOrderEvents retrieves the events of the orders of the user following the event afterID
+func (s *Storage) RetrieveOrderEvents(ctx context.Context, userID uuid.UUID, afterID int64, limit int) ([]storage.OrderEvent, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	events, err := s.queries.SelectOrderEventsByUserID(ctx, genDBSQL.SelectOrderEventsByUserIDParams{
+		UserID:    userID,
+		AfterID:   afterID,
+		MaxEvents: int32(min(limit, math.MaxInt32)),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return events, nil
+}
+
+// RetrieveLastOrderEventID retrieves the number of the latest event of the user.
+// The events of the user are numbered by the trigger of the order status history under the lock of the user
+func (s *Storage) RetrieveLastOrderEventID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	id, err := s.queries.SelectLastUserOrderEventID(ctx, userID)
+	return id, translateError(err)
+}
+
+// ListenOrderEvents listens on the channel notified by the trigger of the order status history.
+// lib/pq listens on its own connection outside of the pool and reconnects if the connection fails.
+// The events stored while the listener reconnects aren't notified
+func (s *Storage) ListenOrderEvents(ctx context.Context, notify func(userID uuid.UUID)) error {
+	listener := pq.NewListener(s.dsn, listenerMinReconnectInterval, listenerMaxReconnectInterval, nil)
+	defer listener.Close()
+
+	if err := listener.Listen(orderEventsChannel); err != nil {
+		return translateError(err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-listener.Notify:
+			if n == nil {
+				continue // the listener is reconnected
+			}
+			if userID, err := uuid.Parse(n.Extra); err == nil {
+				notify(userID)
+			}
+		}
+	}
+}
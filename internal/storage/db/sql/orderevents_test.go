@@ -0,0 +1,134 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+// TestInterleavedCommits checks the events of the user which commit out of the order of their start
+// aren't missed by the stream resuming after the last event it has read nor published out of their order by the relay
+func TestInterleavedCommits(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	login := "streamer-" + uuid.NewString()
+	if err := s.StoreUser(ctx, login, "hashed", role.User); err != nil {
+		t.Fatal(err)
+	}
+	userID, err := s.RetrieveUser(ctx, login)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the numbers aren't stored by the other runs against the same database
+	prefix := time.Now().UnixNano()
+	first, second := fmt.Sprintf("%d1", prefix), fmt.Sprintf("%d2", prefix)
+	for _, n := range []string{first, second} {
+		if err = s.StoreOrder(ctx, userID, n, order.StatusNew, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lastID, err := s.RetrieveLastOrderEventID(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	published, err := userOutboxEvents(ctx, s, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the transition of the first order starts first and commits last
+	started, commit := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	firstDone := make(chan error, 1)
+	go func() {
+		firstDone <- s.WithinTx(ctx, func(tx storage.Tx) error {
+			if err := tx.UpdateOrderAccrual(ctx, first, order.StatusProcessing, nil, time.Time{}); err != nil {
+				return err
+			}
+			once.Do(func() { close(started) })
+			<-commit
+			return nil
+		})
+	}()
+	<-started
+	secondDone := make(chan error, 1)
+	go func() {
+		secondDone <- s.UpdateOrderAccrual(ctx, second, order.StatusProcessing, nil, time.Time{})
+	}()
+	select {
+	case err = <-secondDone:
+		if err != nil {
+			t.Fatal(err)
+		}
+		secondDone <- nil
+	case <-time.After(200 * time.Millisecond): // the second transition waits for the first one
+	}
+
+	// the stream and the relay read the events committed so far
+	read, err := s.RetrieveOrderEvents(ctx, userID, lastID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range read {
+		lastID = e.ID
+	}
+	relayed, err := userOutboxEvents(ctx, s, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	published = append(published, relayed...)
+
+	close(commit)
+	if err = <-firstDone; err != nil {
+		t.Fatal(err)
+	}
+	if err = <-secondDone; err != nil {
+		t.Fatal(err)
+	}
+
+	resumed, err := s.RetrieveOrderEvents(ctx, userID, lastID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var numbers []string
+	for _, e := range slices.Concat(read, resumed) {
+		numbers = append(numbers, e.Number)
+	}
+	want := []string{first, second}
+	slices.Sort(numbers)
+	slices.Sort(want)
+	if !slices.Equal(numbers, want) {
+		t.Errorf("got the events of the orders %v, want %v", numbers, want)
+	}
+
+	rest, err := userOutboxEvents(ctx, s, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range rest {
+		if slices.ContainsFunc(published, func(p outbox.Event) bool { return p.ID == e.ID }) {
+			continue
+		}
+		if last := published[len(published)-1]; e.ID < last.ID {
+			t.Errorf("the outbox event %d is relayed after the event %d", e.ID, last.ID)
+		}
+	}
+}
+
+// userOutboxEvents retrieves the unpublished outbox events of the user in the order they are relayed
+func userOutboxEvents(ctx context.Context, s *Storage, userID uuid.UUID) ([]outbox.Event, error) {
+	events, err := s.RetrieveOutboxEvents(ctx, time.Now().UTC(), 10000)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(events, func(e outbox.Event) bool { return e.UserID != userID }), nil
+}
@@ -0,0 +1,6 @@
+-- name: DeleteExpiredIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+  user_id = $1
+  AND key = $2
+  AND created_at < $3;
@@ -0,0 +1,5 @@
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+  user_id = $1
+  AND key = $2;
@@ -0,0 +1,4 @@
+-- name: DeleteIdempotencyKeysCreatedBefore :execresult
+DELETE FROM idempotency_keys
+WHERE
+  created_at < $1;
@@ -0,0 +1,4 @@
+-- name: DeleteRateLimitBucketsFullBefore :execresult
+DELETE FROM rate_limit_buckets
+WHERE
+  full_at < $1;
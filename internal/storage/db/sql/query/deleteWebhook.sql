@@ -0,0 +1,5 @@
+-- name: DeleteWebhook :execresult
+DELETE FROM webhooks
+WHERE
+  id = @id
+  AND user_id = @user_id;
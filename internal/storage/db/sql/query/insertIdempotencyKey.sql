@@ -0,0 +1,6 @@
+-- name: InsertIdempotencyKey :execresult
+INSERT INTO
+  idempotency_keys (user_id, key, fingerprint, created_at)
+VALUES
+  ($1, $2, $3, $4)
+ON CONFLICT (user_id, key) DO NOTHING;
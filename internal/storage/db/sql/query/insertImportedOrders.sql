@@ -0,0 +1,26 @@
+-- name: InsertImportedOrders :execrows
+-- the orders and their statuses are recorded at once. It fails if any number is stored already
+WITH
+  inserted AS (
+    INSERT INTO
+      orders (id, number, user_id, status, created_at)
+    SELECT
+      unnest(@ids::uuid[]),
+      unnest(@numbers::text[]),
+      unnest(@user_ids::uuid[]),
+      unnest(@statuses::text[]),
+      unnest(@created_ats::timestamptz[])
+    RETURNING
+      id,
+      status,
+      created_at
+  )
+INSERT INTO
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  id,
+  status,
+  @source::text,
+  created_at
+FROM
+  inserted;
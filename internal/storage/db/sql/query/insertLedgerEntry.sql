@@ -0,0 +1,7 @@
+-- name: InsertLedgerEntry :one
+INSERT INTO
+  ledger_entries (user_id, kind, amount, order_number, reason, actor_id, created_at)
+VALUES
+  ($1, $2, $3, $4, $5, $6, $7)
+RETURNING
+  id;
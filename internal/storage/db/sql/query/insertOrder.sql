@@ -1,5 +1,23 @@
 -- name: InsertOrder :execresult
+-- the order and its first status are recorded at once. Nothing is inserted on conflict
+WITH
+  inserted AS (
+    INSERT INTO
+      orders (id, number, user_id, status, created_at)
+    VALUES
+      (@id, @number, @user_id, @status, @created_at)
+    ON CONFLICT (number) DO NOTHING
+    RETURNING
+      id,
+      status,
+      created_at
+  )
 INSERT INTO
-  orders (id, number, user_id, status, created_at)
-VALUES
-  ($1, $2, $3, $4, $5);
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  id,
+  status,
+  @source,
+  created_at
+FROM
+  inserted;
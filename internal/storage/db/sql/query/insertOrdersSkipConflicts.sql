@@ -0,0 +1,33 @@
+-- name: InsertOrdersSkipConflicts :many
+WITH
+  inserted AS (
+    INSERT INTO
+      orders (id, number, user_id, status, created_at)
+    SELECT
+      unnest(@ids::uuid[]),
+      unnest(@numbers::text[]),
+      @user_id::uuid,
+      @status::text,
+      @created_at::timestamptz
+    ON CONFLICT (number) DO NOTHING
+    RETURNING
+      id,
+      number,
+      status,
+      created_at
+  ),
+  history AS (
+    INSERT INTO
+      order_status_history (order_id, to_status, source, created_at)
+    SELECT
+      id,
+      status,
+      @source::text,
+      created_at
+    FROM
+      inserted
+  )
+SELECT
+  number
+FROM
+  inserted;
@@ -0,0 +1,21 @@
+-- name: InsertOutboxEvent :exec
+-- the user is locked until the transaction ends so the IDs of the events of the user follow the order of the commits
+WITH
+  author AS (
+    SELECT
+      users.id
+    FROM
+      users
+    WHERE
+      users.id = @user_id
+    FOR NO KEY UPDATE
+  )
+INSERT INTO
+  outbox_events (user_id, type, data, created_at)
+SELECT
+  author.id,
+  @type,
+  @data,
+  @created_at
+FROM
+  author;
@@ -4,8 +4,10 @@ INSERT INTO
     id,
     login,
     hashed_password,
+    role,
     created_at,
     updated_at
   )
 VALUES
-  ($1, $2, $3, $4, $5);
+  ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (login) DO NOTHING;
@@ -0,0 +1,5 @@
+-- name: InsertWebhook :exec
+INSERT INTO
+  webhooks (id, user_id, url, secret, statuses, active, created_at, updated_at)
+VALUES
+  (@id, @user_id, @url, @secret, @statuses, @active, @created_at, @updated_at);
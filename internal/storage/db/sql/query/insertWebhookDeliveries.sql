@@ -0,0 +1,26 @@
+-- name: InsertWebhookDeliveries :exec
+-- the latest transition of the order is delivered to the active webhooks of its user subscribed to its status
+INSERT INTO
+  webhook_deliveries (webhook_id, history_id, state, attempts, next_attempt_at, created_at)
+SELECT
+  webhooks.id,
+  order_status_history.id,
+  'pending',
+  0,
+  order_status_history.created_at,
+  order_status_history.created_at
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+  JOIN webhooks ON webhooks.user_id = orders.user_id
+WHERE
+  order_status_history.id = (
+    SELECT
+      MAX(latest.id)
+    FROM
+      order_status_history AS latest
+    WHERE
+      latest.order_id = @order_id
+  )
+  AND webhooks.active = TRUE
+  AND ',' || webhooks.statuses || ',' LIKE '%,' || order_status_history.to_status || ',%';
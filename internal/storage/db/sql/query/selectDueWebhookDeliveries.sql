@@ -0,0 +1,25 @@
+-- name: SelectDueWebhookDeliveries :many
+-- the deliveries claimed by a replica are skipped by the others
+SELECT
+  webhook_deliveries.id,
+  webhook_deliveries.attempts,
+  webhooks.url,
+  webhooks.secret,
+  orders.number,
+  order_status_history.to_status,
+  order_status_history.created_at,
+  orders.accrual
+FROM
+  webhook_deliveries
+  JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id
+  JOIN order_status_history ON order_status_history.id = webhook_deliveries.history_id
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  webhook_deliveries.state = 'pending'
+  AND webhook_deliveries.next_attempt_at <= @now
+ORDER BY
+  webhook_deliveries.next_attempt_at ASC
+LIMIT
+  @max_deliveries
+FOR UPDATE OF
+  webhook_deliveries SKIP LOCKED;
@@ -0,0 +1,7 @@
+-- name: SelectLastUserOrderEventID :one
+SELECT
+  order_events_seq
+FROM
+  users
+WHERE
+  id = @user_id;
@@ -0,0 +1,18 @@
+-- name: SelectLedgerEntries :many
+SELECT
+  id,
+  user_id,
+  kind,
+  amount,
+  order_number,
+  reason,
+  actor_id,
+  created_at
+FROM
+  ledger_entries
+WHERE
+  user_id = @user_id
+ORDER BY
+  id DESC
+LIMIT
+  @max_entries;
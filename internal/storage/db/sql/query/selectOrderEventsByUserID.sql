@@ -0,0 +1,20 @@
+-- name: SelectOrderEventsByUserID :many
+-- the events are identified by their numbers among the events of the user which follow the order of the commits
+SELECT
+  order_status_history.user_seq AS id,
+  orders.number,
+  order_status_history.from_status,
+  order_status_history.to_status,
+  order_status_history.source,
+  order_status_history.created_at,
+  orders.accrual
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  orders.user_id = @user_id
+  AND order_status_history.user_seq > @after_id
+ORDER BY
+  order_status_history.user_seq ASC
+LIMIT
+  @max_events;
@@ -0,0 +1,11 @@
+-- name: SelectOrderStatusForUpdate :one
+SELECT
+  id,
+  user_id,
+  status,
+  accrual
+FROM
+  orders
+WHERE
+  number = $1
+FOR UPDATE;
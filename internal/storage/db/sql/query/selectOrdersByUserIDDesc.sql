@@ -0,0 +1,32 @@
+-- name: SelectOrdersByUserIDDesc :many
+SELECT
+  id,
+  number,
+  status,
+  accrual,
+  created_at
+FROM
+  orders
+WHERE
+  user_id = @user_id
+  AND (
+    coalesce(cardinality(@statuses::text[]), 0) = 0
+    OR status = ANY(@statuses::text[])
+  )
+  AND (
+    sqlc.narg(created_from)::timestamptz IS NULL
+    OR created_at >= sqlc.narg(created_from)::timestamptz
+  )
+  AND (
+    sqlc.narg(created_to)::timestamptz IS NULL
+    OR created_at < sqlc.narg(created_to)::timestamptz
+  )
+  AND (
+    sqlc.narg(after_created_at)::timestamptz IS NULL
+    OR (created_at, id) < (sqlc.narg(after_created_at)::timestamptz, @after_id::uuid)
+  )
+ORDER BY
+  created_at DESC,
+  id DESC
+LIMIT
+  @max_rows;
@@ -0,0 +1,11 @@
+-- name: SelectOrdersToProcess :many
+SELECT
+  number
+FROM
+  orders
+WHERE
+  status IN ('NEW', 'PROCESSING')
+ORDER BY
+  created_at ASC
+LIMIT
+  $1;
@@ -0,0 +1,27 @@
+-- name: SelectOutboxEvents :many
+SELECT
+  id,
+  user_id,
+  type,
+  data,
+  created_at,
+  attempts
+FROM
+  outbox_events
+WHERE
+  published_at IS NULL
+  AND dead_at IS NULL
+  AND user_id NOT IN (
+    SELECT
+      held.user_id
+    FROM
+      outbox_events held
+    WHERE
+      held.published_at IS NULL
+      AND held.dead_at IS NULL
+      AND held.next_attempt_at > @now
+  )
+ORDER BY
+  id ASC
+LIMIT
+  @max_events;
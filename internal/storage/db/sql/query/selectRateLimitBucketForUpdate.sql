@@ -0,0 +1,9 @@
+-- name: SelectRateLimitBucketForUpdate :one
+SELECT
+  tokens,
+  updated_at
+FROM
+  rate_limit_buckets
+WHERE
+  key = $1
+FOR UPDATE;
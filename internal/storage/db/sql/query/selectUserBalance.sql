@@ -0,0 +1,8 @@
+-- name: SelectUserBalance :one
+SELECT
+  CAST(COALESCE(SUM(amount), 0) AS BIGINT) AS balance,
+  CAST(COALESCE(SUM(-amount) FILTER (WHERE kind = 'withdrawal'), 0) AS BIGINT) AS withdrawn
+FROM
+  ledger_entries
+WHERE
+  user_id = $1;
@@ -0,0 +1,9 @@
+-- name: SelectUserForUpdate :one
+-- the user is locked until the transaction ends so the changes of the balance are serialized
+SELECT
+  id
+FROM
+  users
+WHERE
+  id = $1
+FOR NO KEY UPDATE;
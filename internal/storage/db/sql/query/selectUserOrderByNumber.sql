@@ -0,0 +1,13 @@
+-- name: SelectUserOrderByNumber :one
+SELECT
+  id,
+  number,
+  status,
+  accrual,
+  created_at,
+  processed_at
+FROM
+  orders
+WHERE
+  number = $1
+  AND user_id = $2;
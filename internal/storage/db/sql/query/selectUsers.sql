@@ -0,0 +1,16 @@
+-- name: SelectUsers :many
+-- the users whose logins contain the login case insensitively. Any role matches if the role is empty
+SELECT
+  id,
+  login,
+  role,
+  created_at
+FROM
+  users
+WHERE
+  strpos(lower(login), lower(@login::TEXT)) > 0
+  AND (@role::TEXT = '' OR role = @role::TEXT)
+ORDER BY
+  login
+LIMIT
+  @max_users;
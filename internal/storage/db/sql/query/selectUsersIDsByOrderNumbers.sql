@@ -0,0 +1,8 @@
+-- name: SelectUsersIDsByOrderNumbers :many
+SELECT
+  number,
+  user_id
+FROM
+  orders
+WHERE
+  number = ANY(@numbers::text[]);
@@ -0,0 +1,8 @@
+-- name: SelectWebhook :one
+SELECT
+  *
+FROM
+  webhooks
+WHERE
+  id = @id
+  AND user_id = @user_id;
@@ -0,0 +1,25 @@
+-- name: SelectWebhookDeliveries :many
+-- the deliveries of the webhook of the user. Any state matches if the state is empty
+SELECT
+  webhook_deliveries.id,
+  order_status_history.to_status,
+  orders.number,
+  webhook_deliveries.state,
+  webhook_deliveries.attempts,
+  webhook_deliveries.next_attempt_at,
+  webhook_deliveries.last_error,
+  webhook_deliveries.created_at,
+  webhook_deliveries.delivered_at
+FROM
+  webhook_deliveries
+  JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id
+  JOIN order_status_history ON order_status_history.id = webhook_deliveries.history_id
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  webhooks.id = @webhook_id
+  AND webhooks.user_id = @user_id
+  AND (@state::TEXT = '' OR webhook_deliveries.state = @state::TEXT)
+ORDER BY
+  webhook_deliveries.id DESC
+LIMIT
+  @max_deliveries;
@@ -0,0 +1,17 @@
+-- name: SelectWithdrawals :many
+SELECT
+  id,
+  user_id,
+  kind,
+  amount,
+  order_number,
+  reason,
+  actor_id,
+  created_at
+FROM
+  ledger_entries
+WHERE
+  user_id = @user_id
+  AND kind = 'withdrawal'
+ORDER BY
+  id;
@@ -0,0 +1,9 @@
+-- name: UpdateIdempotentResponse :execresult
+UPDATE idempotency_keys
+SET
+  status_code = sqlc.arg(status_code),
+  response_header = sqlc.arg(response_header),
+  response_body = sqlc.arg(response_body)
+WHERE
+  user_id = sqlc.arg(user_id)
+  AND key = sqlc.arg(key);
@@ -0,0 +1,9 @@
+-- name: UpdateOrderAccrual :execresult
+UPDATE orders
+SET
+  status = @status,
+  accrual = @accrual,
+  processed_at = @processed_at
+WHERE
+  id = @id
+  AND status = @from_status;
@@ -0,0 +1,8 @@
+-- name: UpdateOutboxEventAttempt :exec
+UPDATE outbox_events
+SET
+  attempts = @attempts,
+  next_attempt_at = @next_attempt_at,
+  dead_at = @dead_at
+WHERE
+  id = @id;
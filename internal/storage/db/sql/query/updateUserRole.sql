@@ -0,0 +1,7 @@
+-- name: UpdateUserRole :execresult
+UPDATE users
+SET
+  role = @role,
+  updated_at = @updated_at
+WHERE
+  id = @id;
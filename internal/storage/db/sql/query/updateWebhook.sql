@@ -0,0 +1,10 @@
+-- name: UpdateWebhook :execresult
+UPDATE webhooks
+SET
+  url = @url,
+  statuses = @statuses,
+  active = @active,
+  updated_at = @updated_at
+WHERE
+  id = @id
+  AND user_id = @user_id;
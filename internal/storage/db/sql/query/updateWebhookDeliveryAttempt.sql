@@ -0,0 +1,10 @@
+-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET
+  state = @state,
+  attempts = @attempts,
+  next_attempt_at = @next_attempt_at,
+  last_error = @last_error,
+  delivered_at = @delivered_at
+WHERE
+  id = @id;
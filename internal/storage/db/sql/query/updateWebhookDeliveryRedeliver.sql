@@ -0,0 +1,19 @@
+-- name: UpdateWebhookDeliveryRedeliver :execresult
+UPDATE webhook_deliveries
+SET
+  state = 'pending',
+  attempts = 0,
+  next_attempt_at = @now,
+  last_error = NULL,
+  delivered_at = NULL
+WHERE
+  webhook_deliveries.id = @id
+  AND webhook_deliveries.webhook_id = (
+    SELECT
+      webhooks.id
+    FROM
+      webhooks
+    WHERE
+      webhooks.id = @webhook_id
+      AND webhooks.user_id = @user_id
+  );
@@ -0,0 +1,60 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	genDBSQL "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sql"
+	"github.com/oleshko-g/oggophermart/internal/ratelimit"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+var _ storage.RateLimit = (*Storage)(nil)
+
+// TakeRateLimitToken takes a token from the bucket of the key. See [storage.RateLimit].
+// The bucket is locked until the transaction ends so the replicas take the tokens one by one
+func (s *Storage) TakeRateLimitToken(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (res ratelimit.Result, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		full := limit.Full(now)
+		err := tx.queries.InsertRateLimitBucket(ctx, genDBSQL.InsertRateLimitBucketParams{
+			Key:       key,
+			Tokens:    full.Tokens,
+			UpdatedAt: full.UpdatedAt,
+			FullAt:    full.UpdatedAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		row, err := tx.queries.SelectRateLimitBucketForUpdate(ctx, key)
+		if err != nil {
+			return translateError(err)
+		}
+
+		var b ratelimit.Bucket
+		b, res = limit.Take(ratelimit.Bucket{Tokens: row.Tokens, UpdatedAt: row.UpdatedAt}, now)
+		err = tx.queries.UpdateRateLimitBucket(ctx, genDBSQL.UpdateRateLimitBucketParams{
+			Tokens:    b.Tokens,
+			UpdatedAt: b.UpdatedAt,
+			FullAt:    limit.FullAt(b),
+			Key:       key,
+		})
+		return translateError(err)
+	}, storage.WithIsolation(storage.IsolationLevelReadCommitted))
+	return res, err
+}
+
+// DeleteFullRateLimitBuckets deletes the buckets which are refilled completely before notAfter
+func (s *Storage) DeleteFullRateLimitBuckets(ctx context.Context, notAfter time.Time) (deleted int64, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.DeleteRateLimitBucketsFullBefore(ctx, notAfter)
+	if err != nil {
+		return 0, translateError(err)
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS accrual BIGINT NULL,
+  ADD COLUMN IF NOT EXISTS processed_at TIMESTAMPTZ NULL;
+
+
+-- +goose Down
+ALTER TABLE orders
+  DROP COLUMN IF EXISTS processed_at,
+  DROP COLUMN IF EXISTS accrual;
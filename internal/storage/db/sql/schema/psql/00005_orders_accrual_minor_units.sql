@@ -0,0 +1,9 @@
+-- +goose Up
+-- the amounts of the points are stored in the minor units which are hundredths of a point
+UPDATE orders SET accrual = accrual * 100 WHERE accrual IS NOT NULL;
+COMMENT ON COLUMN orders.accrual IS 'hundredths of a point';
+
+
+-- +goose Down
+UPDATE orders SET accrual = accrual / 100 WHERE accrual IS NOT NULL;
+COMMENT ON COLUMN orders.accrual IS NULL;
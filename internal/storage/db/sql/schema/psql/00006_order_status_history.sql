@@ -0,0 +1,36 @@
+-- +goose Up
+-- the statuses and their transitions mirror the state machine of the internal/order package
+ALTER TABLE orders ADD CONSTRAINT orders_status CHECK (status IN ('NEW', 'PROCESSING', 'INVALID', 'PROCESSED'));
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  from_status TEXT,
+  to_status TEXT NOT NULL,
+  source TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  CONSTRAINT order_status_transition CHECK (
+    (from_status IS NULL AND to_status = 'NEW')
+    OR (from_status = 'NEW' AND to_status IN ('PROCESSING', 'INVALID', 'PROCESSED'))
+    OR (from_status = 'PROCESSING' AND to_status IN ('INVALID', 'PROCESSED'))
+  )
+);
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_id ON order_status_history (order_id, id);
+
+-- the orders uploaded before are recorded as they would be uploaded and processed at once
+INSERT INTO
+  order_status_history (order_id, from_status, to_status, source, created_at)
+SELECT
+  order_id, from_status, to_status, 'migration', created_at
+FROM (
+  SELECT id AS order_id, NULL AS from_status, 'NEW' AS to_status, created_at, 0 AS step FROM orders
+  UNION ALL
+  SELECT id, 'NEW', status, COALESCE(processed_at, created_at), 1 FROM orders WHERE status <> 'NEW'
+) AS transitions
+ORDER BY
+  created_at, order_id, step;
+
+
+-- +goose Down
+DROP TABLE IF EXISTS order_status_history;
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- the status_code is zero until the response to the request is saved
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  user_id UUID NOT NULL,
+  key TEXT NOT NULL,
+  fingerprint TEXT NOT NULL,
+  status_code INTEGER NOT NULL DEFAULT 0,
+  response_header BYTEA,
+  response_body BYTEA,
+  created_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (user_id, key)
+);
+CREATE INDEX IF NOT EXISTS idempotency_keys_created_at ON idempotency_keys (created_at);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
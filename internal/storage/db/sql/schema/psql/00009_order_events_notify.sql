@@ -0,0 +1,18 @@
+-- +goose Up
+-- the replicas listening on the order_events channel are notified of the user whose order changed its status
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_order_event() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_notify('order_events', (SELECT user_id::TEXT FROM orders WHERE id = NEW.order_id));
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+CREATE TRIGGER order_status_history_notify
+AFTER INSERT ON order_status_history
+FOR EACH ROW EXECUTE FUNCTION notify_order_event();
+
+
+-- +goose Down
+DROP TRIGGER IF EXISTS order_status_history_notify ON order_status_history;
+DROP FUNCTION IF EXISTS notify_order_event();
@@ -0,0 +1,34 @@
+-- +goose Up
+-- the statuses of the orders the webhook is subscribed to are comma separated
+CREATE TABLE IF NOT EXISTS webhooks (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  url TEXT NOT NULL,
+  secret TEXT NOT NULL,
+  statuses TEXT NOT NULL,
+  active BOOLEAN NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS webhooks_user_id_created_at ON webhooks (user_id, created_at, id);
+
+-- the outbox of the order status transitions delivered to the webhooks. The delivery is written with the transition
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  webhook_id UUID NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+  history_id BIGINT NOT NULL REFERENCES order_status_history (id) ON DELETE CASCADE,
+  state TEXT NOT NULL CHECK (state IN ('pending', 'delivered', 'dead')),
+  attempts INTEGER NOT NULL,
+  next_attempt_at TIMESTAMPTZ NOT NULL,
+  last_error TEXT,
+  created_at TIMESTAMPTZ NOT NULL,
+  delivered_at TIMESTAMPTZ,
+  CONSTRAINT webhook_history UNIQUE (webhook_id, history_id)
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_id ON webhook_deliveries (webhook_id, id);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE state = 'pending';
+
+
+-- +goose Down
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- the domain events stored by the transactions of the changes they describe. They are published in the order of their IDs
+CREATE TABLE IF NOT EXISTS outbox_events (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  type TEXT NOT NULL,
+  data JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  published_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS outbox_events_unpublished ON outbox_events (id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_events_published_at ON outbox_events (published_at);
+
+-- the lease of the relay. The events are published by a single replica at a time so the events of a user keep their order
+CREATE TABLE IF NOT EXISTS outbox_relay_lease (
+  id INTEGER PRIMARY KEY CHECK (id = 1),
+  owner TEXT NOT NULL,
+  lease_until TIMESTAMPTZ NOT NULL
+);
+INSERT INTO outbox_relay_lease (id, owner, lease_until) VALUES (1, '', '1970-01-01 00:00:00+00:00') ON CONFLICT DO NOTHING;
+
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_relay_lease;
+DROP TABLE IF EXISTS outbox_events;
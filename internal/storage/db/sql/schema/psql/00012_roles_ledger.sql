@@ -0,0 +1,53 @@
+-- +goose Up
+-- the roles mirror the internal/role package
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'user' CONSTRAINT users_role CHECK (role IN ('user', 'support', 'admin'));
+
+-- an admin reprocesses a final order by moving it back to NEW
+ALTER TABLE order_status_history DROP CONSTRAINT order_status_transition;
+ALTER TABLE order_status_history ADD CONSTRAINT order_status_transition CHECK (
+  (from_status IS NULL AND to_status = 'NEW')
+  OR (from_status = 'NEW' AND to_status IN ('PROCESSING', 'INVALID', 'PROCESSED'))
+  OR (from_status = 'PROCESSING' AND to_status IN ('INVALID', 'PROCESSED'))
+  OR (from_status IN ('INVALID', 'PROCESSED') AND to_status = 'NEW' AND source = 'admin')
+);
+
+-- the changes of the balances of the users in hundredths of a point. The balance is the sum of the amounts.
+-- accrual: the order is processed, withdrawal: the points paid for an order,
+-- adjustment: an admin changed the balance for the reason, reversal: the accrual of the reprocessed order is taken back
+CREATE TABLE IF NOT EXISTS ledger_entries (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  kind TEXT NOT NULL CHECK (kind IN ('accrual', 'withdrawal', 'adjustment', 'reversal')),
+  amount BIGINT NOT NULL CHECK (amount <> 0),
+  order_number TEXT,
+  reason TEXT,
+  actor_id UUID REFERENCES users (id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  CONSTRAINT ledger_adjustment_reason CHECK (kind <> 'adjustment' OR (reason <> '' AND actor_id IS NOT NULL))
+);
+CREATE INDEX IF NOT EXISTS ledger_entries_user_id_id ON ledger_entries (user_id, id);
+
+-- the orders processed before are accrued as they were processed
+INSERT INTO
+  ledger_entries (user_id, kind, amount, order_number, created_at)
+SELECT
+  user_id, 'accrual', accrual, number, COALESCE(processed_at, created_at)
+FROM
+  orders
+WHERE
+  status = 'PROCESSED'
+  AND accrual > 0
+ORDER BY
+  COALESCE(processed_at, created_at), id;
+
+
+-- +goose Down
+DROP TABLE IF EXISTS ledger_entries;
+ALTER TABLE order_status_history DROP CONSTRAINT order_status_transition;
+-- the reprocessings recorded meanwhile are kept
+ALTER TABLE order_status_history ADD CONSTRAINT order_status_transition CHECK (
+  (from_status IS NULL AND to_status = 'NEW')
+  OR (from_status = 'NEW' AND to_status IN ('PROCESSING', 'INVALID', 'PROCESSED'))
+  OR (from_status = 'PROCESSING' AND to_status IN ('INVALID', 'PROCESSED'))
+) NOT VALID;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,56 @@
+-- +goose Up
+-- the events of the orders of a user are numbered by the sequence of the user which is incremented under the lock of the user row.
+-- Unlike the IDs the numbers follow the order of the commits so the stream resuming after an event doesn't miss the events committed later
+ALTER TABLE users ADD COLUMN IF NOT EXISTS order_events_seq BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE order_status_history ADD COLUMN IF NOT EXISTS user_seq BIGINT;
+
+UPDATE order_status_history
+SET
+  user_seq = numbered.user_seq
+FROM (
+  SELECT
+    order_status_history.id,
+    row_number() OVER (PARTITION BY orders.user_id ORDER BY order_status_history.id) AS user_seq
+  FROM
+    order_status_history
+    JOIN orders ON orders.id = order_status_history.order_id
+) AS numbered
+WHERE
+  order_status_history.id = numbered.id;
+UPDATE users
+SET
+  order_events_seq = numbered.user_seq
+FROM (
+  SELECT
+    orders.user_id,
+    MAX(order_status_history.user_seq) AS user_seq
+  FROM
+    order_status_history
+    JOIN orders ON orders.id = order_status_history.order_id
+  GROUP BY
+    orders.user_id
+) AS numbered
+WHERE
+  users.id = numbered.user_id;
+ALTER TABLE order_status_history ALTER COLUMN user_seq SET NOT NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION number_order_event() RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE users SET order_events_seq = order_events_seq + 1
+  WHERE id = (SELECT user_id FROM orders WHERE id = NEW.order_id)
+  RETURNING order_events_seq INTO NEW.user_seq;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+CREATE TRIGGER order_status_history_number
+BEFORE INSERT ON order_status_history
+FOR EACH ROW EXECUTE FUNCTION number_order_event();
+
+
+-- +goose Down
+DROP TRIGGER IF EXISTS order_status_history_number ON order_status_history;
+DROP FUNCTION IF EXISTS number_order_event();
+ALTER TABLE order_status_history DROP COLUMN IF EXISTS user_seq;
+ALTER TABLE users DROP COLUMN IF EXISTS order_events_seq;
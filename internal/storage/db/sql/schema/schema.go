@@ -14,6 +14,9 @@ import (
 //go:embed psql/*.sql
 var psqlMigrations embed.FS
 
+//go:embed sqlite/*.sql
+var sqliteMigrations embed.FS
+
 // Up runs
 func Up(d db.DriverName, database *sql.DB) error {
 	if err := goose.SetDialect(d.String()); err != nil {
@@ -21,10 +24,14 @@ func Up(d db.DriverName, database *sql.DB) error {
 	}
 
 	var dir string
-	if d == db.DriverNamePostgres {
+	switch d {
+	case db.DriverNamePostgres, db.DriverNamePgx:
 		goose.SetBaseFS(psqlMigrations)
 		dir = "psql"
-	} else {
+	case db.DriverNameSQLite:
+		goose.SetBaseFS(sqliteMigrations)
+		dir = "sqlite"
+	default:
 		return errors.New("driver is not supported")
 	}
 
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"embed"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// versions returns the versions of the migrations in the dir of the fsys in their order
+func versions(t *testing.T, fsys embed.FS, dir string) []int {
+	t.Helper()
+	names, err := fs.Glob(fsys, dir+"/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs := make([]int, len(names))
+	for i, name := range names {
+		prefix, _, _ := strings.Cut(strings.TrimPrefix(name, dir+"/"), "_")
+		if vs[i], err = strconv.Atoi(prefix); err != nil {
+			t.Fatalf("the migration %s isn't numbered: %v", name, err)
+		}
+	}
+	return vs
+}
+
+// TestMigrationVersions checks the migrations are only added. The applied migrations aren't rewritten or removed
+// since goose doesn't apply the migrations numbered below the current version
+func TestMigrationVersions(t *testing.T) {
+	psql := versions(t, psqlMigrations, "psql")
+	for i, v := range psql {
+		if v != i+1 {
+			t.Fatalf("got the PostgreSQL migration %05d after %05d, want %05d", v, i, i+1)
+		}
+	}
+	// the SQLite migrations skip the PostgreSQL specific ones
+	for _, v := range versions(t, sqliteMigrations, "sqlite") {
+		if !slices.Contains(psql, v) {
+			t.Errorf("the SQLite migration %05d has no PostgreSQL one", v)
+		}
+	}
+}
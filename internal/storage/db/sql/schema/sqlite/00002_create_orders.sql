@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orders (
+  id TEXT PRIMARY KEY,
+  number TEXT NOT NULL UNIQUE,
+  user_id TEXT NOT NULL,
+  status TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  CONSTRAINT user_id_order_number UNIQUE (user_id, number)
+);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS orders;
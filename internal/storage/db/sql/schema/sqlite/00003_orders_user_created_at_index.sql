@@ -0,0 +1,6 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS orders_user_id_created_at_id ON orders (user_id, created_at, id);
+
+
+-- +goose Down
+DROP INDEX IF EXISTS orders_user_id_created_at_id;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN accrual INTEGER;
+ALTER TABLE orders ADD COLUMN processed_at DATETIME;
+
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN processed_at;
+ALTER TABLE orders DROP COLUMN accrual;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- the amounts of the points are stored in the minor units which are hundredths of a point
+UPDATE orders SET accrual = accrual * 100 WHERE accrual IS NOT NULL;
+
+
+-- +goose Down
+UPDATE orders SET accrual = accrual / 100 WHERE accrual IS NOT NULL;
@@ -0,0 +1,65 @@
+-- +goose Up
+-- the statuses and their transitions mirror the state machine of the internal/order package.
+-- SQLite can't add a constraint to an existing table so the orders table is rebuilt
+CREATE TABLE orders_with_status_check (
+  id TEXT PRIMARY KEY,
+  number TEXT NOT NULL UNIQUE,
+  user_id TEXT NOT NULL,
+  status TEXT NOT NULL CHECK (status IN ('NEW', 'PROCESSING', 'INVALID', 'PROCESSED')),
+  created_at DATETIME NOT NULL,
+  accrual INTEGER,
+  processed_at DATETIME,
+  CONSTRAINT user_id_order_number UNIQUE (user_id, number)
+);
+INSERT INTO orders_with_status_check (id, number, user_id, status, created_at, accrual, processed_at)
+SELECT id, number, user_id, status, created_at, accrual, processed_at FROM orders;
+DROP TABLE orders;
+ALTER TABLE orders_with_status_check RENAME TO orders;
+CREATE INDEX IF NOT EXISTS orders_user_id_created_at_id ON orders (user_id, created_at, id);
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+  id INTEGER PRIMARY KEY,
+  order_id TEXT NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  from_status TEXT,
+  to_status TEXT NOT NULL,
+  source TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  CONSTRAINT order_status_transition CHECK (
+    (from_status IS NULL AND to_status = 'NEW')
+    OR (from_status = 'NEW' AND to_status IN ('PROCESSING', 'INVALID', 'PROCESSED'))
+    OR (from_status = 'PROCESSING' AND to_status IN ('INVALID', 'PROCESSED'))
+  )
+);
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_id ON order_status_history (order_id, id);
+
+-- the orders uploaded before are recorded as they would be uploaded and processed at once
+INSERT INTO
+  order_status_history (order_id, from_status, to_status, source, created_at)
+SELECT
+  order_id, from_status, to_status, 'migration', created_at
+FROM (
+  SELECT id AS order_id, NULL AS from_status, 'NEW' AS to_status, created_at, 0 AS step FROM orders
+  UNION ALL
+  SELECT id, 'NEW', status, COALESCE(processed_at, created_at), 1 FROM orders WHERE status <> 'NEW'
+)
+ORDER BY
+  created_at, order_id, step;
+
+
+-- +goose Down
+DROP TABLE IF EXISTS order_status_history;
+CREATE TABLE orders_without_status_check (
+  id TEXT PRIMARY KEY,
+  number TEXT NOT NULL UNIQUE,
+  user_id TEXT NOT NULL,
+  status TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  accrual INTEGER,
+  processed_at DATETIME,
+  CONSTRAINT user_id_order_number UNIQUE (user_id, number)
+);
+INSERT INTO orders_without_status_check (id, number, user_id, status, created_at, accrual, processed_at)
+SELECT id, number, user_id, status, created_at, accrual, processed_at FROM orders;
+DROP TABLE orders;
+ALTER TABLE orders_without_status_check RENAME TO orders;
+CREATE INDEX IF NOT EXISTS orders_user_id_created_at_id ON orders (user_id, created_at, id);
@@ -0,0 +1,13 @@
+-- +goose Up
+-- the bucket is deleted after full_at when it's the same as a bucket which isn't stored
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+  key TEXT PRIMARY KEY,
+  tokens REAL NOT NULL,
+  updated_at DATETIME NOT NULL,
+  full_at DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS rate_limit_buckets_full_at ON rate_limit_buckets (full_at);
+
+
+-- +goose Down
+DROP TABLE IF EXISTS rate_limit_buckets;
@@ -0,0 +1,64 @@
+-- +goose Up
+-- the roles mirror the internal/role package
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'support', 'admin'));
+
+-- an admin reprocesses a final order by moving it back to NEW.
+-- SQLite can't change a constraint of an existing table so the history is rebuilt.
+-- Dropping it deletes the webhook deliveries by the cascade so they are restored after it
+CREATE TABLE webhook_deliveries_backup AS SELECT * FROM webhook_deliveries;
+CREATE TABLE order_status_history_with_reprocessing (
+  id INTEGER PRIMARY KEY,
+  order_id TEXT NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+  from_status TEXT,
+  to_status TEXT NOT NULL,
+  source TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  CONSTRAINT order_status_transition CHECK (
+    (from_status IS NULL AND to_status = 'NEW')
+    OR (from_status = 'NEW' AND to_status IN ('PROCESSING', 'INVALID', 'PROCESSED'))
+    OR (from_status = 'PROCESSING' AND to_status IN ('INVALID', 'PROCESSED'))
+    OR (from_status IN ('INVALID', 'PROCESSED') AND to_status = 'NEW' AND source = 'admin')
+  )
+);
+INSERT INTO order_status_history_with_reprocessing (id, order_id, from_status, to_status, source, created_at)
+SELECT id, order_id, from_status, to_status, source, created_at FROM order_status_history;
+DROP TABLE order_status_history;
+ALTER TABLE order_status_history_with_reprocessing RENAME TO order_status_history;
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_id ON order_status_history (order_id, id);
+INSERT INTO webhook_deliveries SELECT * FROM webhook_deliveries_backup;
+DROP TABLE webhook_deliveries_backup;
+
+-- the changes of the balances of the users in hundredths of a point. The balance is the sum of the amounts.
+-- accrual: the order is processed, withdrawal: the points paid for an order,
+-- adjustment: an admin changed the balance for the reason, reversal: the accrual of the reprocessed order is taken back
+CREATE TABLE IF NOT EXISTS ledger_entries (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  kind TEXT NOT NULL CHECK (kind IN ('accrual', 'withdrawal', 'adjustment', 'reversal')),
+  amount INTEGER NOT NULL CHECK (amount <> 0),
+  order_number TEXT,
+  reason TEXT,
+  actor_id TEXT REFERENCES users (id) ON DELETE SET NULL,
+  created_at DATETIME NOT NULL,
+  CONSTRAINT ledger_adjustment_reason CHECK (kind <> 'adjustment' OR (reason <> '' AND actor_id IS NOT NULL))
+);
+CREATE INDEX IF NOT EXISTS ledger_entries_user_id_id ON ledger_entries (user_id, id);
+
+-- the orders processed before are accrued as they were processed
+INSERT INTO
+  ledger_entries (user_id, kind, amount, order_number, created_at)
+SELECT
+  user_id, 'accrual', accrual, number, COALESCE(processed_at, created_at)
+FROM
+  orders
+WHERE
+  status = 'PROCESSED'
+  AND accrual > 0
+ORDER BY
+  COALESCE(processed_at, created_at), id;
+
+
+-- +goose Down
+DROP TABLE IF EXISTS ledger_entries;
+-- the reprocessings recorded meanwhile violate the previous constraint so it isn't restored
+ALTER TABLE users DROP COLUMN role;
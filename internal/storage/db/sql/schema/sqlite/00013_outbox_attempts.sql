@@ -0,0 +1,18 @@
+-- +goose Up
+-- the failed event holds back the following events of its user until its retry so the other users aren't held back.
+-- The event is dead once its attempts are exhausted. The dead events are kept and don't hold back the events of their users
+ALTER TABLE outbox_events ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE outbox_events ADD COLUMN next_attempt_at DATETIME;
+ALTER TABLE outbox_events ADD COLUMN dead_at DATETIME;
+DROP INDEX IF EXISTS outbox_events_unpublished;
+CREATE INDEX IF NOT EXISTS outbox_events_pending ON outbox_events (id) WHERE published_at IS NULL AND dead_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_events_user_id_pending ON outbox_events (user_id, id) WHERE published_at IS NULL AND dead_at IS NULL;
+
+
+-- +goose Down
+DROP INDEX IF EXISTS outbox_events_user_id_pending;
+DROP INDEX IF EXISTS outbox_events_pending;
+CREATE INDEX IF NOT EXISTS outbox_events_unpublished ON outbox_events (id) WHERE published_at IS NULL;
+ALTER TABLE outbox_events DROP COLUMN dead_at;
+ALTER TABLE outbox_events DROP COLUMN next_attempt_at;
+ALTER TABLE outbox_events DROP COLUMN attempts;
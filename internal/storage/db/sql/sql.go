@@ -6,142 +6,332 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 	_ "github.com/lib/pq" // revive:disable-line:blank-imports registers the postgres driver
 	genDBSQL "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sql"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/role"
 	"github.com/oleshko-g/oggophermart/internal/storage"
 	"github.com/oleshko-g/oggophermart/internal/storage/db"
 	"github.com/oleshko-g/oggophermart/internal/storage/db/sql/schema"
 	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
 )
 
-// New configures and open a new connection to the db and returns a [Storage] or an error
-func New(c *db.Config) (s *Storage, err error) {
-	database, err := sql.Open(c.DSN().DriverName.String(), c.DSN().String())
+// New configures and open a new connection to the db and returns a [Storage] or an error.
+// It retries to connect with exponential backoff so the database may start up a bit later than the gophermart.
+func New(ctx context.Context, c *db.Config) (s *Storage, err error) {
+	dsn, err := c.ConnString()
 	if err != nil {
 		return nil, err
 	}
 
-	err = database.Ping()
+	database, err := sql.Open(c.DSN().DriverName.String(), dsn)
 	if err != nil {
 		return nil, err
 	}
+	database.SetMaxOpenConns(c.MaxOpenConns().Int())
+	database.SetMaxIdleConns(c.MaxIdleConns().Int())
+	database.SetConnMaxLifetime(c.ConnMaxLifetime().Duration())
+
+	err = db.Connect(ctx, c, database.PingContext)
+	if err != nil {
+		return nil, errors.Join(err, database.Close())
+	}
 
 	if err = schema.Up(c.DSN().DriverName, database); err != nil {
-		return
+		return nil, errors.Join(err, database.Close())
 	}
 
 	queries := genDBSQL.New(database)
 
 	return &Storage{
-		db:      database,
-		queries: queries,
+		db:           database,
+		dsn:          dsn,
+		queries:      queries,
+		queryTimeout: c.QueryTimeout().Duration(),
+		txIsolation:  c.TxIsolation().Level(),
+		txRetries:    c.TxRetries().Int(),
 	}, nil
 }
 
 // Storage represents an internal implementation of [sql.DB]
 type Storage struct {
-	db      *sql.DB
-	queries *genDBSQL.Queries
+	db           *sql.DB
+	dsn          string // the connection string of the listener of the order events
+	queries      *genDBSQL.Queries
+	queryTimeout time.Duration
+	txIsolation  storage.IsolationLevel
+	txRetries    int
+	tx           *sql.Tx // the transaction the storage is bound to by [Storage.WithinTx]
+}
+
+// withQueryTimeout returns the ctx with the deadline of a single storage operation
+func (s *Storage) withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.queryTimeout)
 }
 
 var _ storage.User = (*Storage)(nil)
 var _ storage.Balance = (*Storage)(nil)
 
-// RetrieveUserBalance retrieves current user's balance and the amount withdrawn by their userID or an error
-func (s *Storage) RetrieveUserBalance(ctx context.Context, userID uuid.UUID) (currentBalance, withdrawn int, err error) {
-	return 0, 0, nil
+// RetrieveUserBalance retrieves the sum of the ledger entries of the user and the sum of their withdrawals
+func (s *Storage) RetrieveUserBalance(ctx context.Context, userID uuid.UUID) (currentBalance, withdrawn money.Amount, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	balance, err := s.queries.SelectUserBalance(ctx, userID)
+	if err != nil {
+		return 0, 0, translateError(err)
+	}
+	return money.Amount(balance.Balance), money.Amount(balance.Withdrawn), nil
 }
 
-// SaveUserTransaction saved the user's transaction by the following logic:
-//   - a) If the amount is positive then it's an accrual
-//   - b) if the amount is negative then it's a withdrawl
-func (s *Storage) SaveUserTransaction(ctx context.Context, userID uuid.UUID, amount int) error {
-	return nil
+// WithdrawUserPoints records the withdrawal of the sum unless the balance of the user is less than the sum.
+// The user is locked until the withdrawal is stored so the concurrent withdrawals don't overdraw the balance
+func (s *Storage) WithdrawUserPoints(ctx context.Context, userID uuid.UUID, orderNumber string, sum money.Amount, withdrawnAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		if _, err := tx.queries.SelectUserForUpdate(ctx, userID); err != nil {
+			return translateError(err)
+		}
+		balance, err := tx.queries.SelectUserBalance(ctx, userID)
+		if err != nil {
+			return translateError(err)
+		}
+		if money.Amount(balance.Balance) < sum {
+			return fmt.Errorf("%w: the balance %s of the user %s is less than %s",
+				storageErrors.ErrNoAffect, money.Amount(balance.Balance), userID, sum)
+		}
+
+		_, err = tx.storeLedgerEntry(ctx, storage.LedgerEntry{
+			UserID:      userID,
+			Kind:        storage.LedgerWithdrawal,
+			Amount:      -sum,
+			OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+			CreatedAt:   withdrawnAt,
+		})
+		if err != nil {
+			return err
+		}
+		message, err := outbox.Withdrawn(orderNumber, sum)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, userID, withdrawnAt, message)
+	})
+}
+
+// RetrieveUserWithdrawals retrieves the withdrawals of the user in the order they were made
+func (s *Storage) RetrieveUserWithdrawals(ctx context.Context, userID uuid.UUID) ([]storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectWithdrawals(ctx, userID)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return rows, nil
 }
 
 // RetrieveUser retrieves a user id by their login
 func (s *Storage) RetrieveUser(ctx context.Context, login string) (userID uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
 	userID, err = s.queries.SelectUserIDByLogin(ctx, login)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return uuid.UUID{}, storageErrors.ErrNotFound
-		}
-		return uuid.UUID{}, err
+		return uuid.UUID{}, translateError(err)
 	}
 	return userID, nil
 }
 
-// StoreUser stores the user by their name and their hashed password.
-//   - name MUST be unique
-func (s *Storage) StoreUser(ctx context.Context, login, hashedPassword string) (err error) {
-	_, err = s.RetrieveUser(ctx, login)
+// RetrieveUserRole retrieves the ID and the role of the user by their login
+func (s *Storage) RetrieveUserRole(ctx context.Context, login string) (userID uuid.UUID, r role.Role, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	user, err := s.queries.SelectUserRoleByLogin(ctx, login)
+	if err != nil {
+		return uuid.UUID{}, "", translateError(err)
+	}
+	return user.ID, user.Role, nil
+}
+
+// UpdateUserRole changes the role of the user
+func (s *Storage) UpdateUserRole(ctx context.Context, userID uuid.UUID, r role.Role) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.UpdateUserRole(ctx, genDBSQL.UpdateUserRoleParams{
+		Role:      r,
+		UpdatedAt: time.Now().UTC(),
+		ID:        userID,
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		if errors.Is(err, storageErrors.ErrNotFound) {
-			goto newUser
-		}
 		return err
-	} else {
-		return storageErrors.ErrAlreadyExists
 	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: the user %s", storageErrors.ErrNotFound, userID)
+	}
+	return nil
+}
+
+// StoreUser stores the user by their name, their hashed password and their role.
+//   - name MUST be unique. If it's taken then [storageErrors.ErrAlreadyExists] is returned
+func (s *Storage) StoreUser(ctx context.Context, login, hashedPassword string, r role.Role) (err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
 
-newUser:
 	newUserID, err := uuid.NewV7()
 	if err != nil {
 		return err
 	}
+	now := time.Now().UTC()
 	result, err := s.queries.InsertUser(ctx,
 		genDBSQL.InsertUserParams{
 			ID:             newUserID,
 			Login:          login,
 			HashedPassword: hashedPassword,
-			CreatedAt:      time.Now().UTC(),
-			UpdatedAt:      time.Now().UTC()},
+			Role:           r,
+			CreatedAt:      now,
+			UpdatedAt:      now},
 	)
 	if err != nil {
-		return err
+		return translateError(err)
 	}
 	num, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
-	if num != 1 {
-		return fmt.Errorf("%w: expected to affect 1 row, affected %d", storageErrors.ErrNoAffect, num)
+	switch num {
+	case 1:
+		return nil
+	case 0: // the insert does nothing on conflict
+		return storageErrors.ErrAlreadyExists
 	}
-
-	return nil
+	return fmt.Errorf("%w: expected to affect 1 row, affected %d", storageErrors.ErrNoAffect, num)
 }
 
-func (s *Storage) StoreOrder(ctx context.Context, userID uuid.UUID, orderNumber, orderStatus string, createdAt time.Time) error {
+// StoreOrder stores the order of the user and records its first status in the status history by a single statement.
+// The [outbox.OrderUploaded] event is stored by the same transaction
+func (s *Storage) StoreOrder(ctx context.Context, userID uuid.UUID, orderNumber string, orderStatus order.Status, createdAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
 
 	newOrderID, err := uuid.NewV7()
 	if err != nil {
 		return err
 	}
-	res, err := s.queries.InsertOrder(ctx,
-		genDBSQL.InsertOrderParams{
-			ID:        newOrderID,
-			UserID:    userID,
-			Number:    orderNumber,
-			Status:    orderStatus,
-			CreatedAt: createdAt,
-		})
+	uploaded, err := outbox.Uploaded(orderNumber, orderStatus)
 	if err != nil {
 		return err
 	}
+	return s.withinTx(ctx, func(tx *Storage) error {
+		res, err := tx.queries.InsertOrder(ctx,
+			genDBSQL.InsertOrderParams{
+				ID:        newOrderID,
+				UserID:    userID,
+				Number:    orderNumber,
+				Status:    orderStatus,
+				CreatedAt: createdAt,
+				Source:    order.SourceUpload,
+			})
+		if err != nil {
+			return translateError(err)
+		}
 
-	rowsAffected, err := res.RowsAffected()
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		// the insert does nothing on conflict
+		if rowsAffected == 0 {
+			return storageErrors.ErrAlreadyExists
+		}
+
+		return tx.storeOutboxMessages(ctx, userID, createdAt, uploaded)
+	})
+}
+
+// StoreUserOrders stores the orders of the user by a single multi-row insert skipping the numbers which are stored already.
+// The first statuses of the stored orders are recorded in the status history by the same statement
+// and their [outbox.OrderUploaded] events by the same transaction.
+// It returns the users who uploaded the skipped numbers
+func (s *Storage) StoreUserOrders(ctx context.Context, userID uuid.UUID, orderNumbers []string, orderStatus order.Status, createdAt time.Time) (skipped map[string]uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	ids := make([]uuid.UUID, len(orderNumbers))
+	for i := range ids {
+		if ids[i], err = uuid.NewV7(); err != nil {
+			return nil, err
+		}
+	}
+	var stored []string
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		stored, err = tx.queries.InsertOrdersSkipConflicts(ctx, genDBSQL.InsertOrdersSkipConflictsParams{
+			Ids:       ids,
+			Numbers:   orderNumbers,
+			UserID:    userID,
+			Status:    orderStatus.String(),
+			CreatedAt: createdAt,
+			Source:    string(order.SourceUpload),
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		for _, n := range stored {
+			uploaded, err := outbox.Uploaded(n, orderStatus)
+			if err != nil {
+				return err
+			}
+			if err = tx.storeOutboxMessages(ctx, userID, createdAt, uploaded); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if len(stored) == len(orderNumbers) {
+		return map[string]uuid.UUID{}, nil
 	}
 
-	if rowsAffected == 0 {
-		return storageErrors.ErrAlreadyExists
+	isStored := make(map[string]bool, len(stored))
+	for _, n := range stored {
+		isStored[n] = true
+	}
+	var skippedNumbers []string
+	for _, n := range orderNumbers {
+		if !isStored[n] {
+			skippedNumbers = append(skippedNumbers, n)
+		}
 	}
 
-	return nil
+	rows, err := s.queries.SelectUsersIDsByOrderNumbers(ctx, skippedNumbers)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	skipped = make(map[string]uuid.UUID, len(rows))
+	for _, r := range rows {
+		skipped[r.Number] = r.UserID
+	}
+	return skipped, nil
 }
 func (s *Storage) RetreiveOrder(ctx context.Context, userID uuid.UUID, orderNumber string) error {
 	// s.queries.Se
@@ -149,37 +339,159 @@ func (s *Storage) RetreiveOrder(ctx context.Context, userID uuid.UUID, orderNumb
 }
 
 func (s *Storage) RetreiveUserPassword(ctx context.Context, login string) (hashedPassword string, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
 	hashedPassword, err = s.queries.SelectUserHashedPasswordByLogin(ctx, login)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return "", storageErrors.ErrNotFound
-		}
-		return "", err
+		return "", translateError(err)
 	}
 	return hashedPassword, nil
 }
 
 func (s *Storage) RetreiveOrderUser(ctx context.Context, orderNumber string) (userID uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
 	userID, err = s.queries.SelectUserIDByOrderNumber(ctx, orderNumber)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return uuid.UUID{}, storageErrors.ErrNotFound
+		return uuid.UUID{}, translateError(err)
+	}
+	return userID, nil
+}
+
+// RetrieaveUserOrders retrieves the page of the orders of the user selected by the q
+func (s *Storage) RetrieaveUserOrders(ctx context.Context, userID uuid.UUID, q storage.UserOrdersQuery) (userOrders []storage.UserOrder, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	params := genDBSQL.SelectOrdersByUserIDParams{
+		UserID:      userID,
+		Statuses:    q.StatusStrings(),
+		CreatedFrom: sql.NullTime{Time: q.From, Valid: !q.From.IsZero()},
+		CreatedTo:   sql.NullTime{Time: q.To, Valid: !q.To.IsZero()},
+		MaxRows:     math.MaxInt32,
+	}
+	if q.After != nil {
+		params.AfterCreatedAt = sql.NullTime{Time: q.After.CreatedAt, Valid: true}
+		params.AfterID = q.After.ID
+	}
+	if q.Limit > 0 {
+		params.MaxRows = int32(min(q.Limit, math.MaxInt32))
+	}
+
+	if !q.Descending {
+		userOrders, err = s.queries.SelectOrdersByUserID(ctx, params)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	} else {
+		rows, err := s.queries.SelectOrdersByUserIDDesc(ctx, genDBSQL.SelectOrdersByUserIDDescParams(params))
+		if err != nil {
+			return nil, translateError(err)
 		}
+		for _, r := range rows {
+			userOrders = append(userOrders, storage.UserOrder(r))
+		}
+	}
 
-		return uuid.UUID{}, err
+	if len(userOrders) == 0 {
+		return nil, nil
 	}
-	return userID, nil
+
+	return userOrders, nil
 }
 
-func (s *Storage) RetrieaveUserOrders(ctx context.Context, userID uuid.UUID) (userOrders []genDBSQL.SelectOrdersByUserIDRow, err error) {
-	rows, err := s.queries.SelectOrdersByUserID(ctx, userID)
+// RetrieveOrdersToProcess retrieves the numbers of the orders in the non-final statuses in the order of their upload
+func (s *Storage) RetrieveOrdersToProcess(ctx context.Context, limit int) (orderNumbers []string, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	orderNumbers, err = s.queries.SelectOrdersToProcess(ctx, int32(min(limit, math.MaxInt32)))
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
+	return orderNumbers, nil
+}
 
-	if len(rows) == 0 {
-		return nil, nil
+// RetrieveUserOrder retrieves the order of the user by its number with the history of its statuses
+func (s *Storage) RetrieveUserOrder(ctx context.Context, userID uuid.UUID, orderNumber string) (o storage.OrderDetails, history []storage.OrderStatusChange, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		o, err = tx.queries.SelectUserOrderByNumber(ctx, genDBSQL.SelectUserOrderByNumberParams{
+			Number: orderNumber,
+			UserID: userID,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		history, err = tx.queries.SelectOrderStatusHistory(ctx, o.ID)
+		return translateError(err)
+	}, storage.WithIsolation(storage.IsolationLevelRepeatableRead), storage.ReadOnly())
+	if err != nil {
+		return storage.OrderDetails{}, nil, err
 	}
+	return o, history, nil
+}
 
-	return rows, nil
+// UpdateOrderAccrual moves the order to the status, sets its accrual and records the transition in the status history
+// with its webhook deliveries, its outbox events and the ledger entry of the accrual. The order is locked until the transition is recorded
+func (s *Storage) UpdateOrderAccrual(ctx context.Context, orderNumber string, status order.Status, accrual *money.Amount, processedAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		current, err := tx.queries.SelectOrderStatusForUpdate(ctx, orderNumber)
+		if err != nil {
+			return translateError(err)
+		}
+		if err = order.Transition(current.Status, status); err != nil {
+			return fmt.Errorf("%w: the order %s: %w", storageErrors.ErrNoAffect, orderNumber, err)
+		}
+
+		res, err := tx.queries.UpdateOrderAccrual(ctx, genDBSQL.UpdateOrderAccrualParams{
+			ID:          current.ID,
+			FromStatus:  current.Status,
+			Status:      status,
+			Accrual:     accrual,
+			ProcessedAt: sql.NullTime{Time: processedAt, Valid: !processedAt.IsZero()},
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("%w: the status of the order %s has changed", storageErrors.ErrNoAffect, orderNumber)
+		}
+
+		transitedAt := time.Now().UTC()
+		err = tx.queries.InsertOrderStatusHistory(ctx, genDBSQL.InsertOrderStatusHistoryParams{
+			OrderID:    current.ID,
+			FromStatus: &current.Status,
+			ToStatus:   status,
+			Source:     order.SourceAccrual,
+			CreatedAt:  transitedAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the webhook deliveries and the events of the transition are stored with it so none is lost
+		if err = tx.queries.InsertWebhookDeliveries(ctx, current.ID); err != nil {
+			return translateError(err)
+		}
+		if err = tx.storeAccrual(ctx, current.UserID, orderNumber, status, accrual, transitedAt); err != nil {
+			return err
+		}
+		messages, err := outbox.Transition(orderNumber, status, accrual)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, current.UserID, transitedAt, messages...)
+	})
 }
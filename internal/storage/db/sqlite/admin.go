@@ -0,0 +1,271 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	genDBSQLite "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sqlite"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+var _ storage.Admin = (*Storage)(nil)
+
+// reversalReason is the reason of the reversal of the accrual of the reprocessed order
+const reversalReason = "the order is reprocessed"
+
+// importChunkRows limits the rows of a multi-row insert of the imported orders
+// so its parameters don't exceed the 999 variables allowed by the older SQLite versions
+const importChunkRows = 150
+
+// SearchUsers retrieves the users whose logins contain login in the order of the logins
+func (s *Storage) SearchUsers(ctx context.Context, login string, r role.Role, limit int) ([]storage.UserAccount, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectUsers(ctx, genDBSQLite.SelectUsersParams{
+		Login:    login,
+		Role:     r.String(),
+		MaxUsers: int64(limit),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	users := make([]storage.UserAccount, 0, len(rows))
+	for _, u := range rows {
+		u.CreatedAt = u.CreatedAt.UTC()
+		users = append(users, storage.UserAccount(u))
+	}
+	return users, nil
+}
+
+// RetrieveUserAccount retrieves the user by their ID
+func (s *Storage) RetrieveUserAccount(ctx context.Context, userID uuid.UUID) (storage.UserAccount, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	u, err := s.queries.SelectUserByID(ctx, userID)
+	u.CreatedAt = u.CreatedAt.UTC()
+	return storage.UserAccount(u), translateError(err)
+}
+
+// RetrieveLedger retrieves the latest entries of the ledger of the user
+func (s *Storage) RetrieveLedger(ctx context.Context, userID uuid.UUID, limit int) ([]storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectLedgerEntries(ctx, genDBSQLite.SelectLedgerEntriesParams{
+		UserID:     userID,
+		MaxEntries: int64(limit),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	entries := make([]storage.LedgerEntry, 0, len(rows))
+	for _, r := range rows {
+		r.CreatedAt = r.CreatedAt.UTC()
+		entries = append(entries, storage.LedgerEntry(r))
+	}
+	return entries, nil
+}
+
+// AdjustBalance stores the adjustment of the balance unless it makes the balance negative.
+// The transactions take the write lock upon their start so the concurrent changes of the balance don't overdraw it
+func (s *Storage) AdjustBalance(ctx context.Context, e storage.LedgerEntry) (storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err := s.withinTx(ctx, func(tx *Storage) error {
+		if _, err := tx.queries.SelectUserByID(ctx, e.UserID); err != nil {
+			return translateError(err)
+		}
+		balance, err := tx.queries.SelectUserBalance(ctx, e.UserID)
+		if err != nil {
+			return translateError(err)
+		}
+		if money.Amount(balance.Balance)+e.Amount < 0 {
+			return fmt.Errorf("%w: the balance %s of the user %s is less than %s",
+				storageErrors.ErrNoAffect, money.Amount(balance.Balance), e.UserID, -e.Amount)
+		}
+
+		e.ID, err = tx.storeLedgerEntry(ctx, e)
+		if err != nil {
+			return err
+		}
+		message, err := outbox.Adjusted(e.Amount, e.Reason.String, "")
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, e.UserID, e.CreatedAt, message)
+	})
+	return e, err
+}
+
+// ReprocessOrder moves the final order back to NEW
+func (s *Storage) ReprocessOrder(ctx context.Context, orderNumber string, actorID uuid.UUID, reprocessedAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		current, err := tx.queries.SelectOrderStatus(ctx, orderNumber)
+		if err != nil {
+			return translateError(err)
+		}
+		if err = order.Reprocess(current.Status); err != nil {
+			return fmt.Errorf("%w: the order %s: %w", storageErrors.ErrNoAffect, orderNumber, err)
+		}
+
+		res, err := tx.queries.UpdateOrderAccrual(ctx, genDBSQLite.UpdateOrderAccrualParams{
+			ID:         current.ID,
+			FromStatus: current.Status,
+			Status:     order.StatusNew,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("%w: the status of the order %s has changed", storageErrors.ErrNoAffect, orderNumber)
+		}
+
+		err = tx.queries.InsertOrderStatusHistory(ctx, genDBSQLite.InsertOrderStatusHistoryParams{
+			OrderID:    current.ID,
+			FromStatus: &current.Status,
+			ToStatus:   order.StatusNew,
+			Source:     order.SourceAdmin,
+			CreatedAt:  reprocessedAt.UTC(),
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the accrual is taken back since it's accrued again when the order is processed
+		if current.Status != order.StatusProcessed || current.Accrual == nil || *current.Accrual <= 0 {
+			return nil
+		}
+		reversal := storage.LedgerEntry{
+			UserID:      current.UserID,
+			Kind:        storage.LedgerReversal,
+			Amount:      -*current.Accrual,
+			OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+			Reason:      sql.NullString{String: reversalReason, Valid: true},
+			ActorID:     uuid.NullUUID{UUID: actorID, Valid: true},
+			CreatedAt:   reprocessedAt,
+		}
+		if _, err = tx.storeLedgerEntry(ctx, reversal); err != nil {
+			return err
+		}
+		message, err := outbox.Adjusted(reversal.Amount, reversalReason, orderNumber)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, current.UserID, reprocessedAt, message)
+	})
+}
+
+// ImportOrders stores the orders by the multi-row inserts of at most [importChunkRows] orders in a single transaction
+// and records their statuses in the status history after each insert. Their [outbox.OrderUploaded] events are stored by the same transaction
+func (s *Storage) ImportOrders(ctx context.Context, orders []storage.Order) (imported int64, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	orders = slices.Clone(orders)
+	for i := range orders {
+		if orders[i].ID == uuid.Nil {
+			if orders[i].ID, err = uuid.NewV7(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		imported = 0
+		for chunk := range slices.Chunk(orders, importChunkRows) {
+			args := make([]any, 0, len(chunk)*5)
+			ids := make([]uuid.UUID, len(chunk))
+			for i, o := range chunk {
+				args = append(args, o.ID, o.Number, o.UserID, o.Status, o.CreatedAt)
+				ids[i] = o.ID
+			}
+			res, err := tx.tx.ExecContext(ctx, insertOrdersQuery(len(chunk)), args...)
+			if err != nil {
+				return translateError(err)
+			}
+			rowsAffected, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			imported += rowsAffected
+
+			err = tx.queries.InsertImportedOrdersStatusHistory(ctx, genDBSQLite.InsertImportedOrdersStatusHistoryParams{
+				Source: order.SourceImport,
+				Ids:    ids,
+			})
+			if err != nil {
+				return translateError(err)
+			}
+		}
+		for _, o := range orders {
+			uploaded, err := outbox.Uploaded(o.Number, o.Status)
+			if err != nil {
+				return err
+			}
+			if err = tx.storeOutboxMessages(ctx, o.UserID, o.CreatedAt, uploaded); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return imported, nil
+}
+
+// insertOrdersQuery returns the insert of n orders by a single statement.
+// sqlc generates the inserts of a fixed number of rows only
+func insertOrdersQuery(n int) string {
+	return "INSERT INTO orders (id, number, user_id, status, created_at) VALUES " +
+		strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?, ?), ", n), ", ")
+}
+
+// storeAccrual records the positive accrual of the processed order in the ledger of the user.
+// It's called by the transaction of the transition of the order
+func (s *Storage) storeAccrual(ctx context.Context, userID uuid.UUID, orderNumber string, status order.Status, accrual *money.Amount, accruedAt time.Time) error {
+	if status != order.StatusProcessed || accrual == nil || *accrual <= 0 {
+		return nil
+	}
+	_, err := s.storeLedgerEntry(ctx, storage.LedgerEntry{
+		UserID:      userID,
+		Kind:        storage.LedgerAccrual,
+		Amount:      *accrual,
+		OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+		CreatedAt:   accruedAt,
+	})
+	return err
+}
+
+func (s *Storage) storeLedgerEntry(ctx context.Context, e storage.LedgerEntry) (id int64, err error) {
+	id, err = s.queries.InsertLedgerEntry(ctx, genDBSQLite.InsertLedgerEntryParams{
+		UserID:      e.UserID,
+		Kind:        e.Kind,
+		Amount:      e.Amount,
+		OrderNumber: e.OrderNumber,
+		Reason:      e.Reason,
+		ActorID:     e.ActorID,
+		CreatedAt:   e.CreatedAt.UTC(),
+	})
+	return id, translateError(err)
+}
@@ -0,0 +1,69 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+func TestImportOrders(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	if err := s.StoreUser(ctx, "importer", "hashed", role.User); err != nil {
+		t.Fatal(err)
+	}
+	userID, err := s.RetrieveUser(ctx, "importer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	// the orders don't fit a single insert
+	orders := make([]storage.Order, 2*importChunkRows+1)
+	for i := range orders {
+		orders[i] = storage.Order{Number: fmt.Sprintf("%d", 1000+i), UserID: userID, Status: order.StatusNew, CreatedAt: createdAt}
+	}
+	orders[len(orders)-1].Status = order.StatusInvalid
+
+	imported, err := s.ImportOrders(ctx, orders)
+	if err != nil || imported != int64(len(orders)) {
+		t.Fatalf("got %d imported orders and the error %v, want %d", imported, err, len(orders))
+	}
+	last := orders[len(orders)-1]
+	o, history, err := s.RetrieveUserOrder(ctx, userID, last.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != order.StatusInvalid {
+		t.Errorf("got the status %s, want %s", o.Status, order.StatusInvalid)
+	}
+	if len(history) != 1 || history[0].FromStatus != nil || history[0].ToStatus != order.StatusInvalid ||
+		history[0].Source != order.SourceImport || !history[0].CreatedAt.Equal(createdAt) {
+		t.Errorf("got the history %+v, want the single imported status %s at %v", history, order.StatusInvalid, createdAt)
+	}
+	events, err := s.RetrieveOutboxEvents(ctx, createdAt, 2*len(orders))
+	if err != nil || len(events) != len(orders) {
+		t.Errorf("got %d outbox events and the error %v, want %d", len(events), err, len(orders))
+	}
+
+	t.Run("stored number", func(t *testing.T) {
+		conflicting := []storage.Order{
+			{Number: "1", UserID: userID, Status: order.StatusNew, CreatedAt: createdAt},
+			orders[0],
+		}
+		imported, err := s.ImportOrders(ctx, conflicting)
+		if !errors.Is(err, storageErrors.ErrAlreadyExists) || imported != 0 {
+			t.Fatalf("got %d imported orders and the error %v, want %v", imported, err, storageErrors.ErrAlreadyExists)
+		}
+		if _, _, err = s.RetrieveUserOrder(ctx, userID, "1"); !errors.Is(err, storageErrors.ErrNotFound) {
+			t.Errorf("got the error %v, want the order which isn't stored", err)
+		}
+	})
+}
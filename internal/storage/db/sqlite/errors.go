@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// translateError wraps err with the storage error which corresponds to the driver error.
+// The errors which don't correspond to any storage error are returned as is
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %w", storageErrors.ErrNotFound, err)
+	}
+
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+	switch sqliteErr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return fmt.Errorf("%w: %w", storageErrors.ErrAlreadyExists, err)
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return fmt.Errorf("%w: %w", storageErrors.ErrReferenceNotFound, err)
+	}
+	// the primary result code is the least significant byte of the extended one
+	switch sqliteErr.Code() & 0xff {
+	case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+		// the database file is locked by another connection longer than the busy timeout
+		return fmt.Errorf("%w: %w", storageErrors.ErrSerializationFailure, err)
+	case sqlite3.SQLITE_INTERRUPT:
+		return fmt.Errorf("%w: %w", storageErrors.ErrCanceled, err)
+	}
+	return err
+}
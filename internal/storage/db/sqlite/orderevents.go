@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	genDBSQLite "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sqlite"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+// orderEventsPollInterval is the interval of polling for the new order events since SQLite doesn't notify the listeners
+const orderEventsPollInterval = 500 * time.Millisecond
+
+var _ storage.OrderEvents = (*Storage)(nil)
+
+// RetrieveOrderEvents retrieves the events of the orders of the user following the event afterID
+func (s *Storage) RetrieveOrderEvents(ctx context.Context, userID uuid.UUID, afterID int64, limit int) ([]storage.OrderEvent, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectOrderEventsByUserID(ctx, genDBSQLite.SelectOrderEventsByUserIDParams{
+		UserID:    userID,
+		AfterID:   afterID,
+		MaxEvents: int64(limit),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	events := make([]storage.OrderEvent, 0, len(rows))
+	for _, r := range rows {
+		e := storage.OrderEvent(r)
+		e.CreatedAt = e.CreatedAt.UTC()
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// RetrieveLastOrderEventID retrieves the ID of the latest event of the user.
+// SQLite serializes the transactions so the IDs follow the order of the commits
+func (s *Storage) RetrieveLastOrderEventID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	id, err := s.queries.SelectLastUserOrderEventID(ctx, userID)
+	return id, translateError(err)
+}
+
+// retrieveLastOrderEventID retrieves the ID of the latest event of all the users
+func (s *Storage) retrieveLastOrderEventID(ctx context.Context) (int64, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	id, err := s.queries.SelectLastOrderEventID(ctx)
+	return id, translateError(err)
+}
+
+// ListenOrderEvents polls for the events following the latest one by the [orderEventsPollInterval]
+func (s *Storage) ListenOrderEvents(ctx context.Context, notify func(userID uuid.UUID)) error {
+	lastID, err := s.retrieveLastOrderEventID(ctx)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(orderEventsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		rows, err := s.pollOrderEventUsers(ctx, lastID)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, r := range rows {
+			lastID = max(lastID, r.LastID)
+			notify(r.UserID)
+		}
+	}
+}
+
+// pollOrderEventUsers retrieves the users whose orders have the events following the event afterID
+func (s *Storage) pollOrderEventUsers(ctx context.Context, afterID int64) ([]genDBSQLite.SelectOrderEventUsersAfterRow, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectOrderEventUsersAfter(ctx, afterID)
+	return rows, translateError(err)
+}
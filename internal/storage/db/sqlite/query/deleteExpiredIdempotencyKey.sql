@@ -0,0 +1,6 @@
+-- name: DeleteExpiredIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+  user_id = ?
+  AND key = ?
+  AND created_at < ?;
@@ -0,0 +1,4 @@
+-- name: DeleteOutboxEventsPublishedBefore :execresult
+DELETE FROM outbox_events
+WHERE
+  published_at < sqlc.arg(published_before);
@@ -0,0 +1,5 @@
+-- name: DeleteWebhook :execresult
+DELETE FROM webhooks
+WHERE
+  id = sqlc.arg(id)
+  AND user_id = sqlc.arg(user_id);
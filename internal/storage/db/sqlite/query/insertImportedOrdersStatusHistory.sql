@@ -0,0 +1,16 @@
+-- name: InsertImportedOrdersStatusHistory :exec
+-- the statuses of the imported orders are recorded as their first statuses
+INSERT INTO
+  order_status_history (order_id, to_status, source, created_at)
+SELECT
+  imported.id,
+  imported.status,
+  @source,
+  imported.created_at
+FROM
+  orders AS imported
+WHERE
+  imported.id IN (sqlc.slice('ids'))
+ORDER BY
+  imported.created_at,
+  imported.id;
@@ -0,0 +1,7 @@
+-- name: InsertLedgerEntry :one
+INSERT INTO
+  ledger_entries (user_id, kind, amount, order_number, reason, actor_id, created_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?)
+RETURNING
+  id;
@@ -0,0 +1,6 @@
+-- name: InsertOrder :execresult
+INSERT INTO
+  orders (id, number, user_id, status, created_at)
+VALUES
+  (?, ?, ?, ?, ?)
+ON CONFLICT (number) DO NOTHING;
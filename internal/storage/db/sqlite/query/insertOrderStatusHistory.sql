@@ -0,0 +1,5 @@
+-- name: InsertOrderStatusHistory :exec
+INSERT INTO
+  order_status_history (order_id, from_status, to_status, source, created_at)
+VALUES
+  (?, ?, ?, ?, ?);
@@ -0,0 +1,6 @@
+-- name: InsertOutboxEvent :exec
+-- the writes are serialized so the IDs of the events follow the order of the commits
+INSERT INTO
+  outbox_events (user_id, type, data, created_at)
+VALUES
+  (?, ?, ?, ?);
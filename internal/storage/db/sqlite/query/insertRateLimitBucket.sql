@@ -0,0 +1,6 @@
+-- name: InsertRateLimitBucket :exec
+INSERT INTO
+  rate_limit_buckets (key, tokens, updated_at, full_at)
+VALUES
+  (?, ?, ?, ?)
+ON CONFLICT (key) DO NOTHING;
@@ -0,0 +1,13 @@
+-- name: InsertUser :execresult
+INSERT INTO
+  users (
+    id,
+    login,
+    hashed_password,
+    role,
+    created_at,
+    updated_at
+  )
+VALUES
+  (?, ?, ?, ?, ?, ?)
+ON CONFLICT (login) DO NOTHING;
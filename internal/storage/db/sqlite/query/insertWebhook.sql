@@ -0,0 +1,5 @@
+-- name: InsertWebhook :exec
+INSERT INTO
+  webhooks (id, user_id, url, secret, statuses, active, created_at, updated_at)
+VALUES
+  (sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(url), sqlc.arg(secret), sqlc.arg(statuses), sqlc.arg(active), sqlc.arg(created_at), sqlc.arg(updated_at));
@@ -0,0 +1,12 @@
+-- name: SelectIdempotencyKey :one
+SELECT
+  fingerprint,
+  status_code,
+  response_header,
+  response_body,
+  created_at
+FROM
+  idempotency_keys
+WHERE
+  user_id = ?
+  AND key = ?;
@@ -0,0 +1,5 @@
+-- name: SelectLastOrderEventID :one
+SELECT
+  CAST(COALESCE(MAX(id), 0) AS INTEGER) AS id
+FROM
+  order_status_history;
@@ -0,0 +1,8 @@
+-- name: SelectLastUserOrderEventID :one
+SELECT
+  CAST(COALESCE(MAX(order_status_history.id), 0) AS INTEGER) AS id
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  orders.user_id = @user_id;
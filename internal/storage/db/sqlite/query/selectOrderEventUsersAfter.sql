@@ -0,0 +1,11 @@
+-- name: SelectOrderEventUsersAfter :many
+SELECT
+  orders.user_id,
+  CAST(MAX(order_status_history.id) AS INTEGER) AS last_id
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  order_status_history.id > ?
+GROUP BY
+  orders.user_id;
@@ -0,0 +1,19 @@
+-- name: SelectOrderEventsByUserID :many
+SELECT
+  order_status_history.id,
+  orders.number,
+  order_status_history.from_status,
+  order_status_history.to_status,
+  order_status_history.source,
+  order_status_history.created_at,
+  orders.accrual
+FROM
+  order_status_history
+  JOIN orders ON orders.id = order_status_history.order_id
+WHERE
+  orders.user_id = sqlc.arg(user_id)
+  AND order_status_history.id > sqlc.arg(after_id)
+ORDER BY
+  order_status_history.id ASC
+LIMIT
+  sqlc.arg(max_events);
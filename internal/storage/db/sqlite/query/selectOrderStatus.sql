@@ -0,0 +1,10 @@
+-- name: SelectOrderStatus :one
+SELECT
+  id,
+  user_id,
+  status,
+  accrual
+FROM
+  orders
+WHERE
+  number = ?;
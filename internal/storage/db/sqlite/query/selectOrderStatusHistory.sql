@@ -0,0 +1,12 @@
+-- name: SelectOrderStatusHistory :many
+SELECT
+  from_status,
+  to_status,
+  source,
+  created_at
+FROM
+  order_status_history
+WHERE
+  order_id = ?
+ORDER BY
+  id ASC;
@@ -0,0 +1,37 @@
+-- name: SelectOrdersByUserIDDesc :many
+-- statuses is the list of the statuses delimited and surrounded by commas, e.g. ",NEW,PROCESSING,". Any status matches if it is empty
+SELECT
+  id,
+  number,
+  status,
+  accrual,
+  created_at
+FROM
+  orders
+WHERE
+  user_id = sqlc.arg(user_id)
+  AND (
+    CAST(sqlc.arg(statuses) AS TEXT) = ''
+    OR instr(sqlc.arg(statuses), ',' || status || ',') > 0
+  )
+  AND (
+    created_at >= sqlc.narg(created_from)
+    OR sqlc.narg(created_from) IS NULL
+  )
+  AND (
+    created_at < sqlc.narg(created_to)
+    OR sqlc.narg(created_to) IS NULL
+  )
+  AND (
+    created_at < sqlc.narg(after_created_at)
+    OR sqlc.narg(after_created_at) IS NULL
+    OR (
+      created_at = sqlc.narg(after_created_at)
+      AND id < sqlc.arg(after_id)
+    )
+  )
+ORDER BY
+  created_at DESC,
+  id DESC
+LIMIT
+  sqlc.arg(max_rows);
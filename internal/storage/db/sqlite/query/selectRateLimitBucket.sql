@@ -0,0 +1,8 @@
+-- name: SelectRateLimitBucket :one
+SELECT
+  tokens,
+  updated_at
+FROM
+  rate_limit_buckets
+WHERE
+  key = ?;
@@ -0,0 +1,8 @@
+-- name: SelectUserBalance :one
+SELECT
+  CAST(COALESCE(SUM(amount), 0) AS INTEGER) AS balance,
+  CAST(COALESCE(SUM(CASE WHEN kind = 'withdrawal' THEN -amount END), 0) AS INTEGER) AS withdrawn
+FROM
+  ledger_entries
+WHERE
+  user_id = ?;
@@ -0,0 +1,10 @@
+-- name: SelectUserByID :one
+SELECT
+  id,
+  login,
+  role,
+  created_at
+FROM
+  users
+WHERE
+  id = ?;
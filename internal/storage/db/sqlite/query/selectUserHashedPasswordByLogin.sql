@@ -0,0 +1,7 @@
+-- name: SelectUserHashedPasswordByLogin :one
+SELECT
+  hashed_password
+FROM
+  users
+WHERE
+  login = ?;
@@ -0,0 +1,7 @@
+-- name: SelectUserIDByLogin :one
+SELECT
+  id
+FROM
+  users
+WHERE
+  login = ?;
@@ -0,0 +1,7 @@
+-- name: SelectUserIDByOrderNumber :one
+SELECT
+  user_id
+FROM
+  orders
+WHERE
+  number = ?;
@@ -0,0 +1,13 @@
+-- name: SelectUserOrderByNumber :one
+SELECT
+  id,
+  number,
+  status,
+  accrual,
+  created_at,
+  processed_at
+FROM
+  orders
+WHERE
+  number = ?
+  AND user_id = ?;
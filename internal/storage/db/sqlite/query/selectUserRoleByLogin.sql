@@ -0,0 +1,8 @@
+-- name: SelectUserRoleByLogin :one
+SELECT
+  id,
+  role
+FROM
+  users
+WHERE
+  login = ?;
@@ -0,0 +1,16 @@
+-- name: SelectUsers :many
+-- the users whose logins contain the login case insensitively. Any role matches if the role is empty
+SELECT
+  id,
+  login,
+  role,
+  created_at
+FROM
+  users
+WHERE
+  instr(lower(login), lower(CAST(sqlc.arg(login) AS TEXT))) > 0
+  AND (CAST(sqlc.arg(role) AS TEXT) = '' OR role = sqlc.arg(role))
+ORDER BY
+  login
+LIMIT
+  sqlc.arg(max_users);
@@ -0,0 +1,8 @@
+-- name: SelectWebhook :one
+SELECT
+  *
+FROM
+  webhooks
+WHERE
+  id = sqlc.arg(id)
+  AND user_id = sqlc.arg(user_id);
@@ -0,0 +1,10 @@
+-- name: SelectWebhooksByUserID :many
+SELECT
+  *
+FROM
+  webhooks
+WHERE
+  user_id = sqlc.arg(user_id)
+ORDER BY
+  created_at ASC,
+  id ASC;
@@ -0,0 +1,9 @@
+-- name: UpdateOrderAccrual :execresult
+UPDATE orders
+SET
+  status = sqlc.arg(status),
+  accrual = sqlc.arg(accrual),
+  processed_at = sqlc.arg(processed_at)
+WHERE
+  id = sqlc.arg(id)
+  AND status = sqlc.arg(from_status);
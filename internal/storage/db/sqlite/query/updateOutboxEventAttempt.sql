@@ -0,0 +1,8 @@
+-- name: UpdateOutboxEventAttempt :exec
+UPDATE outbox_events
+SET
+  attempts = sqlc.arg(attempts),
+  next_attempt_at = sqlc.arg(next_attempt_at),
+  dead_at = sqlc.arg(dead_at)
+WHERE
+  id = sqlc.arg(id);
@@ -0,0 +1,6 @@
+-- name: UpdateOutboxEventPublished :exec
+UPDATE outbox_events
+SET
+  published_at = sqlc.arg(published_at)
+WHERE
+  id = sqlc.arg(id);
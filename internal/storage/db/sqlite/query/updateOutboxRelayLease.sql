@@ -0,0 +1,12 @@
+-- name: UpdateOutboxRelayLease :execresult
+-- the lease is taken if it's held by the owner already or it has expired
+UPDATE outbox_relay_lease
+SET
+  owner = sqlc.arg(owner),
+  lease_until = sqlc.arg(lease_until)
+WHERE
+  id = 1
+  AND (
+    owner = sqlc.arg(owner)
+    OR lease_until < sqlc.arg(now)
+  );
@@ -0,0 +1,8 @@
+-- name: UpdateRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET
+  tokens = sqlc.arg(tokens),
+  updated_at = sqlc.arg(updated_at),
+  full_at = sqlc.arg(full_at)
+WHERE
+  key = sqlc.arg(key);
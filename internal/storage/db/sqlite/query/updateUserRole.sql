@@ -0,0 +1,7 @@
+-- name: UpdateUserRole :execresult
+UPDATE users
+SET
+  role = sqlc.arg(role),
+  updated_at = sqlc.arg(updated_at)
+WHERE
+  id = sqlc.arg(id);
@@ -0,0 +1,10 @@
+-- name: UpdateWebhook :execresult
+UPDATE webhooks
+SET
+  url = sqlc.arg(url),
+  statuses = sqlc.arg(statuses),
+  active = sqlc.arg(active),
+  updated_at = sqlc.arg(updated_at)
+WHERE
+  id = sqlc.arg(id)
+  AND user_id = sqlc.arg(user_id);
@@ -0,0 +1,10 @@
+-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET
+  state = sqlc.arg(state),
+  attempts = sqlc.arg(attempts),
+  next_attempt_at = sqlc.arg(next_attempt_at),
+  last_error = sqlc.arg(last_error),
+  delivered_at = sqlc.arg(delivered_at)
+WHERE
+  id = sqlc.arg(id);
@@ -0,0 +1,6 @@
+-- name: UpdateWebhookDeliveryNextAttempt :exec
+UPDATE webhook_deliveries
+SET
+  next_attempt_at = sqlc.arg(next_attempt_at)
+WHERE
+  id = sqlc.arg(id);
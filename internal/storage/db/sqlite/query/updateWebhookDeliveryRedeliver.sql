@@ -0,0 +1,19 @@
+-- name: UpdateWebhookDeliveryRedeliver :execresult
+UPDATE webhook_deliveries
+SET
+  state = 'pending',
+  attempts = 0,
+  next_attempt_at = sqlc.arg(now),
+  last_error = NULL,
+  delivered_at = NULL
+WHERE
+  webhook_deliveries.id = sqlc.arg(id)
+  AND webhook_deliveries.webhook_id = (
+    SELECT
+      webhooks.id
+    FROM
+      webhooks
+    WHERE
+      webhooks.id = sqlc.arg(webhook_id)
+      AND webhooks.user_id = sqlc.arg(user_id)
+  );
@@ -0,0 +1,474 @@
+// Package sqlite is the implementation of the storage in a single SQLite database file
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	genDBSQLite "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sqlite"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/role"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"github.com/oleshko-g/oggophermart/internal/storage/db"
+	"github.com/oleshko-g/oggophermart/internal/storage/db/sql/schema"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+	_ "modernc.org/sqlite" // revive:disable-line:blank-imports registers the sqlite driver
+)
+
+// New opens the database file, creating it if it doesn't exist, and returns a [Storage] or an error
+func New(ctx context.Context, c *db.Config) (s *Storage, err error) {
+	dsn, err := c.ConnString()
+	if err != nil {
+		return nil, err
+	}
+
+	database, err := sql.Open(c.DSN().DriverName.String(), dsn)
+	if err != nil {
+		return nil, err
+	}
+	database.SetMaxOpenConns(c.MaxOpenConns().Int())
+	database.SetMaxIdleConns(c.MaxIdleConns().Int())
+	database.SetConnMaxLifetime(c.ConnMaxLifetime().Duration())
+
+	err = db.Connect(ctx, c, database.PingContext)
+	if err != nil {
+		return nil, errors.Join(err, database.Close())
+	}
+
+	if err = schema.Up(c.DSN().DriverName, database); err != nil {
+		return nil, errors.Join(err, database.Close())
+	}
+
+	return &Storage{
+		db:           database,
+		queries:      genDBSQLite.New(database),
+		queryTimeout: c.QueryTimeout().Duration(),
+		txRetries:    c.TxRetries().Int(),
+	}, nil
+}
+
+// Storage represents an internal implementation of [sql.DB] on SQLite
+type Storage struct {
+	db           *sql.DB
+	queries      *genDBSQLite.Queries
+	queryTimeout time.Duration
+	txRetries    int
+	tx           *sql.Tx // the transaction the storage is bound to by [Storage.WithinTx]
+}
+
+var _ storage.User = (*Storage)(nil)
+var _ storage.Balance = (*Storage)(nil)
+
+// withQueryTimeout returns the ctx with the deadline of a single storage operation
+func (s *Storage) withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.queryTimeout)
+}
+
+// RetrieveUserBalance retrieves the sum of the ledger entries of the user and the sum of their withdrawals
+func (s *Storage) RetrieveUserBalance(ctx context.Context, userID uuid.UUID) (currentBalance, withdrawn money.Amount, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	balance, err := s.queries.SelectUserBalance(ctx, userID)
+	if err != nil {
+		return 0, 0, translateError(err)
+	}
+	return money.Amount(balance.Balance), money.Amount(balance.Withdrawn), nil
+}
+
+// WithdrawUserPoints records the withdrawal of the sum unless the balance of the user is less than the sum.
+// The transactions take the write lock upon their start so the concurrent withdrawals don't overdraw the balance
+func (s *Storage) WithdrawUserPoints(ctx context.Context, userID uuid.UUID, orderNumber string, sum money.Amount, withdrawnAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		if _, err := tx.queries.SelectUserByID(ctx, userID); err != nil {
+			return translateError(err)
+		}
+		balance, err := tx.queries.SelectUserBalance(ctx, userID)
+		if err != nil {
+			return translateError(err)
+		}
+		if money.Amount(balance.Balance) < sum {
+			return fmt.Errorf("%w: the balance %s of the user %s is less than %s",
+				storageErrors.ErrNoAffect, money.Amount(balance.Balance), userID, sum)
+		}
+
+		_, err = tx.storeLedgerEntry(ctx, storage.LedgerEntry{
+			UserID:      userID,
+			Kind:        storage.LedgerWithdrawal,
+			Amount:      -sum,
+			OrderNumber: sql.NullString{String: orderNumber, Valid: true},
+			CreatedAt:   withdrawnAt,
+		})
+		if err != nil {
+			return err
+		}
+		message, err := outbox.Withdrawn(orderNumber, sum)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, userID, withdrawnAt, message)
+	})
+}
+
+// RetrieveUserWithdrawals retrieves the withdrawals of the user in the order they were made
+func (s *Storage) RetrieveUserWithdrawals(ctx context.Context, userID uuid.UUID) ([]storage.LedgerEntry, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectWithdrawals(ctx, userID)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	entries := make([]storage.LedgerEntry, 0, len(rows))
+	for _, r := range rows {
+		r.CreatedAt = r.CreatedAt.UTC()
+		entries = append(entries, storage.LedgerEntry(r))
+	}
+	return entries, nil
+}
+
+// RetrieveUser retrieves a user id by their login
+func (s *Storage) RetrieveUser(ctx context.Context, login string) (userID uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	userID, err = s.queries.SelectUserIDByLogin(ctx, login)
+	if err != nil {
+		return uuid.UUID{}, translateError(err)
+	}
+	return userID, nil
+}
+
+// RetrieveUserRole retrieves the ID and the role of the user by their login
+func (s *Storage) RetrieveUserRole(ctx context.Context, login string) (userID uuid.UUID, r role.Role, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	user, err := s.queries.SelectUserRoleByLogin(ctx, login)
+	if err != nil {
+		return uuid.UUID{}, "", translateError(err)
+	}
+	return user.ID, user.Role, nil
+}
+
+// UpdateUserRole changes the role of the user
+func (s *Storage) UpdateUserRole(ctx context.Context, userID uuid.UUID, r role.Role) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.UpdateUserRole(ctx, genDBSQLite.UpdateUserRoleParams{
+		Role:      r,
+		UpdatedAt: time.Now().UTC(),
+		ID:        userID,
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: the user %s", storageErrors.ErrNotFound, userID)
+	}
+	return nil
+}
+
+// StoreUser stores the user by their name, their hashed password and their role.
+//   - name MUST be unique. If it's taken then [storageErrors.ErrAlreadyExists] is returned
+func (s *Storage) StoreUser(ctx context.Context, login, hashedPassword string, r role.Role) (err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	newUserID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	result, err := s.queries.InsertUser(ctx,
+		genDBSQLite.InsertUserParams{
+			ID:             newUserID,
+			Login:          login,
+			HashedPassword: hashedPassword,
+			Role:           r,
+			CreatedAt:      now,
+			UpdatedAt:      now},
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	num, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	switch num {
+	case 1:
+		return nil
+	case 0: // the insert does nothing on conflict
+		return storageErrors.ErrAlreadyExists
+	}
+	return fmt.Errorf("%w: expected to affect 1 row, affected %d", storageErrors.ErrNoAffect, num)
+}
+
+// RetreiveUserPassword retrieves the hashed password of the user by their login
+func (s *Storage) RetreiveUserPassword(ctx context.Context, login string) (hashedPassword string, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	hashedPassword, err = s.queries.SelectUserHashedPasswordByLogin(ctx, login)
+	if err != nil {
+		return "", translateError(err)
+	}
+	return hashedPassword, nil
+}
+
+// StoreOrder stores the order of the user and records its first status in the status history with the [outbox.OrderUploaded] event
+func (s *Storage) StoreOrder(ctx context.Context, userID uuid.UUID, orderNumber string, orderStatus order.Status, createdAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	newOrderID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	uploaded, err := outbox.Uploaded(orderNumber, orderStatus)
+	if err != nil {
+		return err
+	}
+	return s.withinTx(ctx, func(tx *Storage) error {
+		res, err := tx.queries.InsertOrder(ctx,
+			genDBSQLite.InsertOrderParams{
+				ID:        newOrderID,
+				UserID:    userID,
+				Number:    orderNumber,
+				Status:    orderStatus,
+				CreatedAt: createdAt,
+			})
+		if err != nil {
+			return translateError(err)
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		// the insert does nothing on conflict
+		if rowsAffected == 0 {
+			return storageErrors.ErrAlreadyExists
+		}
+
+		err = tx.queries.InsertOrderStatusHistory(ctx, genDBSQLite.InsertOrderStatusHistoryParams{
+			OrderID:   newOrderID,
+			ToStatus:  orderStatus,
+			Source:    order.SourceUpload,
+			CreatedAt: createdAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		return tx.storeOutboxMessages(ctx, userID, createdAt, uploaded)
+	})
+}
+
+// StoreUserOrders stores the orders of the user skipping the numbers which are stored already.
+// SQLite has no array parameters so the orders are inserted one by one in a single transaction.
+// It returns the users who uploaded the skipped numbers
+func (s *Storage) StoreUserOrders(ctx context.Context, userID uuid.UUID, orderNumbers []string, orderStatus order.Status, createdAt time.Time) (skipped map[string]uuid.UUID, err error) {
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		skipped = make(map[string]uuid.UUID)
+		for _, n := range orderNumbers {
+			err := tx.StoreOrder(ctx, userID, n, orderStatus, createdAt)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, storageErrors.ErrAlreadyExists) {
+				return err
+			}
+			if skipped[n], err = tx.RetreiveOrderUser(ctx, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return skipped, nil
+}
+
+// RetreiveOrderUser retrieves the ID of the user who uploaded the order
+func (s *Storage) RetreiveOrderUser(ctx context.Context, orderNumber string) (userID uuid.UUID, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	userID, err = s.queries.SelectUserIDByOrderNumber(ctx, orderNumber)
+	if err != nil {
+		return uuid.UUID{}, translateError(err)
+	}
+	return userID, nil
+}
+
+// RetrieaveUserOrders retrieves the page of the orders of the user selected by the q
+func (s *Storage) RetrieaveUserOrders(ctx context.Context, userID uuid.UUID, q storage.UserOrdersQuery) (userOrders []storage.UserOrder, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	params := genDBSQLite.SelectOrdersByUserIDParams{
+		UserID:      userID,
+		CreatedFrom: sql.NullTime{Time: q.From.UTC(), Valid: !q.From.IsZero()},
+		CreatedTo:   sql.NullTime{Time: q.To.UTC(), Valid: !q.To.IsZero()},
+		MaxRows:     -1, // no limit
+	}
+	if len(q.Statuses) > 0 {
+		params.Statuses = "," + strings.Join(q.StatusStrings(), ",") + ","
+	}
+	if q.After != nil {
+		params.AfterCreatedAt = sql.NullTime{Time: q.After.CreatedAt.UTC(), Valid: true}
+		params.AfterID = q.After.ID
+	}
+	if q.Limit > 0 {
+		params.MaxRows = int64(q.Limit)
+	}
+
+	var rows []genDBSQLite.SelectOrdersByUserIDRow
+	if !q.Descending {
+		rows, err = s.queries.SelectOrdersByUserID(ctx, params)
+	} else {
+		var descRows []genDBSQLite.SelectOrdersByUserIDDescRow
+		descRows, err = s.queries.SelectOrdersByUserIDDesc(ctx, genDBSQLite.SelectOrdersByUserIDDescParams(params))
+		for _, r := range descRows {
+			rows = append(rows, genDBSQLite.SelectOrdersByUserIDRow(r))
+		}
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	for _, r := range rows {
+		userOrders = append(userOrders, storage.UserOrder{
+			ID:        r.ID,
+			Number:    r.Number,
+			Status:    r.Status,
+			Accrual:   r.Accrual,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return userOrders, nil
+}
+
+// RetrieveOrdersToProcess retrieves the numbers of the orders in the non-final statuses in the order of their upload
+func (s *Storage) RetrieveOrdersToProcess(ctx context.Context, limit int) (orderNumbers []string, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	orderNumbers, err = s.queries.SelectOrdersToProcess(ctx, int64(limit))
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return orderNumbers, nil
+}
+
+// RetrieveUserOrder retrieves the order of the user by its number with the history of its statuses
+func (s *Storage) RetrieveUserOrder(ctx context.Context, userID uuid.UUID, orderNumber string) (o storage.OrderDetails, history []storage.OrderStatusChange, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		row, err := tx.queries.SelectUserOrderByNumber(ctx, genDBSQLite.SelectUserOrderByNumberParams{
+			Number: orderNumber,
+			UserID: userID,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		o = storage.OrderDetails(row)
+
+		rows, err := tx.queries.SelectOrderStatusHistory(ctx, row.ID)
+		if err != nil {
+			return translateError(err)
+		}
+		history = history[:0]
+		for _, r := range rows {
+			history = append(history, storage.OrderStatusChange(r))
+		}
+		return nil
+	}, storage.ReadOnly())
+	if err != nil {
+		return storage.OrderDetails{}, nil, err
+	}
+	return o, history, nil
+}
+
+// UpdateOrderAccrual moves the order to the status, sets its accrual and records the transition in the status history
+// with its webhook deliveries, its outbox events and the ledger entry of the accrual
+func (s *Storage) UpdateOrderAccrual(ctx context.Context, orderNumber string, status order.Status, accrual *money.Amount, processedAt time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	return s.withinTx(ctx, func(tx *Storage) error {
+		current, err := tx.queries.SelectOrderStatus(ctx, orderNumber)
+		if err != nil {
+			return translateError(err)
+		}
+		if err = order.Transition(current.Status, status); err != nil {
+			return fmt.Errorf("%w: the order %s: %w", storageErrors.ErrNoAffect, orderNumber, err)
+		}
+
+		res, err := tx.queries.UpdateOrderAccrual(ctx, genDBSQLite.UpdateOrderAccrualParams{
+			ID:          current.ID,
+			FromStatus:  current.Status,
+			Status:      status,
+			Accrual:     accrual,
+			ProcessedAt: sql.NullTime{Time: processedAt.UTC(), Valid: !processedAt.IsZero()},
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("%w: the status of the order %s has changed", storageErrors.ErrNoAffect, orderNumber)
+		}
+
+		transitedAt := time.Now().UTC()
+		err = tx.queries.InsertOrderStatusHistory(ctx, genDBSQLite.InsertOrderStatusHistoryParams{
+			OrderID:    current.ID,
+			FromStatus: &current.Status,
+			ToStatus:   status,
+			Source:     order.SourceAccrual,
+			CreatedAt:  transitedAt,
+		})
+		if err != nil {
+			return translateError(err)
+		}
+
+		// the webhook deliveries and the events of the transition are stored with it so none is lost
+		if err = tx.queries.InsertWebhookDeliveries(ctx, current.ID); err != nil {
+			return translateError(err)
+		}
+		if err = tx.storeAccrual(ctx, current.UserID, orderNumber, status, accrual, transitedAt); err != nil {
+			return err
+		}
+		messages, err := outbox.Transition(orderNumber, status, accrual)
+		if err != nil {
+			return err
+		}
+		return tx.storeOutboxMessages(ctx, current.UserID, transitedAt, messages...)
+	})
+}
@@ -0,0 +1,139 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/oleshko-g/oggophermart/internal/storage/db"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+// newTestStorage returns the storage of a database file in a temporary directory
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+	c := db.NewConfig()
+	if err := c.DSN().Set("sqlite://" + filepath.Join(t.TempDir(), "gophermart.db")); err != nil {
+		t.Fatal(err)
+	}
+	s, err := New(context.Background(), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.db.Close() })
+	return s
+}
+
+func TestTranslateError(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	for _, q := range []string{
+		`CREATE TABLE parents (id INTEGER PRIMARY KEY, name TEXT UNIQUE)`,
+		`CREATE TABLE children (parent_id INTEGER REFERENCES parents (id))`,
+		`INSERT INTO parents (id, name) VALUES (1, 'a')`,
+	} {
+		if _, err := s.db.ExecContext(ctx, q); err != nil {
+			t.Fatal(err)
+		}
+	}
+	errOther := errors.New("other")
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"unique violation", exec(s, `INSERT INTO parents (id, name) VALUES (2, 'a')`), storageErrors.ErrAlreadyExists},
+		{"primary key violation", exec(s, `INSERT INTO parents (id, name) VALUES (1, 'b')`), storageErrors.ErrAlreadyExists},
+		{"foreign key violation", exec(s, `INSERT INTO children (parent_id) VALUES (2)`), storageErrors.ErrReferenceNotFound},
+		{"no rows", s.db.QueryRowContext(ctx, `SELECT id FROM parents WHERE id = 2`).Scan(new(int)), storageErrors.ErrNotFound},
+		{"other error", errOther, errOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translateError(tt.err)
+			if !errors.Is(got, tt.want) || !errors.Is(got, tt.err) {
+				t.Errorf("got the error %v, want %v wrapping %v", got, tt.want, tt.err)
+			}
+		})
+	}
+
+	if translateError(nil) != nil {
+		t.Error("nil is translated to an error")
+	}
+}
+
+func exec(s *Storage, query string) error {
+	_, err := s.db.ExecContext(context.Background(), query)
+	return err
+}
+
+func TestWithinTx(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	if err := exec(s, `CREATE TABLE attempts (n INTEGER)`); err != nil {
+		t.Fatal(err)
+	}
+	errSerialization := fmt.Errorf("%w: busy", storageErrors.ErrSerializationFailure)
+
+	t.Run("serialization failures are retried", func(t *testing.T) {
+		var attempts int
+		err := s.withinTx(ctx, func(tx *Storage) error {
+			attempts++
+			if _, err := tx.tx.ExecContext(ctx, `INSERT INTO attempts (n) VALUES (?)`, attempts); err != nil {
+				return err
+			}
+			if attempts < 3 {
+				return errSerialization
+			}
+			return nil
+		})
+		if err != nil || attempts != 3 {
+			t.Fatalf("got the error %v after %d attempts, want the success after 3", err, attempts)
+		}
+		// the failed attempts are rolled back
+		var n int
+		if err = s.db.QueryRowContext(ctx, `SELECT count(*) FROM attempts`).Scan(&n); err != nil || n != 1 {
+			t.Errorf("got %d rows, want the row of the last attempt: %v", n, err)
+		}
+	})
+
+	t.Run("retries are exhausted", func(t *testing.T) {
+		var attempts int
+		err := s.withinTx(ctx, func(*Storage) error {
+			attempts++
+			return errSerialization
+		})
+		if !errors.Is(err, storageErrors.ErrSerializationFailure) || attempts != db.DefaultTxRetries+1 {
+			t.Errorf("got the error %v after %d attempts, want the serialization failure after %d", err, attempts, db.DefaultTxRetries+1)
+		}
+	})
+
+	t.Run("nested transaction joins the outer one", func(t *testing.T) {
+		err := s.withinTx(ctx, func(tx *Storage) error {
+			return tx.withinTx(ctx, func(nested *Storage) error {
+				if nested.tx != tx.tx {
+					return errors.New("the nested transaction isn't the outer one")
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("other errors aren't retried", func(t *testing.T) {
+		var attempts int
+		err := s.withinTx(ctx, func(*Storage) error {
+			attempts++
+			return sql.ErrConnDone
+		})
+		if !errors.Is(err, sql.ErrConnDone) || attempts != 1 {
+			t.Errorf("got the error %v after %d attempts, want %v after 1", err, attempts, sql.ErrConnDone)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"github.com/oleshko-g/oggophermart/internal/storage/db"
+)
+
+var _ storage.Transactor = (*Storage)(nil)
+
+// WithinTx runs fn in a transaction. See [storage.Transactor].
+// SQLite transactions are always serializable so the isolation level is ignored
+func (s *Storage) WithinTx(ctx context.Context, fn func(tx storage.Tx) error, opts ...storage.TxOption) error {
+	return s.withinTx(ctx, func(tx *Storage) error {
+		return fn(tx)
+	}, opts...)
+}
+
+// withinTx runs fn with the storage bound to a transaction
+func (s *Storage) withinTx(ctx context.Context, fn func(tx *Storage) error, opts ...storage.TxOption) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	o := storage.NewTxOptions(storage.IsolationLevelSerializable, opts...)
+	return db.RetryTx(ctx, s.txRetries, func() error {
+		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: o.ReadOnly})
+		if err != nil {
+			return translateError(err)
+		}
+
+		txStorage := *s
+		txStorage.tx = tx
+		txStorage.queries = s.queries.WithTx(tx)
+		if err = fn(&txStorage); err != nil {
+			return errors.Join(err, tx.Rollback())
+		}
+		return translateError(tx.Commit())
+	})
+}
@@ -0,0 +1,199 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	genDBSQLite "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sqlite"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+var _ storage.Webhooks = (*Storage)(nil)
+
+// StoreWebhook stores the webhook of the user
+func (s *Storage) StoreWebhook(ctx context.Context, w storage.Webhook) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	w.CreatedAt, w.UpdatedAt = w.CreatedAt.UTC(), w.UpdatedAt.UTC()
+	return translateError(s.queries.InsertWebhook(ctx, genDBSQLite.InsertWebhookParams(w)))
+}
+
+// RetrieveWebhooks retrieves the webhooks of the user in the order of their creation
+func (s *Storage) RetrieveWebhooks(ctx context.Context, userID uuid.UUID) ([]storage.Webhook, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectWebhooksByUserID(ctx, userID)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	webhooks := make([]storage.Webhook, 0, len(rows))
+	for _, r := range rows {
+		webhooks = append(webhooks, webhookUTC(storage.Webhook(r)))
+	}
+	return webhooks, nil
+}
+
+// RetrieveWebhook retrieves the webhook of the user
+func (s *Storage) RetrieveWebhook(ctx context.Context, userID, id uuid.UUID) (storage.Webhook, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	w, err := s.queries.SelectWebhook(ctx, genDBSQLite.SelectWebhookParams{ID: id, UserID: userID})
+	return webhookUTC(storage.Webhook(w)), translateError(err)
+}
+
+// UpdateWebhook updates the webhook of the user
+func (s *Storage) UpdateWebhook(ctx context.Context, w storage.Webhook) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.UpdateWebhook(ctx, genDBSQLite.UpdateWebhookParams{
+		Url:       w.Url,
+		Statuses:  w.Statuses,
+		Active:    w.Active,
+		UpdatedAt: w.UpdatedAt.UTC(),
+		ID:        w.ID,
+		UserID:    w.UserID,
+	})
+	return webhookAffected(res, err, w.ID)
+}
+
+// DeleteWebhook deletes the webhook of the user with its deliveries
+func (s *Storage) DeleteWebhook(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.DeleteWebhook(ctx, genDBSQLite.DeleteWebhookParams{ID: id, UserID: userID})
+	return webhookAffected(res, err, id)
+}
+
+// RetrieveWebhookDeliveries retrieves the latest deliveries of the webhook of the user
+func (s *Storage) RetrieveWebhookDeliveries(ctx context.Context, userID, webhookID uuid.UUID, state string, limit int) ([]storage.WebhookDelivery, error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := s.queries.SelectWebhookDeliveries(ctx, genDBSQLite.SelectWebhookDeliveriesParams{
+		WebhookID:     webhookID,
+		UserID:        userID,
+		State:         state,
+		MaxDeliveries: int64(limit),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+	deliveries := make([]storage.WebhookDelivery, 0, len(rows))
+	for _, r := range rows {
+		d := storage.WebhookDelivery{
+			ID:            r.ID,
+			ToStatus:      r.ToStatus,
+			Number:        r.Number,
+			State:         r.State,
+			Attempts:      int32(r.Attempts),
+			NextAttemptAt: r.NextAttemptAt.UTC(),
+			LastError:     r.LastError,
+			CreatedAt:     r.CreatedAt.UTC(),
+			DeliveredAt:   r.DeliveredAt,
+		}
+		d.DeliveredAt.Time = d.DeliveredAt.Time.UTC()
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+// RedeliverWebhook makes the delivery of the webhook of the user pending from the first attempt
+func (s *Storage) RedeliverWebhook(ctx context.Context, userID, webhookID uuid.UUID, deliveryID int64, now time.Time) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := s.queries.UpdateWebhookDeliveryRedeliver(ctx, genDBSQLite.UpdateWebhookDeliveryRedeliverParams{
+		Now:       now.UTC(),
+		ID:        deliveryID,
+		WebhookID: webhookID,
+		UserID:    userID,
+	})
+	return webhookAffected(res, err, webhookID)
+}
+
+// ClaimWebhookDeliveries retrieves the due deliveries and postpones them until leaseUntil
+func (s *Storage) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) (deliveries []storage.DueWebhookDelivery, err error) {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err = s.withinTx(ctx, func(tx *Storage) error {
+		rows, err := tx.queries.SelectDueWebhookDeliveries(ctx, genDBSQLite.SelectDueWebhookDeliveriesParams{
+			Now:           now.UTC(),
+			MaxDeliveries: int64(limit),
+		})
+		if err != nil {
+			return translateError(err)
+		}
+		deliveries = deliveries[:0]
+		for _, d := range rows {
+			deliveries = append(deliveries, storage.DueWebhookDelivery{
+				ID:        d.ID,
+				Attempts:  int32(d.Attempts),
+				Url:       d.Url,
+				Secret:    d.Secret,
+				Number:    d.Number,
+				ToStatus:  d.ToStatus,
+				CreatedAt: d.CreatedAt.UTC(),
+				Accrual:   d.Accrual,
+			})
+			err = tx.queries.UpdateWebhookDeliveryNextAttempt(ctx, genDBSQLite.UpdateWebhookDeliveryNextAttemptParams{
+				NextAttemptAt: leaseUntil.UTC(),
+				ID:            d.ID,
+			})
+			if err != nil {
+				return translateError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// SaveWebhookAttempt saves the outcome of the attempt of the delivery
+func (s *Storage) SaveWebhookAttempt(ctx context.Context, deliveryID int64, a storage.WebhookAttempt) error {
+	ctx, cancel := s.withQueryTimeout(ctx)
+	defer cancel()
+
+	err := s.queries.UpdateWebhookDeliveryAttempt(ctx, genDBSQLite.UpdateWebhookDeliveryAttemptParams{
+		State:         a.State,
+		Attempts:      int64(a.Attempts),
+		NextAttemptAt: a.NextAttemptAt.UTC(),
+		LastError:     sql.NullString{String: a.LastError, Valid: a.LastError != ""},
+		DeliveredAt:   sql.NullTime{Time: a.DeliveredAt.UTC(), Valid: !a.DeliveredAt.IsZero()},
+		ID:            deliveryID,
+	})
+	return translateError(err)
+}
+
+// webhookAffected returns [storageErrors.ErrNotFound] if the statement of the webhook affected no rows
+func webhookAffected(res sql.Result, err error, webhookID uuid.UUID) error {
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: the webhook %s", storageErrors.ErrNotFound, webhookID)
+	}
+	return nil
+}
+
+// webhookUTC returns the webhook with its times in UTC
+func webhookUTC(w storage.Webhook) storage.Webhook {
+	w.CreatedAt, w.UpdatedAt = w.CreatedAt.UTC(), w.UpdatedAt.UTC()
+	return w
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+// Backoff between the attempts to commit a transaction
+const (
+	txBackoffInitial = 10 * time.Millisecond
+	txBackoffMax     = 500 * time.Millisecond
+)
+
+// RetryTx calls attempt until it succeeds, fails with an error other than [storageErrors.ErrSerializationFailure]
+// or the retries are exhausted.
+// The delay between the attempts doubles up to [txBackoffMax] and is jittered so the concurrent transactions diverge
+func RetryTx(ctx context.Context, retries int, attempt func() error) (err error) {
+	backoff := txBackoffInitial
+	for n := 0; ; n++ {
+		err = attempt()
+		if err == nil || n == retries || !errors.Is(err, storageErrors.ErrSerializationFailure) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff/2 + rand.N(backoff/2+1)):
+		}
+		backoff = min(2*backoff, txBackoffMax)
+	}
+}
+
+// SQLIsolationLevel converts the level to the [database/sql] one
+func SQLIsolationLevel(level storage.IsolationLevel) sql.IsolationLevel {
+	switch level {
+	case storage.IsolationLevelReadCommitted:
+		return sql.LevelReadCommitted
+	case storage.IsolationLevelRepeatableRead:
+		return sql.LevelRepeatableRead
+	case storage.IsolationLevelSerializable:
+		return sql.LevelSerializable
+	}
+	return sql.LevelDefault
+}
+
+// isolation is a [storage.IsolationLevel] set by its name
+type isolation storage.IsolationLevel
+
+// Names of the isolation levels
+var isolationNames = map[storage.IsolationLevel]string{
+	storage.IsolationLevelReadCommitted:  "read-committed",
+	storage.IsolationLevelRepeatableRead: "repeatable-read",
+	storage.IsolationLevelSerializable:   "serializable",
+}
+
+func (i isolation) String() string {
+	return isolationNames[storage.IsolationLevel(i)]
+}
+
+// Set parses the name of an isolation level and sets it or returns an error
+func (i *isolation) Set(s string) error {
+	for level, name := range isolationNames {
+		if name == s {
+			*i = isolation(level)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unknown isolation level %q", errParsingConfig, s)
+}
+
+// Level returns i as [storage.IsolationLevel]
+func (i isolation) Level() storage.IsolationLevel {
+	return storage.IsolationLevel(i)
+}
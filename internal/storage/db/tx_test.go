@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+)
+
+func TestRetryTx(t *testing.T) {
+	errSerialization := fmt.Errorf("%w: 40001", storageErrors.ErrSerializationFailure)
+	errOther := errors.New("other")
+
+	tests := []struct {
+		name         string
+		retries      int
+		errs         []error // the errors of the attempts. The attempts after them succeed
+		wantAttempts int
+		wantErr      error
+	}{
+		{"success", 3, nil, 1, nil},
+		{"retried serialization failures", 3, []error{errSerialization, errSerialization}, 3, nil},
+		{"exhausted retries", 2, []error{errSerialization, errSerialization, errSerialization, errSerialization}, 3, storageErrors.ErrSerializationFailure},
+		{"other error isn't retried", 3, []error{errOther}, 1, errOther},
+		{"no retries", 0, []error{errSerialization}, 1, storageErrors.ErrSerializationFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			err := RetryTx(context.Background(), tt.retries, func() error {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("got the error %v, want %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var attempts int
+		err := RetryTx(ctx, 3, func() error {
+			attempts++
+			return errSerialization
+		})
+		if !errors.Is(err, context.Canceled) || !errors.Is(err, storageErrors.ErrSerializationFailure) || attempts != 1 {
+			t.Errorf("got the error %v after %d attempts, want the serialization failure and the cancellation after 1", err, attempts)
+		}
+	})
+}
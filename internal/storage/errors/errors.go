@@ -17,4 +17,14 @@ var (
 
 	// ErrNotFound is returned when a storage record is not found
 	ErrNotFound = errors.New("not found")
+
+	// ErrReferenceNotFound is returned when a storage record refers to a record which doesn't exist
+	ErrReferenceNotFound = errors.New("reference not found")
+
+	// ErrSerializationFailure is returned when a transaction conflicts with a concurrent one.
+	// The transaction may succeed if it's retried
+	ErrSerializationFailure = errors.New("serialization failure")
+
+	// ErrCanceled is returned when a storage operation is canceled by its context or the database
+	ErrCanceled = errors.New("canceled")
 )
@@ -0,0 +1,62 @@
+// Package memory implements the storage which is kept in the memory of the process and isn't shared by the replicas
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/ratelimit"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+)
+
+var _ storage.RateLimit = (*Storage)(nil)
+
+// Storage is the in-memory storage. It's safe for the concurrent use
+type Storage struct {
+	mu      sync.Mutex
+	buckets map[string]bucket
+}
+
+// bucket is the token bucket with the time it's refilled completely
+type bucket struct {
+	ratelimit.Bucket
+	fullAt time.Time
+}
+
+// New returns the empty [Storage]
+func New() *Storage {
+	return &Storage{
+		buckets: make(map[string]bucket),
+	}
+}
+
+// TakeRateLimitToken takes a token from the bucket of the key. See [storage.RateLimit]
+func (s *Storage) TakeRateLimitToken(_ context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b.Bucket = limit.Full(now)
+	}
+	var res ratelimit.Result
+	b.Bucket, res = limit.Take(b.Bucket, now)
+	b.fullAt = limit.FullAt(b.Bucket)
+	s.buckets[key] = b
+	return res, nil
+}
+
+// DeleteFullRateLimitBuckets deletes the buckets which are refilled completely before notAfter
+func (s *Storage) DeleteFullRateLimitBuckets(_ context.Context, notAfter time.Time) (deleted int64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, b := range s.buckets {
+		if b.fullAt.Before(notAfter) {
+			delete(s.buckets, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -7,27 +7,326 @@ import (
 
 	"github.com/google/uuid"
 	genDBSQL "github.com/oleshko-g/oggophermart/internal/gen/storage/db/sql"
+	"github.com/oleshko-g/oggophermart/internal/money"
+	"github.com/oleshko-g/oggophermart/internal/order"
+	"github.com/oleshko-g/oggophermart/internal/outbox"
+	"github.com/oleshko-g/oggophermart/internal/ratelimit"
+	"github.com/oleshko-g/oggophermart/internal/role"
 )
 
 type Storage struct {
-	User    // interface
-	Balance // interface
+	User        // interface
+	Balance     // interface
+	Idempotency // interface
+	RateLimit   // interface
+	OrderEvents // interface
+	Webhooks    // interface
+	Outbox      // interface
+	Admin       // interface
 }
 
 type Order = genDBSQL.Order
 
+// UserOrder is an order listed by its user
+type UserOrder = genDBSQL.SelectOrdersByUserIDRow
+
+// OrderDetails is an order of the user retrieved by its number
+type OrderDetails = genDBSQL.SelectUserOrderByNumberRow
+
+// OrderStatusChange is a transition of the order status. FromStatus is nil for the first status of the order
+type OrderStatusChange = genDBSQL.SelectOrderStatusHistoryRow
+
+// OrderEvent is a transition of the status of an order of the user.
+// The IDs of the events of the user grow in the order of the commits of the transitions
+type OrderEvent = genDBSQL.SelectOrderEventsByUserIDRow
+
+// UserAccount is a user retrieved by the admins
+type UserAccount = genDBSQL.SelectUserByIDRow
+
+// LedgerEntry is a change of the balance of the user. The amount is negative if the balance decreases.
+// The reason and the actor are set for the adjustment, the order is set for the changes by an order
+type LedgerEntry = genDBSQL.LedgerEntry
+
+// Kinds of the ledger entries
+const (
+	LedgerAccrual    = "accrual"    // the points accrued to the user for the processed order
+	LedgerWithdrawal = "withdrawal" // the points withdrawn by the user to pay for an order
+	LedgerAdjustment = "adjustment" // an admin changed the balance for the reason
+	LedgerReversal   = "reversal"   // the accrual of the order reprocessed by an admin is taken back
+)
+
+// Webhook is a subscription of the user to the statuses of their orders. The statuses are comma separated
+type Webhook = genDBSQL.Webhook
+
+// WebhookDelivery is a delivery of an order event to a webhook listed by the user
+type WebhookDelivery = genDBSQL.SelectWebhookDeliveriesRow
+
+// DueWebhookDelivery is a delivery of an order event which is due to be attempted
+type DueWebhookDelivery = genDBSQL.SelectDueWebhookDeliveriesRow
+
+// States of a webhook delivery
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+// WebhookAttempt is the outcome of an attempt of a webhook delivery
+type WebhookAttempt struct {
+	State         string
+	Attempts      int       // the number of the failed attempts
+	NextAttemptAt time.Time // the time of the retry of the pending delivery
+	LastError     string    // the failure of the attempt. It's empty if the delivery is delivered
+	DeliveredAt   time.Time // zero unless the delivery is delivered
+}
+
+// OutboxAttempt is the outcome of a failed publishing of an outbox event
+type OutboxAttempt struct {
+	Attempts      int       // the number of the failed publishings
+	NextAttemptAt time.Time // the time of the retry. The following events of the user are held back until then
+	DeadAt        time.Time // zero unless the attempts are exhausted. The dead event isn't published and doesn't hold back the events of its user
+}
+
+// UserOrdersQuery selects the page of the orders listed by their user.
+// The zero value selects all the orders in the order of their upload
+type UserOrdersQuery struct {
+	Statuses   []order.Status // any status matches if it's empty
+	From       time.Time      // the orders uploaded at or after From. It's unbounded if zero
+	To         time.Time      // the orders uploaded before To. It's unbounded if zero
+	After      *OrderKey      // the orders following the key. The listing starts from the first order if it's nil
+	Descending bool           // the latest orders go first
+	Limit      int            // the maximum number of the orders. It's unlimited if zero
+}
+
+// StatusStrings returns the statuses of the query as the strings
+func (q UserOrdersQuery) StatusStrings() []string {
+	statuses := make([]string, len(q.Statuses))
+	for i, status := range q.Statuses {
+		statuses[i] = status.String()
+	}
+	return statuses
+}
+
+// OrderKey is the position of the order in the listing by the upload time
+type OrderKey struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Transactor declares the storage interface to compose several storage operations atomically
+type Transactor interface {
+	// WithinTx runs fn in a transaction which is committed if fn returns nil and rolled back otherwise.
+	// If the transaction fails due to a serialization failure then fn is called again in a new transaction.
+	// Calling WithinTx on tx runs fn in the same transaction
+	WithinTx(ctx context.Context, fn func(tx Tx) error, opts ...TxOption) error
+}
+
+// Tx is the storage bound to a transaction by [Transactor.WithinTx]
+type Tx interface {
+	User
+	Balance
+}
+
+// IsolationLevel is the isolation level of a transaction
+type IsolationLevel int
+
+// Isolation levels of a transaction
+const (
+	IsolationLevelDefault IsolationLevel = iota // the level configured for the storage
+	IsolationLevelReadCommitted
+	IsolationLevelRepeatableRead
+	IsolationLevelSerializable
+)
+
+// TxOptions are the options of a transaction started by [Transactor.WithinTx]
+type TxOptions struct {
+	Isolation IsolationLevel
+	ReadOnly  bool
+}
+
+// TxOption sets an option of a transaction
+type TxOption func(*TxOptions)
+
+// NewTxOptions returns the [TxOptions] with the opts applied to the defaults
+func NewTxOptions(defaultIsolation IsolationLevel, opts ...TxOption) TxOptions {
+	o := TxOptions{Isolation: defaultIsolation}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.Isolation == IsolationLevelDefault {
+		o.Isolation = defaultIsolation
+	}
+	return o
+}
+
+// WithIsolation sets the isolation level of a transaction
+func WithIsolation(level IsolationLevel) TxOption {
+	return func(o *TxOptions) {
+		o.Isolation = level
+	}
+}
+
+// ReadOnly makes a transaction read only
+func ReadOnly() TxOption {
+	return func(o *TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
 // User declares the storage interface for the user service
 type User interface {
+	Transactor
 	RetrieveUser(ctx context.Context, login string) (userID uuid.UUID, err error)
+	// RetrieveUserRole retrieves the ID and the role of the user by their login
+	RetrieveUserRole(ctx context.Context, login string) (userID uuid.UUID, r role.Role, err error)
 	RetreiveUserPassword(ctx context.Context, login string) (hashedPassword string, err error)
-	StoreUser(ctx context.Context, login, hashedPassword string) error
+	// StoreUser stores the user with the role. [storageErrors.ErrAlreadyExists] is returned if the login is taken
+	StoreUser(ctx context.Context, login, hashedPassword string, r role.Role) error
+	// UpdateUserRole changes the role of the user or returns [storageErrors.ErrNotFound]
+	UpdateUserRole(ctx context.Context, userID uuid.UUID, r role.Role) error
 }
 
 // Balance declares the storage interfce for the balance service
 type Balance interface {
-	RetrieveUserBalance(ctx context.Context, userID uuid.UUID) (currentBalance, withdrawn int, err error)
-	SaveUserTransaction(ctx context.Context, userID uuid.UUID, amount int) error
-	StoreOrder(ctx context.Context, userID uuid.UUID, orderNumber, status string, createdAt time.Time) error
+	Transactor
+	// RetrieveUserBalance retrieves the sum of the ledger entries of the user and the sum of their withdrawals
+	RetrieveUserBalance(ctx context.Context, userID uuid.UUID) (currentBalance, withdrawn money.Amount, err error)
+	// StoreOrder stores the order of the user and records its first status in the status history
+	// with the [outbox.OrderUploaded] event
+	StoreOrder(ctx context.Context, userID uuid.UUID, orderNumber string, status order.Status, createdAt time.Time) error
+	// StoreUserOrders stores the orders of the user in bulk skipping the numbers which are stored already.
+	// The [outbox.OrderUploaded] events of the stored orders are stored with them.
+	// It returns the users who uploaded the skipped numbers. The numbers absent in skipped are stored
+	StoreUserOrders(ctx context.Context, userID uuid.UUID, orderNumbers []string, status order.Status, createdAt time.Time) (skipped map[string]uuid.UUID, err error)
 	RetreiveOrderUser(ctx context.Context, orderNumber string) (userID uuid.UUID, err error)
-	RetrieaveUserOrders(ctx context.Context, userID uuid.UUID) ([]genDBSQL.SelectOrdersByUserIDRow, error)
+	RetrieaveUserOrders(ctx context.Context, userID uuid.UUID, q UserOrdersQuery) ([]UserOrder, error)
+	// RetrieveUserOrder retrieves the order of the user by its number with the history of its statuses in the order of the transitions.
+	// [storageErrors.ErrNotFound] is returned if the user hasn't uploaded the order
+	RetrieveUserOrder(ctx context.Context, userID uuid.UUID, orderNumber string) (o OrderDetails, history []OrderStatusChange, err error)
+	// RetrieveOrdersToProcess retrieves the numbers of the orders in the non-final statuses in the order of their upload
+	RetrieveOrdersToProcess(ctx context.Context, limit int) (orderNumbers []string, err error)
+	// UpdateOrderAccrual moves the order to the status, sets its accrual and records the transition in the status history
+	// with its deliveries to the webhooks of the user and its events in the outbox. See [outbox.Transition].
+	// The positive accrual of the processed order is recorded in the ledger of the user.
+	// The accrual is absent if it's nil. The processedAt is stored unless it's zero.
+	// If the status can't follow the current one then the order isn't updated
+	// and [storageErrors.ErrNoAffect] wrapping [order.ErrTransition] is returned
+	UpdateOrderAccrual(ctx context.Context, orderNumber string, status order.Status, accrual *money.Amount, processedAt time.Time) error
+	// WithdrawUserPoints records the withdrawal of the sum to pay for the order in the ledger of the user
+	// with the [outbox.Withdrawn] event. If the balance of the user is less than the sum then nothing is stored
+	// and [storageErrors.ErrNoAffect] is returned
+	WithdrawUserPoints(ctx context.Context, userID uuid.UUID, orderNumber string, sum money.Amount, withdrawnAt time.Time) error
+	// RetrieveUserWithdrawals retrieves the withdrawals of the user in the order they were made
+	RetrieveUserWithdrawals(ctx context.Context, userID uuid.UUID) ([]LedgerEntry, error)
+}
+
+// IdempotencyKey is the key of a request of the user with the response to the request
+type IdempotencyKey struct {
+	Fingerprint string // identifies the request the key is stored for
+	StatusCode  int    // zero until the response is saved
+	Header      []byte
+	Body        []byte
+	CreatedAt   time.Time
+}
+
+// Idempotency declares the storage interface of the idempotency keys of the requests
+type Idempotency interface {
+	// StoreIdempotencyKey stores the key of the request of the user. The key stored before notBefore is expired and replaced.
+	// [storageErrors.ErrAlreadyExists] is returned if the key is stored and isn't expired
+	StoreIdempotencyKey(ctx context.Context, userID uuid.UUID, key, fingerprint string, createdAt, notBefore time.Time) error
+	// RetrieveIdempotencyKey retrieves the key of the user or returns [storageErrors.ErrNotFound]
+	RetrieveIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (IdempotencyKey, error)
+	// SaveIdempotentResponse saves the response to the request of the key
+	SaveIdempotentResponse(ctx context.Context, userID uuid.UUID, key string, statusCode int, header, body []byte) error
+	DeleteIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) error
+	// DeleteExpiredIdempotencyKeys deletes the keys stored before notBefore
+	DeleteExpiredIdempotencyKeys(ctx context.Context, notBefore time.Time) (deleted int64, err error)
+}
+
+// RateLimit declares the storage interface of the token buckets of the rate limits
+type RateLimit interface {
+	// TakeRateLimitToken takes a token from the bucket of the key by [ratelimit.Limit.Take].
+	// The bucket which isn't stored is full
+	TakeRateLimitToken(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Result, error)
+	// DeleteFullRateLimitBuckets deletes the buckets which are refilled completely before notAfter
+	DeleteFullRateLimitBuckets(ctx context.Context, notAfter time.Time) (deleted int64, err error)
+}
+
+// OrderEvents declares the storage interface of the events of the user orders
+type OrderEvents interface {
+	// RetrieveOrderEvents retrieves at most limit events of the orders of the user following the event afterID in the order of their IDs
+	RetrieveOrderEvents(ctx context.Context, userID uuid.UUID, afterID int64, limit int) ([]OrderEvent, error)
+	// RetrieveLastOrderEventID retrieves the ID of the latest event of the orders of the user. It's zero if there are no events
+	RetrieveLastOrderEventID(ctx context.Context, userID uuid.UUID) (int64, error)
+	// ListenOrderEvents calls notify with the user whose orders have new events until ctx is done or the listening fails.
+	// The events stored by the other replicas are notified as well
+	ListenOrderEvents(ctx context.Context, notify func(userID uuid.UUID)) error
+}
+
+// Webhooks declares the storage interface of the webhooks of the users and the outbox of their deliveries.
+// The deliveries of the order events are stored with the transitions of the order statuses by [Balance.UpdateOrderAccrual]
+type Webhooks interface {
+	// StoreWebhook stores the webhook of the user
+	StoreWebhook(ctx context.Context, w Webhook) error
+	// RetrieveWebhooks retrieves the webhooks of the user in the order of their creation
+	RetrieveWebhooks(ctx context.Context, userID uuid.UUID) ([]Webhook, error)
+	// RetrieveWebhook retrieves the webhook of the user or returns [storageErrors.ErrNotFound]
+	RetrieveWebhook(ctx context.Context, userID, id uuid.UUID) (Webhook, error)
+	// UpdateWebhook updates the URL, the statuses, the activity and the update time of the webhook of the user.
+	// [storageErrors.ErrNotFound] is returned if the user has no webhook with the ID
+	UpdateWebhook(ctx context.Context, w Webhook) error
+	// DeleteWebhook deletes the webhook of the user with its deliveries or returns [storageErrors.ErrNotFound]
+	DeleteWebhook(ctx context.Context, userID, id uuid.UUID) error
+	// RetrieveWebhookDeliveries retrieves at most limit latest deliveries of the webhook of the user in the state. Any state matches if it's empty
+	RetrieveWebhookDeliveries(ctx context.Context, userID, webhookID uuid.UUID, state string, limit int) ([]WebhookDelivery, error)
+	// RedeliverWebhook makes the delivery pending from the first attempt at now.
+	// [storageErrors.ErrNotFound] is returned if the webhook of the user has no delivery with the ID
+	RedeliverWebhook(ctx context.Context, userID, webhookID uuid.UUID, deliveryID int64, now time.Time) error
+	// ClaimWebhookDeliveries retrieves at most limit pending deliveries due at now in the order of their attempts
+	// and postpones them until leaseUntil so the other replicas don't attempt them meanwhile
+	ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]DueWebhookDelivery, error)
+	// SaveWebhookAttempt saves the outcome of the attempt of the delivery
+	SaveWebhookAttempt(ctx context.Context, deliveryID int64, a WebhookAttempt) error
+}
+
+// Outbox declares the storage interface of the outbox of the domain events.
+// The events are stored by the transactions of the changes of [Balance] they describe
+type Outbox interface {
+	// AcquireOutboxLease takes or renews the lease of the relay by the owner until leaseUntil.
+	// It reports false if the lease is held by another owner at now
+	AcquireOutboxLease(ctx context.Context, owner string, now, leaseUntil time.Time) (bool, error)
+	// RetrieveOutboxEvents retrieves at most limit unpublished events which aren't dead in the order of their IDs.
+	// The events of the users whose failed events are retried after now aren't retrieved
+	RetrieveOutboxEvents(ctx context.Context, now time.Time, limit int) ([]outbox.Event, error)
+	// MarkOutboxEventPublished marks the event published at publishedAt
+	MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error
+	// SaveOutboxAttempt saves the outcome of the failed publishing of the event
+	SaveOutboxAttempt(ctx context.Context, id int64, a OutboxAttempt) error
+	// DeletePublishedOutboxEvents deletes the events published before publishedBefore and returns their number
+	DeletePublishedOutboxEvents(ctx context.Context, publishedBefore time.Time) (deleted int64, err error)
+}
+
+// Admin declares the storage interface of the admin service which reads and changes the accounts of any user
+type Admin interface {
+	// SearchUsers retrieves at most limit users whose logins contain login case insensitively in the order of the logins.
+	// Any role matches if r is empty
+	SearchUsers(ctx context.Context, login string, r role.Role, limit int) ([]UserAccount, error)
+	// RetrieveUserAccount retrieves the user or returns [storageErrors.ErrNotFound]
+	RetrieveUserAccount(ctx context.Context, userID uuid.UUID) (UserAccount, error)
+	// RetrieveLedger retrieves at most limit latest entries of the ledger of the user
+	RetrieveLedger(ctx context.Context, userID uuid.UUID, limit int) ([]LedgerEntry, error)
+	// AdjustBalance stores the adjustment of the balance with its [outbox.PointsAdjusted] event and returns it with its ID.
+	// [storageErrors.ErrNotFound] is returned if the user doesn't exist.
+	// If the balance would be negative then the adjustment isn't stored and [storageErrors.ErrNoAffect] is returned
+	AdjustBalance(ctx context.Context, e LedgerEntry) (LedgerEntry, error)
+	// ReprocessOrder moves the final order back to [order.StatusNew] by the actor and records the transition in the status history
+	// so the order is processed again. The accrual of the processed order is reversed in the ledger with its [outbox.PointsAdjusted] event.
+	// [storageErrors.ErrNotFound] is returned if the order doesn't exist.
+	// If the order isn't final then it isn't updated and [storageErrors.ErrNoAffect] wrapping [order.ErrTransition] is returned
+	ReprocessOrder(ctx context.Context, orderNumber string, actorID uuid.UUID, reprocessedAt time.Time) error
+	// ImportOrders stores the orders of any users in bulk with their [outbox.OrderUploaded] events
+	// and records their statuses in the status history as imported by [order.SourceImport].
+	// The IDs of the orders are generated if they are zero. The accruals and the processing times of the orders are ignored.
+	// If any number is stored already then no order is stored and [storageErrors.ErrAlreadyExists] is returned
+	ImportOrders(ctx context.Context, orders []Order) (imported int64, err error)
 }
@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oleshko-g/oggophermart/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// authorizationMetadata is the metadata of the JWT token
+const authorizationMetadata = "authorization"
+
+// Authentication returns the interceptor which authenticates the JWT token in the authorization metadata once per call.
+// The result is kept in the call context for the other interceptors and the services
+func Authentication(auther service.Auther) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		token := firstMetadata(ctx, authorizationMetadata)
+		if token == "" {
+			return handler(ctx, req)
+		}
+		if _, cred, ok := strings.Cut(token, " "); ok {
+			token = cred // removes the authorization scheme prefix, e.g. "Bearer"
+		}
+		return handler(auther.Authenticate(ctx, token), req)
+	}
+}
+
+// firstMetadata returns the first value of the incoming metadata of the key or an empty string
+func firstMetadata(ctx context.Context, key string) string {
+	if v := metadata.ValueFromIncomingContext(ctx, key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
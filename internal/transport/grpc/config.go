@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+// errParsingAddress indicates an invalid address of the [Server]
+var errParsingAddress = errors.New("error parsing gRPC address")
+
+// Config contains the [flag.Value]s to set up the [Server]. The zero value disables the server
+type Config struct {
+	address address
+}
+
+// Address returns a pointer to the [flag.Value] to set the address the [Server] listens on
+func (c *Config) Address() *address { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.address
+}
+
+// Enabled reports whether the [Server] is set up
+func (c *Config) Enabled() bool {
+	return c.address != ""
+}
+
+// address is the host:port the [Server] listens on. It's empty if the server is disabled
+type address string
+
+func (a *address) String() string {
+	return string(*a)
+}
+
+// Set validates the host:port and sets it or returns an error. The empty host is any interface
+func (a *address) Set(s string) error {
+	if s == "" {
+		*a = ""
+		return nil
+	}
+	if _, port, err := net.SplitHostPort(s); err != nil || port == "" {
+		return fmt.Errorf("%w: %q isn't host:port", errParsingAddress, s)
+	}
+	*a = address(s)
+	return nil
+}
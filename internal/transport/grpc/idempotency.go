@@ -0,0 +1,218 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	"github.com/oleshko-g/oggophermart/internal/service"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+	"goa.design/clue/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Metadata of the idempotent calls. They are the headers of the HTTP API
+const (
+	// idempotencyKeyMetadata is the metadata of the key which makes retrying a call safe
+	idempotencyKeyMetadata = "idempotency-key"
+	// idempotentReplayedMetadata marks the stored response which is replayed
+	idempotentReplayedMetadata = "idempotent-replayed"
+	// statusMetadata and messageMetadata keep the status of the call in the stored header
+	statusMetadata  = "grpc-status"
+	messageMetadata = "grpc-message"
+)
+
+// callStatusCode is the HTTP status code of the stored call. The gRPC responses are sent with 200 OK and the code of the call is kept in the header
+const callStatusCode = 200
+
+// maxIdempotencyKeyLength is the maximum length of an idempotency key
+const maxIdempotencyKeyLength = 255
+
+// idempotentMethods are the mutating methods which accept the idempotency key with the constructors of their responses
+var idempotentMethods = map[string]func() proto.Message{
+	balancepb.Balance_UploadUserOrder_FullMethodName: func() proto.Message { return new(balancepb.UploadUserOrderResponse) },
+}
+
+// serverCodes are the codes of the calls which aren't stored so the call can be retried. They are the 5xx status codes of the HTTP API
+var serverCodes = map[codes.Code]bool{
+	codes.Canceled:          true,
+	codes.Unknown:           true,
+	codes.DeadlineExceeded:  true,
+	codes.ResourceExhausted: true,
+	codes.Aborted:           true,
+	codes.Unimplemented:     true,
+	codes.Internal:          true,
+	codes.Unavailable:       true,
+	codes.DataLoss:          true,
+}
+
+// Idempotency returns the interceptor which stores the response to a call with the idempotency key metadata
+// and replays it to the retries of the call by the same user. The keys are stored in the storage shared with the HTTP API for the ttl.
+// A call with the key which is reused for a different call is rejected with the InvalidArgument code.
+// A retry of the call which is still in progress is rejected with the Aborted code
+func Idempotency(storage storage.Idempotency, auther service.Auther, ttl time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := firstMetadata(ctx, idempotencyKeyMetadata)
+		newResponse, ok := idempotentMethods[info.FullMethod]
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+		if !validIdempotencyKey(key) {
+			return nil, status.Error(codes.InvalidArgument, "invalid "+idempotencyKeyMetadata)
+		}
+
+		userID, err := auther.UserIDFromContext(ctx)
+		if err != nil {
+			return handler(ctx, req) // the service rejects the call
+		}
+		fingerprint, err := callFingerprint(info.FullMethod, req)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		now := time.Now().UTC()
+		err = storage.StoreIdempotencyKey(ctx, userID, key, fingerprint, now, now.Add(-ttl))
+		switch {
+		case err == nil:
+			return serve(ctx, storage, req, handler, userID, key)
+		case errors.Is(err, storageErrors.ErrAlreadyExists):
+			return replay(ctx, storage, newResponse(), userID, key, fingerprint)
+		default:
+			log.Errorf(ctx, err, "failed to store the idempotency key")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+}
+
+// serve serves the call by the handler and stores the response
+func serve(ctx context.Context, storage storage.Idempotency, req any, handler grpc.UnaryHandler, userID uuid.UUID, key string) (res any, err error) {
+	// the key is released if the response isn't stored so the call can be retried.
+	// The storage is updated even if the client is gone
+	storageCtx := context.WithoutCancel(ctx)
+	stored := false
+	defer func() {
+		if stored {
+			return
+		}
+		if err := storage.DeleteIdempotencyKey(storageCtx, userID, key); err != nil {
+			log.Errorf(storageCtx, err, "failed to release the idempotency key")
+		}
+	}()
+
+	res, err = handler(ctx, req)
+	s := status.Convert(err)
+	if serverCodes[s.Code()] {
+		return res, err
+	}
+
+	var body []byte
+	if msg, ok := res.(proto.Message); ok && err == nil {
+		if body, err = proto.Marshal(msg); err != nil {
+			log.Errorf(storageCtx, err, "failed to encode the idempotent response")
+			return res, nil
+		}
+	}
+	header, encodeErr := json.Marshal(metadata.Pairs(
+		statusMetadata, strconv.Itoa(int(s.Code())),
+		messageMetadata, s.Message(),
+	))
+	if encodeErr != nil {
+		log.Errorf(storageCtx, encodeErr, "failed to encode the response header")
+		return res, err
+	}
+	if saveErr := storage.SaveIdempotentResponse(storageCtx, userID, key, callStatusCode, header, body); saveErr != nil {
+		log.Errorf(storageCtx, saveErr, "failed to store the idempotent response")
+		return res, err
+	}
+	stored = true
+	return res, err
+}
+
+// replay returns the stored response to the call with the key decoded to res
+func replay(ctx context.Context, storage storage.Idempotency, res proto.Message, userID uuid.UUID, key, fingerprint string) (any, error) {
+	stored, err := storage.RetrieveIdempotencyKey(ctx, userID, key)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		// the call has failed and released the key just now
+		_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterMetadata, "1"))
+		return nil, status.Error(codes.Aborted, "the call with the "+idempotencyKeyMetadata+" is in progress")
+	}
+	if err != nil {
+		log.Errorf(ctx, err, "failed to retrieve the idempotency key")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	switch {
+	case stored.Fingerprint != fingerprint:
+		return nil, status.Error(codes.InvalidArgument, "the "+idempotencyKeyMetadata+" is used for a different call")
+	case stored.StatusCode == 0:
+		_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterMetadata, "1"))
+		return nil, status.Error(codes.Aborted, "the call with the "+idempotencyKeyMetadata+" is in progress")
+	}
+
+	var header metadata.MD
+	if err = json.Unmarshal(stored.Header, &header); err != nil {
+		log.Errorf(ctx, err, "failed to decode the stored response header")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedMetadata, "true"))
+	code, _ := strconv.Atoi(firstValue(header, statusMetadata))
+	if code != int(codes.OK) {
+		return nil, status.Error(codes.Code(code), firstValue(header, messageMetadata))
+	}
+	if err = proto.Unmarshal(stored.Body, res); err != nil {
+		log.Errorf(ctx, err, "failed to decode the stored response")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return res, nil
+}
+
+// validIdempotencyKey reports whether the key is printable ASCII of at most [maxIdempotencyKeyLength]
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < ' ' || key[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// callFingerprint returns the hash of the call which identifies it among the calls with the same key
+func callFingerprint(fullMethod string, req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", errors.New("the request isn't a protobuf message")
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte("POST"))
+	h.Write([]byte{0})
+	h.Write([]byte(fullMethod))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// firstValue returns the first value of the metadata of the key or an empty string
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata of the rate limited calls. They are the headers of the HTTP API
+const (
+	rateLimitLimitMetadata     = "x-ratelimit-limit"
+	rateLimitRemainingMetadata = "x-ratelimit-remaining"
+	rateLimitResetMetadata     = "x-ratelimit-reset"
+	retryAfterMetadata         = "retry-after"
+)
+
+// RateLimiter takes the tokens of the requests from the buckets shared with the HTTP API
+type RateLimiter interface {
+	Take(ctx context.Context, method, path, remoteAddr string) (res ratelimit.Result, limited bool)
+}
+
+// RateLimit returns the interceptor which limits the rate of the calls by the limiter.
+// A call is the POST request to its full method name, e.g. "POST /balance.Balance/GetUserOrder", so the routes are limited by the same config.
+// A call which exceeds the limit is rejected with the ResourceExhausted code
+func RateLimit(limiter RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var remoteAddr string
+		if p, ok := peer.FromContext(ctx); ok {
+			remoteAddr = p.Addr.String()
+		}
+		res, limited := limiter.Take(ctx, "POST", info.FullMethod, remoteAddr)
+		if !limited {
+			return handler(ctx, req)
+		}
+
+		md := metadata.Pairs(
+			rateLimitLimitMetadata, strconv.Itoa(res.Limit),
+			rateLimitRemainingMetadata, strconv.Itoa(res.Remaining),
+			rateLimitResetMetadata, seconds(res.Reset),
+		)
+		if !res.Allowed {
+			md.Set(retryAfterMetadata, seconds(res.RetryAfter))
+			_ = grpc.SetHeader(ctx, md) // the header isn't sent if the stream is gone
+			return nil, status.Error(codes.ResourceExhausted, "too many requests")
+		}
+		_ = grpc.SetHeader(ctx, md)
+		return handler(ctx, req)
+	}
+}
+
+// seconds returns the duration as the whole number of the seconds rounded up
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
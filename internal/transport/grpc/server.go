@@ -0,0 +1,70 @@
+// Package grpc implements gRPC server for the gophermart API.
+// The JWT token is sent in the authorization metadata and the service errors are mapped to the gRPC codes by their names
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	balancepb "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/pb"
+	genBalanceGRPCSrv "github.com/oleshko-g/oggophermart/internal/gen/grpc/balance/server"
+	userpb "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/pb"
+	genUserGRPCSrv "github.com/oleshko-g/oggophermart/internal/gen/grpc/user/server"
+	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+	"github.com/oleshko-g/oggophermart/internal/service"
+	"goa.design/clue/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type Server interface {
+	ListenAndServe() error
+	// Shutdown stops the server once the pending RPCs are finished. The RPCs are cancelled when ctx is done
+	Shutdown(ctx context.Context)
+}
+
+type server struct {
+	*grpc.Server
+	address string
+}
+
+// NewServer returns the [Server] of the gophermart API. The server serves TLS by the tlsConfig if it isn't nil.
+// The messages larger than maxRecvMsgSize are rejected. The interceptors wrap the API handlers in their order
+func NewServer(loggingCtx context.Context, cfg Config, svc service.Service, tlsConfig *tls.Config, maxRecvMsgSize int, interceptors ...grpc.UnaryServerInterceptor) Server {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{log.UnaryServerInterceptor(loggingCtx)}, interceptors...)...),
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	srv := grpc.NewServer(opts...)
+
+	balancepb.RegisterBalanceServer(srv, genBalanceGRPCSrv.New(balance.NewEndpoints(svc.Balance), nil))
+	userpb.RegisterUserServer(srv, genUserGRPCSrv.New(user.NewEndpoints(svc.User), nil))
+
+	return &server{Server: srv, address: cfg.Address().String()}
+}
+
+func (s *server) ListenAndServe() error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	return s.Server.Serve(listener)
+}
+
+func (s *server) Shutdown(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		s.Server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.Server.Stop()
+	}
+}
@@ -0,0 +1,34 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	genAccrual "github.com/oleshko-g/oggophermart/internal/gen/accrual"
+	genAccrualHTTPSrv "github.com/oleshko-g/oggophermart/internal/gen/http/accrual/server"
+	"goa.design/clue/log"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// NewAccrualHandler returns the HTTP handler of the accrual system API
+func NewAccrualHandler(loggingCtx context.Context, svc genAccrual.Service) http.Handler {
+	mux := goahttp.NewMuxer()
+	accrualServer := genAccrualHTTPSrv.New(
+		genAccrual.NewEndpoints(svc), mux,
+		goahttp.RequestDecoder, goahttp.ResponseEncoder, errorHandler, nil,
+	)
+	accrualServer.Mount(mux)
+
+	loggingMiddleware := log.HTTP(loggingCtx)
+	return loggingMiddleware(mux)
+}
+
+// NewAccrualServer returns the HTTP server of the accrual system at the address
+func NewAccrualServer(loggingCtx context.Context, address string, svc genAccrual.Service) Server {
+	return &http.Server{
+		Addr:              address,
+		Handler:           NewAccrualHandler(loggingCtx, svc),
+		ReadHeaderTimeout: time.Second * 60,
+	}
+}
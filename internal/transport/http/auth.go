@@ -0,0 +1,26 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/oleshko-g/oggophermart/internal/service"
+)
+
+// Authentication returns the [Middleware] which authenticates the JWT token in the Authorization header once per request.
+// The result is kept in the request context for the other middlewares and the services
+func Authentication(auther service.Auther) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get("Authorization")
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if _, cred, ok := strings.Cut(token, " "); ok {
+				token = cred // removes the authorization scheme prefix, e.g. "Bearer"
+			}
+			next.ServeHTTP(w, r.WithContext(auther.Authenticate(r.Context(), token)))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/oleshko-g/oggophermart/internal/route"
+)
+
+// DefaultMaxBodySize limits the request bodies of the routes which have no limit of their own
+const DefaultMaxBodySize = 64 << 10
+
+// DefaultBodyLimitRoutes are the limits of the routes which accept the large bodies
+var DefaultBodyLimitRoutes = map[string]int64{
+	"POST /api/user/orders/batch": 4 << 20,
+}
+
+// errParsingBodyLimit indicates an invalid value of a [BodyLimitConfig] parameter
+var errParsingBodyLimit = errors.New("error parsing body limit config")
+
+// BodyLimitConfig contains the [flag.Value]s to set up the [BodyLimit] middleware. The zero value is the default config
+type BodyLimitConfig struct {
+	maxSize byteSize
+	routes  bodyLimitRoutes
+}
+
+// MaxSize returns a pointer to the [flag.Value] to set the limit of the routes which have no limit of their own
+func (c *BodyLimitConfig) MaxSize() *byteSize { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxSize
+}
+
+// Routes returns a pointer to the [flag.Value] to set the limits of the routes
+func (c *BodyLimitConfig) Routes() *bodyLimitRoutes { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.routes
+}
+
+// Limit returns the limit of the body of the request of the method to the path
+func (c *BodyLimitConfig) Limit(method, path string) int64 {
+	for _, r := range c.routes.Routes() {
+		if r.pattern.Match(method, path) {
+			return r.size
+		}
+	}
+	return c.maxSize.Bytes(DefaultMaxBodySize)
+}
+
+// bodyLimitRoute is the limit of the bodies of the requests matching the pattern
+type bodyLimitRoute struct {
+	pattern route.Pattern
+	size    int64
+}
+
+// bodyLimitRoutes are the limits of the routes. The first matching route limits a request
+type bodyLimitRoutes struct {
+	v   []bodyLimitRoute
+	set bool
+}
+
+func (r *bodyLimitRoutes) String() string {
+	var s []string
+	for _, rl := range r.Routes() {
+		size := byteSize(rl.size)
+		s = append(s, rl.pattern.String()+"="+size.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set parses s as the comma separated <METHOD> <path>=<size>, e.g. "POST /api/user/orders/batch=8MiB",
+// and sets it or returns an error. The [DefaultBodyLimitRoutes] are replaced
+func (r *bodyLimitRoutes) Set(s string) error {
+	var v []bodyLimitRoute
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		pattern, sizeValue, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf("%w: %q isn't <METHOD> <path>=<size>", errParsingBodyLimit, entry)
+		}
+		var size byteSize
+		if err := size.Set(sizeValue); err != nil {
+			return fmt.Errorf("%w: %w", errParsingBodyLimit, err)
+		}
+		p, err := route.Parse(pattern)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errParsingBodyLimit, err)
+		}
+		v = append(v, bodyLimitRoute{pattern: p, size: int64(size)})
+	}
+	r.v, r.set = v, true
+	return nil
+}
+
+// Routes returns the routes or the [DefaultBodyLimitRoutes] if they aren't set
+func (r *bodyLimitRoutes) Routes() []bodyLimitRoute {
+	if r.set {
+		return r.v
+	}
+	var v []bodyLimitRoute
+	for pattern, size := range DefaultBodyLimitRoutes {
+		p, _ := route.Parse(pattern) // the defaults are valid
+		v = append(v, bodyLimitRoute{pattern: p, size: size})
+	}
+	slices.SortFunc(v, func(a, b bodyLimitRoute) int {
+		return strings.Compare(a.pattern.String(), b.pattern.String())
+	})
+	return v
+}
+
+// BodyLimit returns the middleware which rejects the requests with the bodies over the limits of their routes
+// with 413 Request Entity Too Large. The limits are of the decompressed bodies if the middleware follows [Compression]
+func BodyLimit(cfg *BodyLimitConfig) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limit := cfg.Limit(r.Method, r.URL.Path)
+			if r.ContentLength > limit {
+				http.Error(w, "the request body is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// the body is read at once since the decoders of the handlers respond to any read error with 400 Bad Request
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+			var maxBytesErr *http.MaxBytesError
+			switch {
+			case errors.As(err, &maxBytesErr):
+				http.Error(w, "the request body is too large", http.StatusRequestEntityTooLarge)
+				return
+			case err != nil:
+				http.Error(w, "failed to read the request body", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Defaults of the [CompressionConfig] parameters
+const (
+	DefaultCompressionMinSize      = 1 << 10
+	DefaultMaxDecompressedBodySize = 10 << 20
+)
+
+// maxClientResponseSize limits the decompressed responses received by the [Client]
+const maxClientResponseSize = 10 << 20
+
+// CompressionConfig contains the [flag.Value]s to set up the [Compression] middleware. The zero value is the default config
+type CompressionConfig struct {
+	minSize             byteSize
+	maxDecompressedSize byteSize
+}
+
+// MinSize returns a pointer to the [flag.Value] to set the minimum size of the response body which is compressed
+func (c *CompressionConfig) MinSize() *byteSize { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.minSize
+}
+
+// MaxDecompressedSize returns a pointer to the [flag.Value] to set the maximum size of the decompressed request body
+func (c *CompressionConfig) MaxDecompressedSize() *byteSize { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxDecompressedSize
+}
+
+// Compression returns the middleware which compresses the responses by the gzip or deflate coding accepted by the client
+// if the response body is at least [CompressionConfig.MinSize].
+// The request bodies with the gzip or deflate Content-Encoding are decompressed up to [CompressionConfig.MaxDecompressedSize].
+// A larger request is rejected with 413 Request Entity Too Large and a request of another coding with 415 Unsupported Media Type
+func Compression(cfg *CompressionConfig) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !decompressRequest(w, r, cfg.maxDecompressedSize.Bytes(DefaultMaxDecompressedBodySize)) {
+				return
+			}
+
+			w.Header().Add("Vary", "Accept-Encoding")
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if encoding == "" || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &compressWriter{
+				ResponseWriter: w,
+				encoding:       encoding,
+				minSize:        int(cfg.minSize.Bytes(DefaultCompressionMinSize)),
+			}
+			defer cw.Close()
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// decompressRequest replaces the compressed body of the request by the decompressed one.
+// It responds with an error and returns false if the body can't be decompressed
+func decompressRequest(w http.ResponseWriter, r *http.Request, maxSize int64) bool {
+	var (
+		zr  io.ReadCloser
+		err error
+	)
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+	switch encoding {
+	case "", "identity":
+		return true
+	case "gzip", "x-gzip":
+		zr, err = gzip.NewReader(r.Body)
+	case "deflate":
+		zr, err = zlib.NewReader(r.Body) // the HTTP deflate coding is the zlib format
+	default:
+		w.Header().Set("Accept-Encoding", "gzip, deflate")
+		http.Error(w, "unsupported Content-Encoding "+encoding, http.StatusUnsupportedMediaType)
+		return false
+	}
+	if err != nil {
+		http.Error(w, "malformed "+encoding+" request body", http.StatusBadRequest)
+		return false
+	}
+	defer zr.Close()
+
+	// the body is read at once so a zip bomb is stopped before it reaches the handler
+	body, err := io.ReadAll(io.LimitReader(zr, maxSize+1))
+	if err != nil {
+		http.Error(w, "malformed "+encoding+" request body", http.StatusBadRequest)
+		return false
+	}
+	if int64(len(body)) > maxSize {
+		http.Error(w, "the decompressed request body is too large", http.StatusRequestEntityTooLarge)
+		return false
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.Header.Del("Content-Encoding")
+	r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return true
+}
+
+// negotiateEncoding returns the coding of the response accepted by the client with the highest weight.
+// gzip is preferred to deflate of the same weight. It returns "" if the client accepts neither
+func negotiateEncoding(acceptEncoding string) string {
+	weights := make(map[string]float64)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "" {
+			continue
+		}
+		weight := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if q, err := strconv.ParseFloat(v, 64); err == nil {
+				weight = q
+			}
+		}
+		weights[coding] = weight
+	}
+
+	best, bestWeight := "", 0.0
+	for _, coding := range []string{"gzip", "deflate"} {
+		weight, ok := weights[coding]
+		if !ok {
+			weight = weights["*"]
+		}
+		if weight > bestWeight {
+			best, bestWeight = coding, weight
+		}
+	}
+	return best
+}
+
+// encoder is the compressing writer of a coding
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// encoders are the pools of the encoders of the codings since an encoder allocates a lot
+var encoders = map[string]*sync.Pool{
+	"gzip":    {New: func() any { return gzip.NewWriter(nil) }},
+	"deflate": {New: func() any { return zlib.NewWriter(nil) }},
+}
+
+// incompressibleTypes are the prefixes of the content types which aren't compressed
+var incompressibleTypes = []string{
+	"text/event-stream", // the events are flushed one by one
+	"image/", "video/", "audio/",
+	"application/gzip", "application/zip", "application/zstd",
+}
+
+// compressWriter buffers the response up to the minimum size and compresses it if it's not smaller
+type compressWriter struct {
+	http.ResponseWriter
+	encoding   string
+	minSize    int
+	statusCode int
+	buf        []byte
+	decided    bool    // the header is written with or without the Content-Encoding
+	zw         encoder // nil if the response isn't compressed
+}
+
+func (cw *compressWriter) WriteHeader(statusCode int) {
+	if statusCode < http.StatusOK {
+		cw.ResponseWriter.WriteHeader(statusCode) // informational
+		return
+	}
+	if cw.statusCode != 0 {
+		return
+	}
+	cw.statusCode = statusCode
+	if !cw.compressible() {
+		_ = cw.decide(false) // the body isn't written yet
+	}
+}
+
+func (cw *compressWriter) Write(b []byte) (int, error) {
+	if cw.statusCode == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if !cw.decided {
+		cw.buf = append(cw.buf, b...)
+		if len(cw.buf) < cw.minSize {
+			return len(b), nil
+		}
+		if err := cw.decide(true); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if cw.zw != nil {
+		return cw.zw.Write(b)
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+// Flush writes the buffered response. The response which isn't compressed yet isn't compressed
+func (cw *compressWriter) Flush() {
+	if cw.statusCode == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if !cw.decided {
+		_ = cw.decide(false)
+	}
+	if cw.zw != nil {
+		_ = cw.zw.Flush()
+	}
+	_ = http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+// Close writes the rest of the response
+func (cw *compressWriter) Close() error {
+	if cw.statusCode == 0 {
+		return nil // nothing is written
+	}
+	if !cw.decided {
+		return cw.decide(false)
+	}
+	if cw.zw == nil {
+		return nil
+	}
+	err := cw.zw.Close()
+	encoders[cw.encoding].Put(cw.zw)
+	cw.zw = nil
+	return err
+}
+
+// Unwrap returns the wrapped writer for [http.ResponseController]
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// compressible reports whether the response can be compressed by its status and header
+func (cw *compressWriter) compressible() bool {
+	if cw.statusCode == http.StatusNoContent || cw.statusCode == http.StatusNotModified {
+		return false
+	}
+	h := cw.Header()
+	if h.Get("Content-Encoding") != "" {
+		return false
+	}
+	contentType := h.Get("Content-Type")
+	for _, prefix := range incompressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// decide writes the header and the buffered body compressed or not
+func (cw *compressWriter) decide(compress bool) error {
+	cw.decided = true
+	if compress && cw.compressible() {
+		cw.Header().Set("Content-Encoding", cw.encoding)
+		cw.Header().Del("Content-Length")
+		cw.zw = encoders[cw.encoding].Get().(encoder)
+		cw.zw.Reset(cw.ResponseWriter)
+	}
+	cw.ResponseWriter.WriteHeader(cw.statusCode)
+
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if cw.zw != nil {
+		_, err = cw.zw.Write(buf)
+	} else {
+		_, err = cw.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+// errResponseTooLarge means the response to the [Client] exceeds [maxClientResponseSize]
+var errResponseTooLarge = errors.New("the response body is too large")
+
+// limitedTransport limits the bodies of the responses.
+// The transport decompresses the gzip responses to the requests without the Accept-Encoding so the limit is of the decompressed bodies
+type limitedTransport struct {
+	http.RoundTripper
+}
+
+func (t limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.RoundTripper.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = &limitedBody{ReadCloser: res.Body, remaining: maxClientResponseSize}
+	return res, nil
+}
+
+// limitedBody returns [errResponseTooLarge] after the remaining bytes are read
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, errResponseTooLarge
+	}
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1] // a byte over the limit is read to tell the end of the body
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		n = int(b.remaining)
+		b.remaining = -1
+		return n, errResponseTooLarge
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
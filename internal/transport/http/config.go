@@ -3,8 +3,12 @@ package http //revive:disable-line:var-naming
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 )
 
 // errParsingAdress indicates an error while parsing an address URL for an instance of http server
@@ -13,7 +17,20 @@ var errParsingAdress = errors.New("error parsing address")
 // Config contains fields and [flag.Value]s to set up the [Server]
 type Config struct {
 	address        address
-	accrualAddress address
+	accrualAddress accrualAddress
+	tls            TLSConfig
+	accrualTLS     TLSConfig
+	compression    CompressionConfig
+	bodyLimit      BodyLimitConfig
+	docs           DocsConfig
+	metrics        MetricsConfig
+	timeouts       struct {
+		readHeader duration
+		read       duration
+		write      duration
+		idle       duration
+	}
+	maxHeaderBytes byteSize
 }
 
 // Address returns a pointer to the [flag.Value] to set up the [Server]
@@ -22,12 +39,68 @@ func (c *Config) Address() *address { // revive:disable-line:unexported-return p
 }
 
 // AccrualAddress returns a pointer to the [flag.Value] to set up the accrual [Client]
-func (c *Config) AccrualAddress() *address { // revive:disable-line:unexported-return provides the interface to the caller
+func (c *Config) AccrualAddress() *accrualAddress { // revive:disable-line:unexported-return provides the interface to the caller
 	return &c.accrualAddress
 }
 
+// TLS returns a pointer to the [TLSConfig] of the [Server]. The server serves HTTPS if the certificate is set
+func (c *Config) TLS() *TLSConfig {
+	return &c.tls
+}
+
+// AccrualTLS returns a pointer to the [TLSConfig] of the accrual [Client]. It's used if the accrual address has the https scheme
+func (c *Config) AccrualTLS() *TLSConfig {
+	return &c.accrualTLS
+}
+
+// Compression returns a pointer to the [CompressionConfig] of the [Server]
+func (c *Config) Compression() *CompressionConfig {
+	return &c.compression
+}
+
+// BodyLimit returns a pointer to the [BodyLimitConfig] of the [Server]
+func (c *Config) BodyLimit() *BodyLimitConfig {
+	return &c.bodyLimit
+}
+
+// Docs returns a pointer to the [DocsConfig] of the [Server]
+func (c *Config) Docs() *DocsConfig {
+	return &c.docs
+}
+
+// Metrics returns a pointer to the [MetricsConfig] of the metrics server
+func (c *Config) Metrics() *MetricsConfig {
+	return &c.metrics
+}
+
+// ReadHeaderTimeout returns a pointer to the [flag.Value] to set the time the [Server] reads the request headers for
+func (c *Config) ReadHeaderTimeout() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.timeouts.readHeader
+}
+
+// ReadTimeout returns a pointer to the [flag.Value] to set the time the [Server] reads the whole request for
+func (c *Config) ReadTimeout() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.timeouts.read
+}
+
+// WriteTimeout returns a pointer to the [flag.Value] to set the time the [Server] writes the response for
+func (c *Config) WriteTimeout() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.timeouts.write
+}
+
+// IdleTimeout returns a pointer to the [flag.Value] to set the time the [Server] keeps an idle connection for
+func (c *Config) IdleTimeout() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.timeouts.idle
+}
+
+// MaxHeaderBytes returns a pointer to the [flag.Value] to set the maximum size of the request headers
+func (c *Config) MaxHeaderBytes() *byteSize { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.maxHeaderBytes
+}
+
 // String returns [codings] elements separated by ", " as a single string
 type address struct {
+	Scheme string // http or https
 	Host   string
 	Port   string
 	Source string
@@ -60,6 +133,12 @@ func (a *address) Set(s string) error {
 		return err
 	}
 
+	switch a.Scheme = url.Scheme; a.Scheme {
+	case "http", "https":
+	default:
+		return fmt.Errorf("%w: %s %q", errParsingAdress, "unsupported scheme", a.Scheme)
+	}
+
 	if a.Host = url.Hostname(); url.Hostname() == "" {
 		return fmt.Errorf("%w: %s", errParsingAdress, "empty scheme")
 	}
@@ -71,6 +150,33 @@ func (a *address) Set(s string) error {
 	return nil
 }
 
+// accrualAddress is the [address] which is safe to be set while it is read by the running client
+type accrualAddress struct {
+	v atomic.Pointer[address]
+}
+
+func (a *accrualAddress) String() string {
+	return a.Load().String()
+}
+
+// Set validates a value of address and sets it or returns an error. The current address is kept upon an error
+func (a *accrualAddress) Set(s string) error {
+	next := a.Load()
+	if err := next.Set(s); err != nil {
+		return err
+	}
+	a.v.Store(&next)
+	return nil
+}
+
+// Load returns a copy of the current address
+func (a *accrualAddress) Load() address { // revive:disable-line:unexported-return provides the interface to the caller
+	if v := a.v.Load(); v != nil {
+		return *v
+	}
+	return address{}
+}
+
 type secret string
 
 func (sec secret) String() string {
@@ -81,3 +187,94 @@ func (sec *secret) Set(s string) error {
 	*sec = secret(s)
 	return nil
 }
+
+// errParsingSize indicates an invalid [byteSize]
+var errParsingSize = errors.New("error parsing size")
+
+// sizeUnits are the suffixes of the [byteSize] from the largest one
+var sizeUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"GiB", 1 << 30},
+	{"MiB", 1 << 20},
+	{"KiB", 1 << 10},
+	{"B", 1},
+}
+
+// byteSize is a positive number of bytes
+type byteSize int64
+
+func (b *byteSize) String() string {
+	if *b <= 0 {
+		return "default"
+	}
+	for _, u := range sizeUnits {
+		if int64(*b) >= u.bytes && int64(*b)%u.bytes == 0 {
+			return strconv.FormatInt(int64(*b)/u.bytes, 10) + u.suffix
+		}
+	}
+	return strconv.FormatInt(int64(*b), 10) + "B"
+}
+
+// Set parses s as a number of bytes with an optional suffix B, KiB, MiB or GiB, e.g. "512KiB", and sets it or returns an error
+func (b *byteSize) Set(s string) error {
+	number, unit := s, int64(1)
+	for _, u := range sizeUnits {
+		if v, ok := strings.CutSuffix(s, u.suffix); ok {
+			number, unit = v, u.bytes
+			break
+		}
+	}
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingSize, err)
+	}
+	if n <= 0 || n > math.MaxInt64/unit {
+		return fmt.Errorf("%w: %q isn't a positive size", errParsingSize, s)
+	}
+	*b = byteSize(n * unit)
+	return nil
+}
+
+// Bytes returns the size or the default if it isn't set
+func (b *byteSize) Bytes(defaultSize int64) int64 {
+	if *b > 0 {
+		return int64(*b)
+	}
+	return defaultSize
+}
+
+// errParsingDuration indicates an invalid [duration]
+var errParsingDuration = errors.New("error parsing duration")
+
+// duration is a positive [time.Duration]
+type duration time.Duration
+
+func (d *duration) String() string {
+	if *d <= 0 {
+		return "default"
+	}
+	return time.Duration(*d).String()
+}
+
+// Set parses s by [time.ParseDuration] and sets it or returns an error
+func (d *duration) Set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingDuration, err)
+	}
+	if v <= 0 {
+		return fmt.Errorf("%w: %s isn't positive", errParsingDuration, v)
+	}
+	*d = duration(v)
+	return nil
+}
+
+// Duration returns the duration or the default if it isn't set
+func (d *duration) Duration(defaultDuration time.Duration) time.Duration {
+	if *d > 0 {
+		return time.Duration(*d)
+	}
+	return defaultDuration
+}
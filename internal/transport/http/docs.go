@@ -0,0 +1,173 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// Paths of the API contract and its docs
+const (
+	OpenAPIJSONPath = "/api/openapi.json"
+	OpenAPIYAMLPath = "/api/openapi.yaml"
+	DocsPath        = "/api/docs"
+	DocsAssetsPath  = DocsPath + "/assets"
+)
+
+// DefaultDocsAssetsURL is the base URL of the Swagger UI assets of the docs UI.
+// The assets are embedded into the binary and served by the docs UI
+const DefaultDocsAssetsURL = DocsAssetsPath
+
+// docsAssets are swagger-ui.css and swagger-ui-bundle.js of the Swagger UI served by the docs UI
+//
+//go:embed swaggerui/swagger-ui.css swaggerui/swagger-ui-bundle.js
+var docsAssets embed.FS
+
+// errParsingDocs indicates an invalid value of a [DocsConfig] parameter
+var errParsingDocs = errors.New("error parsing docs config")
+
+// OpenAPISpec is the OpenAPI spec of the gophermart API generated by goa
+type OpenAPISpec struct {
+	JSON []byte
+	YAML []byte
+}
+
+// DocsConfig contains the spec and the [flag.Value]s to serve the API docs.
+// The spec is served if it's set, the docs UI is served if it's enabled as well
+type DocsConfig struct {
+	Spec      OpenAPISpec
+	ui        enabled
+	assetsURL assetsURL
+}
+
+// UI returns a pointer to the [flag.Value] to enable the docs UI
+func (c *DocsConfig) UI() *enabled { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.ui
+}
+
+// AssetsURL returns a pointer to the [flag.Value] to set the base URL of the Swagger UI assets of the docs UI
+func (c *DocsConfig) AssetsURL() *assetsURL { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.assetsURL
+}
+
+// enabled is a switch which is off by default
+type enabled bool
+
+func (e *enabled) String() string {
+	return strconv.FormatBool(bool(*e))
+}
+
+// Set parses s by [strconv.ParseBool] and sets it or returns an error
+func (e *enabled) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("%w: %q isn't a boolean", errParsingDocs, s)
+	}
+	*e = enabled(v)
+	return nil
+}
+
+// assetsURL is the base URL of swagger-ui-bundle.js and swagger-ui.css
+type assetsURL string
+
+func (u *assetsURL) String() string {
+	return u.URL()
+}
+
+// Set validates the absolute URL or the path and sets it or returns an error
+func (u *assetsURL) Set(s string) error {
+	v, err := url.Parse(s)
+	if err != nil || v.Path == "" && v.Host == "" || v.Scheme != "" && v.Scheme != "http" && v.Scheme != "https" {
+		return fmt.Errorf("%w: %q isn't an http(s) URL or a path", errParsingDocs, s)
+	}
+	*u = assetsURL(strings.TrimSuffix(s, "/"))
+	return nil
+}
+
+// URL returns the URL or the [DefaultDocsAssetsURL] if it isn't set
+func (u *assetsURL) URL() string {
+	if *u == "" {
+		return DefaultDocsAssetsURL
+	}
+	return string(*u)
+}
+
+// docsPage is the Swagger UI of the spec. The requests tried out by the UI are sent to the origin of the page
+var docsPage = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Gophermart API</title>
+<link rel="stylesheet" href="{{.AssetsURL}}/swagger-ui.css">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="{{.AssetsURL}}/swagger-ui-bundle.js"></script>
+<script>
+fetch({{.SpecPath}})
+	.then((res) => res.json())
+	.then((spec) => {
+		spec.servers = [{url: window.location.origin}];
+		SwaggerUIBundle({spec: spec, dom_id: "#swagger-ui"});
+	});
+</script>
+</body>
+</html>
+`))
+
+// mountDocs mounts the handlers of the spec and the docs UI onto the mux by the config
+func mountDocs(mux goahttp.Muxer, cfg *DocsConfig) error {
+	if len(cfg.Spec.JSON) == 0 || len(cfg.Spec.YAML) == 0 {
+		return nil
+	}
+	mux.Handle(http.MethodGet, OpenAPIJSONPath, serveContent(cfg.Spec.JSON, "application/json"))
+	mux.Handle(http.MethodGet, OpenAPIYAMLPath, serveContent(cfg.Spec.YAML, "application/yaml"))
+	if !cfg.ui {
+		return nil
+	}
+
+	for name, contentType := range map[string]string{
+		"swagger-ui.css":       "text/css; charset=utf-8",
+		"swagger-ui-bundle.js": "text/javascript; charset=utf-8",
+	} {
+		content, err := docsAssets.ReadFile("swaggerui/" + name)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errParsingDocs, err)
+		}
+		mux.Handle(http.MethodGet, DocsAssetsPath+"/"+name, serveContent(content, contentType))
+	}
+
+	var page bytes.Buffer
+	err := docsPage.Execute(&page, struct{ AssetsURL, SpecPath string }{
+		AssetsURL: cfg.assetsURL.URL(),
+		SpecPath:  OpenAPIJSONPath,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errParsingDocs, err)
+	}
+	mux.Handle(http.MethodGet, DocsPath, serveContent(page.Bytes(), "text/html; charset=utf-8"))
+	return nil
+}
+
+// serveContent returns the handler of the content which is validated by its ETag since it's the same until the restart
+func serveContent(content []byte, contentType string) http.HandlerFunc {
+	sum := sha256.Sum256(content)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "no-cache")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}
+}
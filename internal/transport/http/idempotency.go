@@ -0,0 +1,294 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oleshko-g/oggophermart/internal/route"
+	"github.com/oleshko-g/oggophermart/internal/service"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	storageErrors "github.com/oleshko-g/oggophermart/internal/storage/errors"
+	"goa.design/clue/log"
+)
+
+// Headers of the idempotent requests
+const (
+	// IdempotencyKeyHeader is the header of the key which makes retrying a request safe
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks the stored response which is replayed
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+const (
+	// IdempotencyKeyTTL is the time an idempotency key and the response are stored for
+	IdempotencyKeyTTL = 24 * time.Hour
+	// idempotencyPurgeInterval is the interval between the deletions of the expired keys
+	idempotencyPurgeInterval = time.Hour
+	// maxIdempotencyKeyLength is the maximum length of an idempotency key
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize limits the requests and the responses which are stored
+	maxIdempotentBodySize = 4 << 20
+)
+
+// idempotentRoutes are the patterns of the mutating routes which accept the idempotency key
+var idempotentRoutes = mustParseRoutes(
+	"POST /api/user/orders",
+	"POST /api/user/orders/batch",
+	"POST /api/user/balance/withdraw",
+	"POST /api/user/webhooks",
+	"POST /api/user/webhooks/{id}/deliveries/{delivery_id}/redeliver",
+	"POST /api/admin/users/{user_id}/adjustments",
+)
+
+// mustParseRoutes parses the patterns of the routes or panics
+func mustParseRoutes(patterns ...string) []route.Pattern {
+	routes := make([]route.Pattern, 0, len(patterns))
+	for _, s := range patterns {
+		p, err := route.Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		routes = append(routes, p)
+	}
+	return routes
+}
+
+// idempotentRoute reports whether the request of the method to the path matches an [idempotentRoutes] pattern
+func idempotentRoute(method, path string) bool {
+	return slices.ContainsFunc(idempotentRoutes, func(p route.Pattern) bool {
+		return p.Match(method, path)
+	})
+}
+
+// Idempotency is the middleware which stores the response to a request with the [IdempotencyKeyHeader]
+// and replays it to the retries of the request by the same user.
+// A request with the key which is reused for a different request is rejected with 422 Unprocessable Entity.
+// A retry of the request which is still in progress is rejected with 409 Conflict.
+// The responses with the 5xx status codes aren't stored so the request can be retried
+type Idempotency struct {
+	storage storage.Idempotency
+	auther  service.Auther
+}
+
+// NewIdempotency returns the [Idempotency] middleware storing the keys in the storage.
+// The users are authenticated by the auther
+func NewIdempotency(storage storage.Idempotency, auther service.Auther) *Idempotency {
+	return &Idempotency{
+		storage: storage,
+		auther:  auther,
+	}
+}
+
+// Run deletes the expired keys every [idempotencyPurgeInterval] until ctx is done
+func (i *Idempotency) Run(ctx context.Context) error {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		deleted, err := i.storage.DeleteExpiredIdempotencyKeys(ctx, time.Now().UTC().Add(-IdempotencyKeyTTL))
+		if err != nil && ctx.Err() == nil {
+			log.Errorf(ctx, err, "failed to delete the expired idempotency keys")
+			continue
+		}
+		log.Debugf(ctx, "deleted %d expired idempotency keys", deleted)
+	}
+}
+
+// Handler wraps the next handler of the [idempotentRoutes]
+func (i *Idempotency) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || !idempotentRoute(r.Method, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !validIdempotencyKey(key) {
+			http.Error(w, "invalid "+IdempotencyKeyHeader, http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+		userID, err := i.auther.UserIDFromContext(ctx)
+		if err != nil {
+			next.ServeHTTP(w, r) // the service rejects the request
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBodySize+1))
+		if err != nil {
+			http.Error(w, "failed to read the request body", http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxIdempotentBodySize {
+			http.Error(w, "the request body is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		fingerprint := requestFingerprint(r, body)
+
+		now := time.Now().UTC()
+		err = i.storage.StoreIdempotencyKey(ctx, userID, key, fingerprint, now, now.Add(-IdempotencyKeyTTL))
+		switch {
+		case err == nil:
+			i.serve(w, r, next, userID, key)
+		case errors.Is(err, storageErrors.ErrAlreadyExists):
+			i.replay(w, r, userID, key, fingerprint)
+		default:
+			log.Errorf(ctx, err, "failed to store the idempotency key")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+	})
+}
+
+// serve serves the request by the next handler and stores the response
+func (i *Idempotency) serve(w http.ResponseWriter, r *http.Request, next http.Handler, userID uuid.UUID, key string) {
+	// the key is released if the response isn't stored so the request can be retried.
+	// The storage is updated even if the client is gone
+	ctx := context.WithoutCancel(r.Context())
+	stored := false
+	defer func() {
+		if stored {
+			return
+		}
+		if err := i.storage.DeleteIdempotencyKey(ctx, userID, key); err != nil {
+			log.Errorf(ctx, err, "failed to release the idempotency key")
+		}
+	}()
+
+	rec := &responseRecorder{ResponseWriter: w}
+	next.ServeHTTP(rec, r)
+	if rec.statusCode == 0 {
+		rec.statusCode = http.StatusOK // nothing is written
+	}
+	if rec.statusCode >= http.StatusInternalServerError || rec.overflow {
+		return
+	}
+
+	header, err := json.Marshal(rec.header)
+	if err != nil {
+		log.Errorf(ctx, err, "failed to encode the response header")
+		return
+	}
+	err = i.storage.SaveIdempotentResponse(ctx, userID, key, rec.statusCode, header, rec.body.Bytes())
+	if err != nil {
+		log.Errorf(ctx, err, "failed to store the idempotent response")
+		return
+	}
+	stored = true
+}
+
+// replay writes the stored response to the request with the key
+func (i *Idempotency) replay(w http.ResponseWriter, r *http.Request, userID uuid.UUID, key, fingerprint string) {
+	ctx := r.Context()
+	stored, err := i.storage.RetrieveIdempotencyKey(ctx, userID, key)
+	if errors.Is(err, storageErrors.ErrNotFound) {
+		// the request has failed and released the key just now
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "the request with the "+IdempotencyKeyHeader+" is in progress", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Errorf(ctx, err, "failed to retrieve the idempotency key")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	switch {
+	case stored.Fingerprint != fingerprint:
+		http.Error(w, "the "+IdempotencyKeyHeader+" is used for a different request", http.StatusUnprocessableEntity)
+		return
+	case stored.StatusCode == 0:
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "the request with the "+IdempotencyKeyHeader+" is in progress", http.StatusConflict)
+		return
+	}
+
+	var header http.Header
+	if err = json.Unmarshal(stored.Header, &header); err != nil {
+		log.Errorf(ctx, err, "failed to decode the stored response header")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	for k, v := range header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(stored.StatusCode)
+	if _, err = w.Write(stored.Body); err != nil {
+		log.Errorf(ctx, err, "failed to replay the idempotent response")
+	}
+}
+
+// validIdempotencyKey reports whether the key is printable ASCII of at most [maxIdempotencyKeyLength]
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < ' ' || key[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestFingerprint returns the hash of the request which identifies it among the requests with the same key
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	for _, part := range []string{r.Method, r.URL.Path, r.URL.RawQuery, r.Header.Get("Content-Type")} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder writes the response and records it up to [maxIdempotentBodySize]
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	header     http.Header
+	body       bytes.Buffer
+	overflow   bool // the body exceeds the limit and isn't recorded
+}
+
+func (rec *responseRecorder) WriteHeader(statusCode int) {
+	if rec.statusCode == 0 {
+		rec.statusCode = statusCode
+		rec.header = rec.ResponseWriter.Header().Clone()
+	}
+	rec.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	if rec.statusCode == 0 {
+		rec.WriteHeader(http.StatusOK)
+	}
+	if !rec.overflow {
+		if rec.body.Len()+len(b) > maxIdempotentBodySize {
+			rec.overflow = true
+			rec.body.Reset()
+		} else {
+			rec.body.Write(b)
+		}
+	}
+	return rec.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped writer for [http.ResponseController]
+func (rec *responseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,72 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// MetricsPath is the path of the gophermart [Metrics] served by the metrics server
+const MetricsPath = "/debug/vars"
+
+// Metrics are the gophermart metrics served by the metrics server.
+// Unlike the vars published by [expvar] they don't expose the command line with the secrets and the memory stats
+var Metrics = new(expvar.Map)
+
+// errMetricsAddress indicates the metrics address which isn't on the loopback interface
+var errMetricsAddress = errors.New("the metrics are served on the loopback interface only")
+
+// MetricsConfig contains the [flag.Value] to set up the metrics server. The zero value disables the server
+type MetricsConfig struct {
+	address loopbackAddress
+}
+
+// Address returns a pointer to the [flag.Value] to set the address the metrics server listens on
+func (c *MetricsConfig) Address() *loopbackAddress { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.address
+}
+
+// Enabled reports whether the metrics server is set up
+func (c *MetricsConfig) Enabled() bool {
+	return c.address != ""
+}
+
+// loopbackAddress is the host:port on the loopback interface. It's empty if the server is disabled
+type loopbackAddress string
+
+func (a *loopbackAddress) String() string {
+	return string(*a)
+}
+
+// Set validates the host:port on the loopback interface and sets it or returns an error
+func (a *loopbackAddress) Set(s string) error {
+	if s == "" {
+		*a = ""
+		return nil
+	}
+	host, port, err := net.SplitHostPort(s)
+	if err != nil || port == "" {
+		return fmt.Errorf("%w: %q isn't host:port", errParsingAdress, s)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return fmt.Errorf("%w: %q", errMetricsAddress, s)
+	}
+	*a = loopbackAddress(s)
+	return nil
+}
+
+// NewMetricsServer returns the [Server] of the gophermart [Metrics] which listens on the loopback address of the cfg
+func NewMetricsServer(cfg *MetricsConfig) Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(http.MethodGet+" "+MetricsPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprint(w, Metrics.String())
+	})
+	return &http.Server{
+		Addr:              cfg.Address().String(),
+		Handler:           mux,
+		ReadHeaderTimeout: DefaultReadHeaderTimeout,
+	}
+}
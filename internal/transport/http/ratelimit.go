@@ -0,0 +1,124 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/oleshko-g/oggophermart/internal/ratelimit"
+	"github.com/oleshko-g/oggophermart/internal/service"
+	"github.com/oleshko-g/oggophermart/internal/storage"
+	"goa.design/clue/log"
+)
+
+// Headers of the rate limited responses
+const (
+	// RateLimitLimitHeader is the burst of the limit of the route
+	RateLimitLimitHeader = "X-RateLimit-Limit"
+	// RateLimitRemainingHeader is the number of the requests which can be sent at once
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	// RateLimitResetHeader is the number of the seconds until the limit is restored completely
+	RateLimitResetHeader = "X-RateLimit-Reset"
+)
+
+// rateLimitPurgeInterval is the interval between the deletions of the full buckets
+const rateLimitPurgeInterval = time.Minute
+
+// RateLimiter is the middleware which limits the rate of the requests by the token buckets.
+// The requests of an authenticated user share the bucket of the user and the other requests share the bucket of the client IP.
+// Every route limited by its own limit has its own bucket.
+// A request which exceeds the limit is rejected with 429 Too Many Requests
+type RateLimiter struct {
+	storage storage.RateLimit
+	auther  service.Auther
+	cfg     *ratelimit.Config
+}
+
+// NewRateLimiter returns the [RateLimiter] middleware keeping the buckets in the storage.
+// The users are authenticated by the auther
+func NewRateLimiter(storage storage.RateLimit, auther service.Auther, cfg *ratelimit.Config) *RateLimiter {
+	return &RateLimiter{
+		storage: storage,
+		auther:  auther,
+		cfg:     cfg,
+	}
+}
+
+// Run deletes the full buckets every [rateLimitPurgeInterval] until ctx is done
+func (rl *RateLimiter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(rateLimitPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		deleted, err := rl.storage.DeleteFullRateLimitBuckets(ctx, time.Now().UTC())
+		if err != nil && ctx.Err() == nil {
+			log.Errorf(ctx, err, "failed to delete the full rate limit buckets")
+			continue
+		}
+		log.Debugf(ctx, "deleted %d full rate limit buckets", deleted)
+	}
+}
+
+// Handler wraps the next handler
+func (rl *RateLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, limited := rl.Take(r.Context(), r.Method, r.URL.Path, r.RemoteAddr)
+		if !limited {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(RateLimitLimitHeader, strconv.Itoa(res.Limit))
+		w.Header().Set(RateLimitRemainingHeader, strconv.Itoa(res.Remaining))
+		w.Header().Set(RateLimitResetHeader, seconds(res.Reset))
+		if !res.Allowed {
+			w.Header().Set("Retry-After", seconds(res.RetryAfter))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Take takes the token of the request of the method to the path sent from the remote address.
+// The requests of the other transports share the buckets by their method and path, e.g. the gRPC calls are POST requests to the full method name.
+// limited is false if the route is unlimited or the token can't be taken, the request isn't rejected then
+func (rl *RateLimiter) Take(ctx context.Context, method, path, remoteAddr string) (res ratelimit.Result, limited bool) {
+	route, limit := rl.cfg.RouteLimit(method, path)
+	if limit.Unlimited() {
+		return ratelimit.Result{}, false
+	}
+
+	key := rl.clientKey(ctx, remoteAddr) + " " + route
+	res, err := rl.storage.TakeRateLimitToken(ctx, key, limit, time.Now().UTC())
+	if err != nil {
+		// the requests aren't rejected because of the storage
+		log.Errorf(ctx, err, "failed to take the rate limit token")
+		return ratelimit.Result{}, false
+	}
+	return res, true
+}
+
+// clientKey returns the key of the user who sent the request or of the client IP if the request isn't authenticated
+func (rl *RateLimiter) clientKey(ctx context.Context, remoteAddr string) string {
+	if userID, err := rl.auther.UserIDFromContext(ctx); err == nil {
+		return "user:" + userID.String()
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return "ip:" + host
+}
+
+// seconds returns the duration as the whole number of the seconds rounded up
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
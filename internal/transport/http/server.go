@@ -5,20 +5,37 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"time"
 
+	admin "github.com/oleshko-g/oggophermart/internal/gen/admin"
 	balance "github.com/oleshko-g/oggophermart/internal/gen/balance"
+	genAdminHTTPSrv "github.com/oleshko-g/oggophermart/internal/gen/http/admin/server"
 	genBalanceHTTPSrv "github.com/oleshko-g/oggophermart/internal/gen/http/balance/server"
 	genUserHTTPSvr "github.com/oleshko-g/oggophermart/internal/gen/http/user/server"
+	genWebhookHTTPSrv "github.com/oleshko-g/oggophermart/internal/gen/http/webhook/server"
 	user "github.com/oleshko-g/oggophermart/internal/gen/user"
+	webhook "github.com/oleshko-g/oggophermart/internal/gen/webhook"
 	"github.com/oleshko-g/oggophermart/internal/service"
 	"goa.design/clue/log"
 	goahttp "goa.design/goa/v3/http"
 )
 
+// Defaults of the [Server] timeouts and limits
+const (
+	DefaultReadHeaderTimeout = 10 * time.Second
+	DefaultReadTimeout       = 30 * time.Second
+	DefaultWriteTimeout      = 60 * time.Second
+	DefaultIdleTimeout       = 2 * time.Minute
+	DefaultMaxHeaderBytes    = 64 << 10
+)
+
 type Server interface {
 	ListenAndServe() error
+	Serve(l net.Listener) error
+	Shutdown(ctx context.Context) error
+	Close() error
 }
 
 type server struct {
@@ -28,7 +45,10 @@ type server struct {
 	Server
 }
 
-func newHandlers(loggingCtx context.Context, balanceEndpoints *balance.Endpoints, userEndpoints *user.Endpoints) http.Handler {
+// Middleware wraps the handler of the gophermart API
+type Middleware = func(http.Handler) http.Handler
+
+func newHandlers(loggingCtx context.Context, balanceEndpoints *balance.Endpoints, userEndpoints *user.Endpoints, webhookEndpoints *webhook.Endpoints, adminEndpoints *admin.Endpoints, docs *DocsConfig, middlewares []Middleware) (http.Handler, error) {
 	var (
 		reqDecoder func(r *http.Request) goahttp.Decoder
 		resEncoder func(ctx context.Context, res http.ResponseWriter) goahttp.Encoder
@@ -45,36 +65,80 @@ func newHandlers(loggingCtx context.Context, balanceEndpoints *balance.Endpoints
 	// create HTTP servers
 	balanceServer := genBalanceHTTPSrv.New(balanceEndpoints, mux, reqDecoder, resEncoder, errHandler, nil)
 	userServer := genUserHTTPSvr.New(userEndpoints, mux, reqDecoder, resEncoder, errHandler, nil)
+	webhookServer := genWebhookHTTPSrv.New(webhookEndpoints, mux, reqDecoder, resEncoder, errHandler, nil)
+	adminServer := genAdminHTTPSrv.New(adminEndpoints, mux, reqDecoder, resEncoder, errHandler, nil)
 
 	// mount HTTP endpoint onto mux
 	balanceServer.Mount(mux)
 	userServer.Mount(mux)
+	webhookServer.Mount(mux)
+	adminServer.Mount(mux)
+	if err := mountDocs(mux, docs); err != nil {
+		return nil, err
+	}
 
-	loggingMiddleware := log.HTTP(loggingCtx)
-	var handlers = loggingMiddleware(mux)
+	var handlers http.Handler = mux
+	// the first middleware is the outermost one
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handlers = middlewares[i](handlers)
+	}
 
-	return handlers
+	loggingMiddleware := log.HTTP(loggingCtx)
+	handlers = loggingMiddleware(handlers)
 
+	return withoutStreamDeadlines(handlers), nil
 }
 
-func NewServer(loggingCtx context.Context, cfg Config, svc service.Service) Server {
+// NewServer returns the [Server] of the gophermart API. The middlewares wrap the API handlers in their order.
+// The server serves HTTPS with the certs if they aren't nil
+func NewServer(loggingCtx context.Context, cfg *Config, svc service.Service, certs *Certificates, middlewares ...Middleware) (Server, error) {
 	var (
 		balanceEndpoints *balance.Endpoints
 		userEndpoints    *user.Endpoints
+		webhookEndpoints *webhook.Endpoints
+		adminEndpoints   *admin.Endpoints
 		handlers         http.Handler
+		err              error
 	)
 	{
 		balanceEndpoints = balance.NewEndpoints(svc.Balance)
 		userEndpoints = user.NewEndpoints(svc.User)
-		handlers = newHandlers(loggingCtx, balanceEndpoints, userEndpoints)
+		webhookEndpoints = webhook.NewEndpoints(svc.Webhook)
+		adminEndpoints = admin.NewEndpoints(svc.Admin)
+		handlers, err = newHandlers(loggingCtx, balanceEndpoints, userEndpoints, webhookEndpoints, adminEndpoints, cfg.Docs(), middlewares)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return &server{
-		Server: &http.Server{
-			Addr:              cfg.Address().String(),
-			Handler:           handlers,
-			ReadHeaderTimeout: time.Second * 60},
+	srv := &http.Server{
+		Addr:              cfg.Address().String(),
+		Handler:           handlers,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout().Duration(DefaultReadHeaderTimeout),
+		ReadTimeout:       cfg.ReadTimeout().Duration(DefaultReadTimeout),
+		WriteTimeout:      cfg.WriteTimeout().Duration(DefaultWriteTimeout),
+		IdleTimeout:       cfg.IdleTimeout().Duration(DefaultIdleTimeout),
+		MaxHeaderBytes:    int(cfg.MaxHeaderBytes().Bytes(DefaultMaxHeaderBytes)),
 	}
+	if certs == nil {
+		return &server{Server: srv}, nil
+	}
+
+	srv.TLSConfig = certs.ServerConfig()
+	return &server{Server: tlsServer{srv}}, nil
+}
+
+// tlsServer serves HTTPS by the certificates of its TLS config
+type tlsServer struct {
+	*http.Server
+}
+
+func (s tlsServer) ListenAndServe() error {
+	return s.Server.ListenAndServeTLS("", "") // the certificate is got from the TLS config
+}
+
+func (s tlsServer) Serve(l net.Listener) error {
+	return s.Server.ServeTLS(l, "", "") // the certificate is got from the TLS config
 }
 
 // errorHandler is the handler which is called when ther was an HTTP response encoding error
@@ -102,3 +166,13 @@ func errorHandler(ctx context.Context, res http.ResponseWriter, err error) {
 var errResponseWithError = errors.New("failed to response with error")
 
 type Client = http.Client
+
+// NewClient returns the [Client] which connects to the HTTPS servers by the TLS config of the certs if they aren't nil.
+// The response bodies are limited by [maxClientResponseSize]
+func NewClient(certs *Certificates) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if certs != nil {
+		transport.TLSClientConfig = certs.ClientConfig()
+	}
+	return &Client{Transport: limitedTransport{transport}}
+}
@@ -0,0 +1,22 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"net/http"
+	"time"
+)
+
+// OrderEventsPath is the path of the stream of the order events which stays open until the client leaves
+const OrderEventsPath = "/api/user/orders/events"
+
+// withoutStreamDeadlines lifts the read and write timeouts of the server off the streams.
+// It wraps the logging middleware since the writer of the logger doesn't unwrap to the connection
+func withoutStreamDeadlines(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == OrderEventsPath {
+			rc := http.NewResponseController(w)
+			_ = rc.SetReadDeadline(time.Time{}) // the stream has no body
+			_ = rc.SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
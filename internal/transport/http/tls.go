@@ -0,0 +1,349 @@
+package http //revive:disable-line:var-naming
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"goa.design/clue/log"
+)
+
+// DefaultTLSReloadInterval is the default interval between the checks of the certificate files for changes
+const DefaultTLSReloadInterval = 10 * time.Second
+
+// errParsingTLSConfig indicates an invalid value of a [TLSConfig] parameter
+var errParsingTLSConfig = errors.New("error parsing TLS config")
+
+// TLSConfig contains the [flag.Value]s to set up TLS of the [Server] or of the accrual [Client].
+// The zero value is TLS 1.2 with the modern cipher policy and without the certificates
+type TLSConfig struct {
+	certFile       filePath
+	keyFile        filePath
+	caFile         filePath
+	minVersion     tlsVersion
+	cipherPolicy   cipherPolicy
+	clientAuth     clientAuth
+	reloadInterval duration
+}
+
+// CertFile returns a pointer to the [flag.Value] to set the path of the PEM certificate chain
+func (c *TLSConfig) CertFile() *filePath { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.certFile
+}
+
+// KeyFile returns a pointer to the [flag.Value] to set the path of the PEM private key of the certificate
+func (c *TLSConfig) KeyFile() *filePath { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.keyFile
+}
+
+// CAFile returns a pointer to the [flag.Value] to set the path of the PEM certificates of the trusted CAs.
+// The [Server] verifies the client certificates by them and the [Client] verifies the server certificates
+func (c *TLSConfig) CAFile() *filePath { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.caFile
+}
+
+// MinVersion returns a pointer to the [flag.Value] to set the minimum TLS version: 1.2 or 1.3
+func (c *TLSConfig) MinVersion() *tlsVersion { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.minVersion
+}
+
+// CipherPolicy returns a pointer to the [flag.Value] to set the TLS 1.2 cipher suites: modern or compatible.
+// The TLS 1.3 cipher suites aren't configurable
+func (c *TLSConfig) CipherPolicy() *cipherPolicy { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.cipherPolicy
+}
+
+// ClientAuth returns a pointer to the [flag.Value] to set the verification of the client certificates
+// by the [Server] with the [TLSConfig.CAFile]: require or optional
+func (c *TLSConfig) ClientAuth() *clientAuth { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.clientAuth
+}
+
+// ReloadInterval returns a pointer to the [flag.Value] to set the interval between the checks of the files for changes
+func (c *TLSConfig) ReloadInterval() *duration { // revive:disable-line:unexported-return provides the interface to the caller
+	return &c.reloadInterval
+}
+
+// HasCertificate reports whether the certificate and its key are set
+func (c *TLSConfig) HasCertificate() bool {
+	return c.certFile != "" && c.keyFile != ""
+}
+
+// filePath is the path of a file
+type filePath string
+
+func (p *filePath) String() string {
+	return string(*p)
+}
+
+// Set sets the path of the file which exists or returns an error
+func (p *filePath) Set(s string) error {
+	if s != "" {
+		if _, err := os.Stat(s); err != nil {
+			return fmt.Errorf("%w: %w", errParsingTLSConfig, err)
+		}
+	}
+	*p = filePath(s)
+	return nil
+}
+
+// tlsVersion is the minimum TLS version
+type tlsVersion uint16
+
+func (v *tlsVersion) String() string {
+	if v.Version() == tls.VersionTLS13 {
+		return "1.3"
+	}
+	return "1.2"
+}
+
+// Set parses s as 1.2 or 1.3 and sets it or returns an error
+func (v *tlsVersion) Set(s string) error {
+	switch s {
+	case "1.2":
+		*v = tls.VersionTLS12
+	case "1.3":
+		*v = tls.VersionTLS13
+	default:
+		return fmt.Errorf("%w: unsupported TLS version %q", errParsingTLSConfig, s)
+	}
+	return nil
+}
+
+// Version returns the version or TLS 1.2 if it isn't set
+func (v *tlsVersion) Version() uint16 {
+	if *v == 0 {
+		return tls.VersionTLS12
+	}
+	return uint16(*v)
+}
+
+// Cipher policies of TLS 1.2
+const (
+	CipherPolicyModern     = "modern"     // the forward secret AEAD cipher suites only
+	CipherPolicyCompatible = "compatible" // all the cipher suites without the known security issues
+)
+
+// cipherPolicy is the name of the set of the TLS 1.2 cipher suites
+type cipherPolicy string
+
+func (p *cipherPolicy) String() string {
+	if *p == "" {
+		return CipherPolicyModern
+	}
+	return string(*p)
+}
+
+// Set validates the name of the policy and sets it or returns an error
+func (p *cipherPolicy) Set(s string) error {
+	switch s {
+	case CipherPolicyModern, CipherPolicyCompatible:
+		*p = cipherPolicy(s)
+		return nil
+	}
+	return fmt.Errorf("%w: unsupported cipher policy %q", errParsingTLSConfig, s)
+}
+
+// CipherSuites returns the IDs of the cipher suites of the policy
+func (p *cipherPolicy) CipherSuites() []uint16 {
+	var ids []uint16
+	for _, suite := range tls.CipherSuites() {
+		if p.String() == CipherPolicyModern && !modernCipherSuite(suite.ID) {
+			continue
+		}
+		ids = append(ids, suite.ID)
+	}
+	return ids
+}
+
+// modernCipherSuite reports whether the TLS 1.2 cipher suite has the ECDHE key exchange and the AEAD cipher
+func modernCipherSuite(id uint16) bool {
+	return slices.Contains([]uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+	}, id)
+}
+
+// Verifications of the client certificates
+const (
+	ClientAuthRequire  = "require"  // the clients without a valid certificate are rejected
+	ClientAuthOptional = "optional" // the certificate is verified if the client sends it
+)
+
+// clientAuth is the verification of the client certificates if the CAs are set
+type clientAuth string
+
+func (a *clientAuth) String() string {
+	if *a == "" {
+		return ClientAuthRequire
+	}
+	return string(*a)
+}
+
+// Set validates the verification and sets it or returns an error
+func (a *clientAuth) Set(s string) error {
+	switch s {
+	case ClientAuthRequire, ClientAuthOptional:
+		*a = clientAuth(s)
+		return nil
+	}
+	return fmt.Errorf("%w: unsupported client auth %q", errParsingTLSConfig, s)
+}
+
+// Certificates are the certificate and the CAs of the [TLSConfig] which are reloaded when their files change.
+// The TLS connections established after a reload use the new certificates
+type Certificates struct {
+	cfg     *TLSConfig
+	current atomic.Pointer[certificates]
+}
+
+// certificates are the certificates loaded from the files at the time of the modification of the files
+type certificates struct {
+	cert    *tls.Certificate // nil if the certificate isn't set
+	pool    *x509.CertPool   // nil if the CAs aren't set
+	modTime map[string]time.Time
+}
+
+// NewCertificates loads the files of the cfg and returns the [Certificates]
+func NewCertificates(cfg *TLSConfig) (*Certificates, error) {
+	c := &Certificates{cfg: cfg}
+	loaded, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+	c.current.Store(loaded)
+	return c, nil
+}
+
+// files returns the paths of the files which are set
+func (c *Certificates) files() []string {
+	var files []string
+	for _, p := range []filePath{c.cfg.certFile, c.cfg.keyFile, c.cfg.caFile} {
+		if p != "" {
+			files = append(files, p.String())
+		}
+	}
+	return files
+}
+
+// load reads the files
+func (c *Certificates) load() (*certificates, error) {
+	loaded := &certificates{modTime: make(map[string]time.Time)}
+	for _, file := range c.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		loaded.modTime[file] = info.ModTime()
+	}
+
+	if c.cfg.HasCertificate() {
+		cert, err := tls.LoadX509KeyPair(c.cfg.certFile.String(), c.cfg.keyFile.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the TLS certificate %s: %w", c.cfg.certFile, err)
+		}
+		loaded.cert = &cert
+	}
+
+	if c.cfg.caFile != "" {
+		pem, err := os.ReadFile(c.cfg.caFile.String())
+		if err != nil {
+			return nil, err
+		}
+		loaded.pool = x509.NewCertPool()
+		if !loaded.pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no CA certificates in %s", c.cfg.caFile)
+		}
+	}
+	return loaded, nil
+}
+
+// changed reports whether any of the files is modified after it's loaded
+func (c *Certificates) changed() bool {
+	loaded := c.current.Load()
+	for _, file := range c.files() {
+		info, err := os.Stat(file)
+		if err != nil || !info.ModTime().Equal(loaded.modTime[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Run reloads the certificates upon the changes of their files until ctx is done.
+// The certificates are kept if the changed files fail to load, e.g. if the certificate is written but its key isn't yet
+func (c *Certificates) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.reloadInterval.Duration(DefaultTLSReloadInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if !c.changed() {
+			continue
+		}
+		loaded, err := c.load()
+		if err != nil {
+			log.Errorf(ctx, err, "failed to reload the TLS certificates")
+			continue
+		}
+		c.current.Store(loaded)
+		log.Printf(ctx, "reloaded the TLS certificates %v", c.files())
+	}
+}
+
+// ServerConfig returns the TLS config of the [Server] which verifies the client certificates if the CAs are set
+func (c *Certificates) ServerConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion:   c.cfg.minVersion.Version(),
+		CipherSuites: c.cfg.cipherPolicy.CipherSuites(),
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return c.current.Load().cert, nil
+		},
+	}
+	if c.cfg.caFile == "" {
+		return cfg
+	}
+
+	clientAuth := tls.RequireAndVerifyClientCert
+	if c.cfg.clientAuth.String() == ClientAuthOptional {
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+	// the config of every connection gets the current CAs
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		connCfg := cfg.Clone()
+		connCfg.GetConfigForClient = nil
+		connCfg.ClientAuth = clientAuth
+		connCfg.ClientCAs = c.current.Load().pool
+		return connCfg, nil
+	}
+	return cfg
+}
+
+// ClientConfig returns the TLS config of the [Client] which sends the certificate if it's set.
+// The server certificates are verified by the CAs loaded at the time of the call or by the system CAs if the CAs aren't set
+func (c *Certificates) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:   c.cfg.minVersion.Version(),
+		CipherSuites: c.cfg.cipherPolicy.CipherSuites(),
+		RootCAs:      c.current.Load().pool,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := c.current.Load().cert; cert != nil {
+				return cert, nil
+			}
+			return &tls.Certificate{}, nil // no certificate is sent
+		},
+	}
+}